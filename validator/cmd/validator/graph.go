@@ -0,0 +1,104 @@
+package main
+
+import (
+    "fmt"
+    "io"
+    "log/slog"
+    "os"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+)
+
+// Supported --graph formats
+const (
+    graphFormatMermaid = "mermaid"
+    graphFormatDOT     = "dot"
+    graphFormatJSON    = "json"
+)
+
+// resolvePlan resolves the execution plan of the validators enabled under cfg, honouring ONLY_VALIDATOR
+// Clients are created lazily, so the returned Context has made no GCP calls
+func resolvePlan(cfg *config.Config, logger *slog.Logger) (*validator.Context, *validator.DependencyResolver, []validator.ExecutionGroup, error) {
+    vctx := validator.NewContext(cfg, logger)
+    enabled := validator.FilterEnabled(vctx, logger, validator.GetAll())
+    if cfg.OnlyValidator != "" {
+        var err error
+        if enabled, err = validator.SelectWithDependencies(enabled, cfg.OnlyValidator); err != nil {
+            return nil, nil, nil, err
+        }
+    }
+    if len(enabled) == 0 {
+        return nil, nil, nil, fmt.Errorf("no validators enabled")
+    }
+
+    resolver := validator.NewDependencyResolver(enabled)
+    groups, err := resolver.ResolveExecutionGroups()
+    if err != nil {
+        return nil, nil, nil, fmt.Errorf("dependency resolution failed: %w", err)
+    }
+    return vctx, resolver, groups, nil
+}
+
+// writeSelfCheck reports the problems and warnings validator.SelfCheck finds in the full registry to w
+// It returns false when there are problems; no configuration is loaded and no GCP client is created
+func writeSelfCheck(w io.Writer) bool {
+    validators := validator.GetAll()
+    problems, warnings := validator.SelfCheck(validators, strictSelfCheck)
+    for _, warning := range warnings {
+        fmt.Fprintf(w, "warning: %s\n", warning)
+    }
+    if len(problems) == 0 {
+        fmt.Fprintf(w, "self-check passed: %d validators registered\n", len(validators))
+        return true
+    }
+    fmt.Fprintf(w, "self-check failed: %d problem(s) in %d registered validators\n", len(problems), len(validators))
+    for _, problem := range problems {
+        fmt.Fprintf(w, "  - %s\n", problem)
+    }
+    return false
+}
+
+// writeDryRun prints what each validator enabled under cfg would check, level by level, without running it
+func writeDryRun(cfg *config.Config, logger *slog.Logger) error {
+    vctx, _, groups, err := resolvePlan(cfg, logger)
+    if err != nil {
+        return err
+    }
+    _, err = os.Stdout.WriteString(validator.DescribePlan(vctx, groups))
+    return err
+}
+
+// writeGraph renders the leveled dependency graph of the validators enabled under cfg to path, or stdout for "-"
+// No GCP clients are created: only registration metadata and configuration are used
+func writeGraph(cfg *config.Config, logger *slog.Logger, path, format string) error {
+    _, resolver, groups, err := resolvePlan(cfg, logger)
+    if err != nil {
+        return err
+    }
+
+    var graph string
+    switch format {
+    case graphFormatMermaid:
+        graph = resolver.ToMermaidWithLevels(groups)
+    case graphFormatDOT:
+        graph = resolver.ToDOTWithLevels(groups)
+    case graphFormatJSON:
+        data, err := resolver.ToJSON()
+        if err != nil {
+            return fmt.Errorf("failed to render graph as JSON: %w", err)
+        }
+        graph = string(data) + "\n"
+    default:
+        return fmt.Errorf("unknown graph format %q (expected %q, %q or %q)", format, graphFormatMermaid, graphFormatDOT, graphFormatJSON)
+    }
+
+    if path == "-" {
+        _, err := os.Stdout.WriteString(graph)
+        return err
+    }
+    if err := os.WriteFile(path, []byte(graph), 0644); err != nil {
+        return fmt.Errorf("failed to write graph to %s: %w", path, err)
+    }
+    return nil
+}
@@ -2,24 +2,65 @@ package main
 
 import (
     "context"
-    "encoding/json"
+    "flag"
+    "fmt"
     "log/slog"
     "os"
     "os/signal"
+    "slices"
     "strings"
+    "sync"
     "syscall"
     "time"
 
     "validator/pkg/config"
+    "validator/pkg/gcp"
+    "validator/pkg/output"
     "validator/pkg/validator"
     _ "validator/pkg/validators" // Import to trigger init() registration
 )
 
+// maxLoggedResultsBytes caps the size of results echoed into the logs after writing
+const maxLoggedResultsBytes = 1 << 20
+
+// Exit codes; a results write failure or a validator panic is an infrastructure problem or a bug,
+// not a failed validation
+const (
+    exitValidationFailed   = 1
+    exitResultsWriteFailed = 2
+    exitValidatorPanic     = 3
+)
 
 // main is the entry point for the GCP validator application.
 // It loads configuration, executes all enabled validators, aggregates results,
 // and writes the output to a JSON file.
+// With --graph-out or --graph it instead writes the validator dependency graph and exits.
+// With --dry-run it instead prints what each enabled validator would check and exits.
 func main() {
+    graphOut := flag.String("graph-out", "", "Write the validator dependency graph to this path (- for stdout) and exit without running checks")
+    graphFormat := flag.String("graph", graphFormatMermaid, "Dependency graph format: mermaid, dot or json; without --graph-out the graph is printed to stdout")
+    dryRun := flag.Bool("dry-run", false, "Print what each enabled validator would check, given the configuration, and exit without running checks")
+    only := flag.String("only", "", "Run only this validator and its dependencies (overrides ONLY_VALIDATOR)")
+    var excludeTags stringList
+    flag.Var(&excludeTags, "exclude-tag", "Skip validators with this tag; repeatable, combined with DISABLED_TAGS")
+    selfCheck := flag.Bool("self-check", false, "Check the registered validators for duplicate names, unknown or cyclic dependencies and incomplete metadata, and exit without running checks")
+    flag.Parse()
+
+    // An explicit --graph on its own prints the graph rather than silently running a full validation
+    flag.Visit(func(f *flag.Flag) {
+        if f.Name == "graph" && *graphOut == "" {
+            *graphOut = "-"
+        }
+    })
+
+    // The self-check covers the full registry, so it needs neither configuration nor credentials
+    if *selfCheck {
+        if !writeSelfCheck(os.Stdout) {
+            os.Exit(1)
+        }
+        return
+    }
+
     // Load configuration first to get log level
     cfg, err := config.LoadFromEnv()
     if err != nil {
@@ -38,8 +79,23 @@ func main() {
     logger.Info("Loaded configuration",
         "gcp_project", cfg.ProjectID,
         "results_path", cfg.ResultsPath,
+        "results_history", cfg.ResultsHistory,
+        "output_format", cfg.OutputFormat,
+        "results_destination", cfg.ResultsDestination,
+        "batch_config_file", cfg.BatchConfigFile,
         "log_level", cfg.LogLevel,
-        "max_wait_time_seconds", cfg.MaxWaitTimeSeconds)
+        "max_wait_time_seconds", cfg.MaxWaitTimeSeconds,
+        "soft_timeout_seconds", cfg.SoftTimeoutSeconds)
+    for _, warning := range cfg.ConfigWarnings {
+        logger.Warn("Ignoring invalid configuration value", "warning", warning)
+    }
+
+    // A short registry means the validators package's init() functions never ran
+    if err := validator.CheckRegistered(cfg.ExpectedValidatorCount); err != nil {
+        logger.Error("Startup check failed", "error", err,
+            "hint", "Ensure cmd/validator imports validator/pkg/validators for its init() registrations")
+        os.Exit(1)
+    }
 
     // Validate disabled validators against registry
     if len(cfg.DisabledValidators) > 0 {
@@ -53,14 +109,50 @@ func main() {
         }
     }
 
-    // Create validation context with lazy client initialization
-    // Services will only be created when validators actually need them (least privilege)
-    vctx := validator.NewContext(cfg, logger)
+    // --exclude-tag adds to DISABLED_TAGS rather than replacing it
+    for _, tag := range excludeTags {
+        cfg.DisableTag(tag)
+    }
+    if len(cfg.DisabledTags) > 0 {
+        logger.Info("Disabled tags", "tags", cfg.DisabledTags)
+    }
 
-    // Create context with timeout (max time for all validators)
-    validationTimeout := time.Duration(cfg.MaxWaitTimeSeconds) * time.Second
-    ctx, cancel := context.WithTimeout(context.Background(), validationTimeout)
-    defer cancel()
+    // --only takes precedence over ONLY_VALIDATOR; unknown names fail before any GCP client is built
+    if *only != "" {
+        cfg.OnlyValidator = *only
+    }
+    if cfg.OnlyValidator != "" {
+        if _, exists := validator.Get(cfg.OnlyValidator); !exists {
+            logger.Error("Unknown validator for --only/ONLY_VALIDATOR",
+                "validator", cfg.OnlyValidator,
+                "hint", "Check for typos; names match the validator list in the README")
+            os.Exit(1)
+        }
+        logger.Info("Running a single validator with its dependencies", "validator", cfg.OnlyValidator)
+    }
+
+    // Render the dependency graph for docs pipelines without touching GCP
+    if *graphOut != "" {
+        if err := writeGraph(cfg, logger, *graphOut, *graphFormat); err != nil {
+            logger.Error("Failed to write dependency graph", "error", err)
+            os.Exit(1)
+        }
+        logger.Info("Dependency graph written", "path", *graphOut, "format", *graphFormat)
+        return
+    }
+
+    // Explain the plan without touching GCP
+    if *dryRun {
+        if err := writeDryRun(cfg, logger); err != nil {
+            logger.Error("Failed to describe the validation plan", "error", err)
+            os.Exit(1)
+        }
+        return
+    }
+
+    // Cancellation causes let results tell a signal apart from the timeout
+    ctx, cancel := context.WithCancelCause(context.Background())
+    defer cancel(nil)
 
     // Set up signal handling for graceful shutdown
     sigCh := make(chan os.Signal, 1)
@@ -68,54 +160,422 @@ func main() {
     go func() {
         sig := <-sigCh
         logger.Warn("Received shutdown signal, cancelling validation", "signal", sig)
-        cancel()
+        cancel(fmt.Errorf("%w: %s", validator.ErrCancelledBySignal, sig))
     }()
 
+    // The results webhook reuses the GCP retry policy
+    retryCfg := retryConfig(cfg)
+
+    // BATCH_CONFIG_FILE validates each listed config in turn and writes one combined report
+    if cfg.BatchConfigFile != "" {
+        runBatch(ctx, cfg, retryCfg, logger, *only, excludeTags)
+        return
+    }
+
+    aggregated, err := runValidation(ctx, cfg, logger, nil)
+    if err != nil {
+        logger.Error("Validator execution failed", "error", err)
+        os.Exit(1)
+    }
+
+    payload := resultsPayload(cfg, aggregated)
+    var writeErr error
+    if cfg.ResultsDestination != config.ResultsDestinationWebhook {
+        writeErr = writeResultsFile(cfg, logger, payload, aggregated.Status)
+    }
+    if cfg.ResultsDestination != config.ResultsDestinationFile {
+        postResultsWebhook(cfg, retryCfg, logger, payload)
+    }
+    if cfg.ResultsGCSURI != "" {
+        uploadResultsGCS(cfg, retryCfg, logger, payload)
+    }
+    writeGitHubAnnotations(cfg, logger, "", aggregated)
+
+    logger.Info("Validation completed",
+        "status", aggregated.Status,
+        "message", aggregated.Message)
+
+    // Exit with appropriate code
+    exitOnWriteFailure(logger, writeErr, aggregated.Status)
+    exitOnPanic(cfg, logger, aggregated.PanicCount())
+    if aggregated.Status == validator.StatusFailure {
+        logger.Warn("Validation FAILED - exiting with code 1")
+        os.Exit(exitValidationFailed)
+    }
+
+    logger.Info("Validation PASSED - exiting with code 0")
+}
+
+// runValidation executes the enabled validators for cfg within its MAX_WAIT_TIME_SECONDS budget
+// and aggregates their results; each call builds its own Context and GCP clients
+// cache, when not nil, serves Cacheable validators from the results of earlier calls
+func runValidation(ctx context.Context, cfg *config.Config, logger *slog.Logger, cache *validator.ResultCache) (*validator.AggregatedResult, error) {
+    factoryOpts, err := clientFactoryOptions(cfg, logger)
+    if err != nil {
+        return nil, err
+    }
+
+    // Create validation context with lazy client initialization
+    // Services will only be created when validators actually need them (least privilege)
+    vctx := validator.NewContextWithFactory(cfg, gcp.NewClientFactory(cfg.ProjectID, logger, factoryOpts...))
+    vctx.SetResultCache(cache)
+
+    // Create context with timeout (max time for all validators)
+    validationTimeout := time.Duration(cfg.MaxWaitTimeSeconds) * time.Second
+    ctx, cancelTimeout := context.WithTimeoutCause(ctx, validationTimeout, validator.ErrValidationTimeout)
+    defer cancelTimeout()
+
     // Execute all validators
     executor := validator.NewExecutor(vctx, logger)
 
     results, err := executor.ExecuteAll(ctx)
     if err != nil {
-        logger.Error("Validator execution failed", "error", err)
-        os.Exit(1)
+        return nil, err
+    }
+    if reason := validator.CancellationReason(ctx); reason != "" {
+        logger.Warn("Validation was interrupted", "reason", reason, "cause", context.Cause(ctx))
     }
 
-    // Aggregate results
-    aggregated := validator.Aggregate(results)
+    // Aggregate results (skipped validators fail the run only when FAIL_ON_SKIPPED is set,
+    // experimental failures only when TREAT_EXPERIMENTAL_AS_BLOCKING is set)
+    // Scopes of the clients actually created are recorded for least-privilege auditing,
+    // per-level parallelism for tuning the validator set
+    return validator.Aggregate(results,
+        validator.WithFailOnSkipped(cfg.FailOnSkipped),
+        validator.WithExperimentalBlocking(cfg.TreatExperimentalAsBlocking),
+        validator.WithScopesUsed(vctx.ScopesUsed()),
+        validator.WithLevelStats(executor.LevelStats())), nil
+}
 
-    // Write to output file
-    outputFile := cfg.ResultsPath
-    logger.Info("Writing results", "path", outputFile)
+// clientFactoryOptions builds the GCP client factory options for the proxy/TLS/timeout and retry settings
+func clientFactoryOptions(cfg *config.Config, logger *slog.Logger) ([]gcp.ClientFactoryOption, error) {
+    // Build the base HTTP transport when proxy/TLS/timeout settings are configured
+    var factoryOpts []gcp.ClientFactoryOption
+    transportCfg := gcp.TransportConfig{
+        DialTimeout:           time.Duration(cfg.HTTPDialTimeoutSeconds) * time.Second,
+        ResponseHeaderTimeout: time.Duration(cfg.HTTPResponseHeaderTimeoutSeconds) * time.Second,
+        CACertFile:            cfg.CACertFile,
+    }
+    if !transportCfg.IsZero() {
+        transport, err := gcp.NewTransport(transportCfg)
+        if err != nil {
+            return nil, fmt.Errorf("failed to configure HTTP transport: %w", err)
+        }
+        logger.Info("Using custom HTTP transport",
+            "dial_timeout", transportCfg.DialTimeout,
+            "response_header_timeout", transportCfg.ResponseHeaderTimeout,
+            "ca_cert_file", transportCfg.CACertFile)
+        factoryOpts = append(factoryOpts, gcp.WithHTTPTransport(transport))
+    }
+
+    // Replace the read-only default scopes of the services SERVICE_SCOPES lists
+    if len(cfg.ServiceScopes) > 0 {
+        for api := range cfg.ServiceScopes {
+            if !slices.Contains(gcp.ScopedAPIs, api) {
+                logger.Warn("Unknown API in SERVICE_SCOPES - will be ignored",
+                    "api", api,
+                    "hint", "Use the API name of a client the validators create, e.g. compute.googleapis.com")
+            }
+        }
+        logger.Info("Overriding OAuth scopes of service clients", "service_scopes", cfg.ServiceScopes)
+        factoryOpts = append(factoryOpts, gcp.WithServiceScopes(cfg.ServiceScopes))
+    }
+
+    // Bound how long a single GCP call can stall across retries, and which errors are retried
+    if cfg.RetryMaxTotalSeconds > 0 || len(cfg.RetryableStatusCodes) > 0 {
+        factoryOpts = append(factoryOpts, gcp.WithRetryConfig(retryConfig(cfg)))
+    }
+    return factoryOpts, nil
+}
+
+// retryConfig returns the GCP retry policy adjusted by RETRY_MAX_TOTAL_SECONDS and RETRYABLE_STATUS_CODES
+func retryConfig(cfg *config.Config) gcp.RetryConfig {
+    retryCfg := gcp.DefaultRetryConfig()
+    if cfg.RetryMaxTotalSeconds > 0 || len(cfg.RetryableStatusCodes) > 0 {
+        retryCfg.MaxTotalRetryDuration = time.Duration(cfg.RetryMaxTotalSeconds) * time.Second
+        if len(cfg.RetryableStatusCodes) > 0 {
+            retryCfg.RetryableStatusCodes = cfg.RetryableStatusCodes
+        }
+    }
+    return retryCfg
+}
 
-    data, err := json.MarshalIndent(aggregated, "", "  ")
+// runBatch validates the BATCH_CONFIG_FILE entries, PROJECT_CONCURRENCY at a time, writes the combined
+// report and exits per BATCH_EXIT_POLICY; a run that fails or cannot start does not stop the others
+func runBatch(ctx context.Context, cfg *config.Config, retryCfg gcp.RetryConfig, logger *slog.Logger, only string, excludeTags []string) {
+    entries, err := config.LoadBatchFile(cfg.BatchConfigFile)
     if err != nil {
-        logger.Error("Failed to marshal results", "error", err)
+        logger.Error("Failed to load batch config", "error", err)
         os.Exit(1)
     }
+    logger.Info("Running batch validation", "file", cfg.BatchConfigFile, "runs", len(entries),
+        "concurrency", cfg.ProjectConcurrency)
 
-    // Ensure output directory exists
-    // Note: In Kubernetes, the /results directory should be pre-created via volumeMounts
-    if err := os.WriteFile(outputFile, data, 0644); err != nil {
-        logger.Error("Failed to write results", "error", err, "path", outputFile)
-        os.Exit(1)
+    // The top-level MAX_WAIT_TIME_SECONDS bounds the whole batch; each run's own budget is derived from it
+    batchTimeout := time.Duration(cfg.MaxWaitTimeSeconds) * time.Second
+    ctx, cancelTimeout := context.WithTimeoutCause(ctx, batchTimeout, validator.ErrValidationTimeout)
+    defer cancelTimeout()
+
+    // Runs against the same project reuse the results of Cacheable validators
+    cache := validator.NewResultCache()
+
+    // Each run writes only its own slot, so the report keeps the file's order whatever finishes first
+    runs := make([]validator.BatchRun, len(entries))
+    sem := make(chan struct{}, cfg.ProjectConcurrency)
+    var wg sync.WaitGroup
+    for i, entry := range entries {
+        sem <- struct{}{}
+        // Once the batch is cancelled, runs that have not started are recorded instead of started
+        if ctx.Err() != nil {
+            <-sem
+            runs[i] = validator.BatchRun{Name: entry.Name, Status: validator.StatusFailure,
+                Error: fmt.Sprintf("not started: %v", context.Cause(ctx))}
+            continue
+        }
+        wg.Add(1)
+        go func(i int, entry config.BatchEntry) {
+            defer wg.Done()
+            defer func() { <-sem }()
+            runs[i] = runBatchEntry(ctx, entry, logger.With("batch_run", entry.Name), only, excludeTags, cache)
+        }(i, entry)
     }
+    wg.Wait()
+    report := validator.AggregateBatch(runs)
 
-    // Log the results content for easy access via logs (useful in containerized environments)
-    logger.Info("Results written successfully",
-        "path", outputFile,
-        "content", string(data))
+    var writeErr error
+    if cfg.ResultsDestination != config.ResultsDestinationWebhook {
+        writeErr = writeResultsFile(cfg, logger, report, report.Status)
+    }
+    if cfg.ResultsDestination != config.ResultsDestinationFile {
+        postResultsWebhook(cfg, retryCfg, logger, report)
+    }
+    if cfg.ResultsGCSURI != "" {
+        uploadResultsGCS(cfg, retryCfg, logger, report)
+    }
 
-    logger.Info("Validation completed",
+    logger.Info("Batch validation completed",
+        "status", report.Status,
+        "message", report.Message)
+
+    // "any" fails the invocation on the first failed run, "all" only when no run passed
+    exitOnWriteFailure(logger, writeErr, report.Status)
+    exitOnPanic(cfg, logger, report.PanicCount)
+    failed := len(report.FailedRuns)
+    if failed > 0 && (cfg.BatchExitPolicy == config.BatchExitPolicyAny || failed == len(runs)) {
+        logger.Warn("Batch validation FAILED - exiting with code 1", "policy", cfg.BatchExitPolicy)
+        os.Exit(exitValidationFailed)
+    }
+
+    logger.Info("Batch validation finished - exiting with code 0", "policy", cfg.BatchExitPolicy, "failed_runs", failed)
+}
+
+// runBatchEntry loads the entry's config on top of the base environment and validates it with its
+// own Context and MAX_WAIT_TIME_SECONDS budget, derived from the batch context
+// Configuration and execution errors are recorded as a failed run instead of exiting
+func runBatchEntry(ctx context.Context, entry config.BatchEntry, logger *slog.Logger, only string, excludeTags []string, cache *validator.ResultCache) validator.BatchRun {
+    cfg, err := entry.Load()
+    if err != nil {
+        logger.Error("Batch run configuration error", "error", err)
+        return validator.BatchRun{Name: entry.Name, Status: validator.StatusFailure, Error: "configuration error: " + err.Error()}
+    }
+    for _, warning := range cfg.ConfigWarnings {
+        logger.Warn("Ignoring invalid configuration value", "warning", warning)
+    }
+    if only != "" {
+        cfg.OnlyValidator = only
+    }
+    for _, tag := range excludeTags {
+        cfg.DisableTag(tag)
+    }
+
+    logger.Info("Starting batch run", "gcp_project", cfg.ProjectID, "gcp_region", cfg.GCPRegion)
+    aggregated, err := runValidation(ctx, cfg, logger, cache)
+    if err != nil {
+        logger.Error("Batch run failed to execute", "error", err)
+        return validator.BatchRun{Name: entry.Name, Status: validator.StatusFailure, Error: err.Error()}
+    }
+    logger.Info("Batch run completed",
         "status", aggregated.Status,
         "message", aggregated.Message)
+    writeGitHubAnnotations(cfg, logger, entry.Name, aggregated)
 
-    // Exit with appropriate code
-    if aggregated.Status == validator.StatusFailure {
-        logger.Warn("Validation FAILED - exiting with code 1")
-        os.Exit(1)
+    return validator.BatchRun{Name: entry.Name, Status: aggregated.Status, Result: resultsPayload(cfg, aggregated),
+        Panics: aggregated.PanicCount()}
+}
+
+// writeResultsFile writes the results to RESULTS_PATH and echoes them into the logs
+// {project}, {timestamp} and {status} placeholders in RESULTS_PATH are substituted at write time
+// When the file cannot be written the results go to stdout instead and the write error is returned
+func writeResultsFile(cfg *config.Config, logger *slog.Logger, payload interface{}, status validator.Status) error {
+    outputFile := output.ExpandPath(cfg.ResultsPath, output.PathVars{
+        Project: cfg.ProjectID,
+        Status:  string(status),
+        Time:    time.Now(),
+    })
+    logger.Info("Writing results", "path", outputFile)
+
+    // Stream the canonical results file, keeping timestamped history when RESULTS_HISTORY > 0
+    writer := output.NewFileWriter(outputFile, cfg.ResultsHistory, logger)
+    writer.CreateDirs = output.HasPathPlaceholders(cfg.ResultsPath)
+    writer.Fallback = os.Stdout
+    writer.Checksum = cfg.ResultsChecksum
+    if err := writer.WriteJSON(payload); err != nil {
+        return err
     }
 
-    logger.Info("Validation PASSED - exiting with code 0")
+    // Log the results content for easy access via logs (useful in containerized environments)
+    // Large result sets are only referenced by path so they are not loaded back into memory
+    if info, err := os.Stat(outputFile); err == nil && info.Size() > maxLoggedResultsBytes {
+        logger.Info("Results written successfully (content too large to log)",
+            "path", outputFile,
+            "size_bytes", info.Size())
+    } else if data, err := os.ReadFile(outputFile); err == nil {
+        logger.Info("Results written successfully",
+            "path", outputFile,
+            "content", string(data))
+    } else {
+        logger.Info("Results written successfully", "path", outputFile)
+    }
+    return nil
+}
+
+// writeGitHubAnnotations prints a GitHub Actions workflow command to stdout for each failed or warning
+// validator when GITHUB_ANNOTATIONS is set, so results show up inline in PR checks; batch runs pass their
+// name as prefix. Outside GitHub Actions it does nothing. Experimental failures do not gate the run and are warnings
+func writeGitHubAnnotations(cfg *config.Config, logger *slog.Logger, prefix string, aggregated *validator.AggregatedResult) {
+    if !cfg.GitHubAnnotations || !output.InGitHubActions() {
+        return
+    }
+    var annotations []output.Annotation
+    for _, r := range aggregated.Results() {
+        level := output.AnnotationWarning
+        switch {
+        case r.Status == validator.StatusFailure && !r.IsExperimental():
+            level = output.AnnotationError
+        case r.Status != validator.StatusFailure && r.Status != validator.StatusWarning:
+            continue
+        }
+        title := r.ValidatorName
+        if prefix != "" {
+            title = prefix + "/" + title
+        }
+        annotations = append(annotations, output.Annotation{
+            Level:   level,
+            Title:   title,
+            Message: fmt.Sprintf("%s: %s", r.Reason, r.Message),
+        })
+    }
+    if err := output.WriteGitHubAnnotations(os.Stdout, annotations); err != nil {
+        logger.Warn("Failed to write GitHub Actions annotations", "error", err)
+    }
+}
+
+// exitOnWriteFailure exits with exitResultsWriteFailed when the results file could not be written,
+// whatever the validation status, so operators can tell a broken results volume from failed checks
+func exitOnWriteFailure(logger *slog.Logger, writeErr error, status validator.Status) {
+    if writeErr == nil {
+        return
+    }
+    logger.Error("Results could not be written (infrastructure failure, not a validation failure) - exiting with code 2",
+        "error", writeErr,
+        "validation_status", status)
+    os.Exit(exitResultsWriteFailed)
+}
+
+// exitOnPanic exits with exitValidatorPanic when FAIL_ON_PANIC is set and any validator panicked,
+// whatever the validation status, so a validator bug is not mistaken for a failed check
+func exitOnPanic(cfg *config.Config, logger *slog.Logger, panics int) {
+    if panics == 0 || !cfg.FailOnPanic {
+        return
+    }
+    logger.Error("Validator panicked (validator bug, not a validation failure) - exiting with code 3",
+        "panics", panics)
+    os.Exit(exitValidatorPanic)
+}
+
+// postResultsWebhook POSTs the results to RESULTS_WEBHOOK_URL with the GCP retry policy
+// A failed POST only fails the run when WEBHOOK_REQUIRED is set
+func postResultsWebhook(cfg *config.Config, retryCfg gcp.RetryConfig, logger *slog.Logger, payload interface{}) {
+    // The validation context may already be cancelled, so the POST gets its own lifetime
+    ctx := context.Background()
+    timeout := time.Duration(cfg.ResultsWebhookTimeoutSeconds) * time.Second
+    sink := output.NewWebhookSink(cfg.ResultsWebhookURL, timeout, logger)
+    sink.Checksum = cfg.ResultsChecksum
+    sink.Retry = func(ctx context.Context, operation func() error) error {
+        return gcp.Retry(ctx, retryCfg, operation)
+    }
+
+    target := output.RedactURL(cfg.ResultsWebhookURL)
+    logger.Info("Posting results to webhook", "url", target, "timeout", timeout)
+    status, err := sink.PostJSON(ctx, payload)
+    if err != nil {
+        if cfg.WebhookRequired {
+            logger.Error("Failed to post results to webhook", "url", target, "status", status, "error", err)
+            os.Exit(1)
+        }
+        logger.Warn("Failed to post results to webhook (WEBHOOK_REQUIRED not set, continuing)",
+            "url", target, "status", status, "error", err)
+        return
+    }
+    logger.Info("Results posted to webhook", "url", target, "status", status)
+}
+
+// uploadResultsGCS uploads the results to RESULTS_GCS_URI with the GCP retry policy
+// Only this upload requests a write scope; a failed upload only fails the run when GCS_REQUIRED is set
+func uploadResultsGCS(cfg *config.Config, retryCfg gcp.RetryConfig, logger *slog.Logger, payload interface{}) {
+    // Like the webhook, the upload outlives a cancelled validation context
+    ctx := context.Background()
+    fail := func(msg string, err error) {
+        if cfg.GCSRequired {
+            logger.Error(msg, "uri", cfg.ResultsGCSURI, "error", err)
+            os.Exit(1)
+        }
+        logger.Warn(msg+" (GCS_REQUIRED not set, continuing)", "uri", cfg.ResultsGCSURI, "error", err)
+    }
+
+    factoryOpts, err := clientFactoryOptions(cfg, logger)
+    if err != nil {
+        fail("Failed to configure Cloud Storage client for results upload", err)
+        return
+    }
+    svc, err := gcp.NewClientFactory(cfg.ProjectID, logger, factoryOpts...).CreateStorageWriterService(ctx)
+    if err != nil {
+        fail("Failed to create Cloud Storage client for results upload", err)
+        return
+    }
+
+    sink := output.NewGCSSink(cfg.ResultsGCSBucket, cfg.ResultsGCSObject, gcp.NewStorageWriterAPI(svc), logger)
+    sink.Checksum = cfg.ResultsChecksum
+    sink.Retry = func(ctx context.Context, operation func() error) error {
+        return gcp.Retry(ctx, retryCfg, operation)
+    }
+
+    logger.Info("Uploading results to Cloud Storage", "uri", sink.URI())
+    if err := sink.UploadJSON(ctx, payload); err != nil {
+        fail("Failed to upload results to Cloud Storage", err)
+        return
+    }
+    logger.Info("Results uploaded to Cloud Storage", "uri", sink.URI())
+}
+
+// resultsPayload projects the aggregated result onto the configured OUTPUT_FORMAT
+func resultsPayload(cfg *config.Config, aggregated *validator.AggregatedResult) interface{} {
+    if cfg.OutputFormat == config.OutputFormatSummary {
+        return aggregated.Summary()
+    }
+    return aggregated
+}
+
+// stringList collects the values of a repeatable flag; each value may also be a comma-separated list
+type stringList []string
+
+func (l *stringList) String() string {
+    return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+    *l = append(*l, strings.Split(value, ",")...)
+    return nil
 }
 
 // parseLogLevel converts string log level to slog.Level
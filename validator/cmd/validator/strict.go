@@ -0,0 +1,6 @@
+//go:build strict
+
+package main
+
+// strictSelfCheck makes --self-check fail on RunAfter and RequireSuccess entries naming unregistered validators
+const strictSelfCheck = true
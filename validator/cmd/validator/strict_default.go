@@ -0,0 +1,7 @@
+//go:build !strict
+
+package main
+
+// strictSelfCheck makes --self-check fail on RunAfter and RequireSuccess entries naming unregistered validators
+// Default builds only warn, since a run ignores such dependencies; build with -tags strict to fail
+const strictSelfCheck = false
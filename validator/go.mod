@@ -3,6 +3,7 @@ module validator
 go 1.25.0
 
 require (
+    cloud.google.com/go/compute/metadata v0.9.0
     github.com/onsi/ginkgo/v2 v2.27.5
     github.com/onsi/gomega v1.39.0
     golang.org/x/oauth2 v0.34.0
@@ -12,7 +13,6 @@ require (
 require (
     cloud.google.com/go/auth v0.18.0 // indirect
     cloud.google.com/go/auth/oauth2adapt v0.2.8 // indirect
-    cloud.google.com/go/compute/metadata v0.9.0 // indirect
     github.com/Masterminds/semver/v3 v3.4.0 // indirect
     github.com/cespare/xxhash/v2 v2.3.0 // indirect
     github.com/felixge/httpsnoop v1.0.4 // indirect
@@ -0,0 +1,115 @@
+package config
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+)
+
+// BatchEntry is one run of a batch: a name and the environment overrides applied on top of the
+// base environment, e.g. {"name": "prod-us", "env": {"PROJECT_ID": "prod", "GCP_REGION": "us-central1"}}
+type BatchEntry struct {
+    Name string            `json:"name"`
+    Env  map[string]string `json:"env"`
+}
+
+// processOnlyEnv lists settings the GCP client libraries read from the process environment when a
+// client is created, after BatchEntry.Load has restored it; an entry overriding them would be ignored,
+// or with PROJECT_CONCURRENCY > 1 leak into another entry's run
+var processOnlyEnv = map[string]bool{
+    "GOOGLE_APPLICATION_CREDENTIALS": true,
+    "HTTP_PROXY":                     true,
+    "HTTPS_PROXY":                    true,
+    "NO_PROXY":                       true,
+    "http_proxy":                     true,
+    "https_proxy":                    true,
+    "no_proxy":                       true,
+}
+
+// LoadBatchFile reads the BATCH_CONFIG_FILE JSON list of batch entries
+// Unnamed entries are named after their PROJECT_ID and GCP_REGION overrides, or their position
+func LoadBatchFile(path string) ([]BatchEntry, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read BATCH_CONFIG_FILE: %w", err)
+    }
+    var entries []BatchEntry
+    if err := json.Unmarshal(data, &entries); err != nil {
+        return nil, fmt.Errorf("failed to parse BATCH_CONFIG_FILE %s: %w", path, err)
+    }
+    if len(entries) == 0 {
+        return nil, fmt.Errorf("BATCH_CONFIG_FILE %s lists no runs", path)
+    }
+
+    for i := range entries {
+        e := &entries[i]
+        for key := range e.Env {
+            if key == "" || key == "BATCH_CONFIG_FILE" {
+                return nil, fmt.Errorf("BATCH_CONFIG_FILE entry %d cannot override %q", i+1, key)
+            }
+            if processOnlyEnv[key] {
+                return nil, fmt.Errorf("BATCH_CONFIG_FILE entry %d cannot override %q: credentials and proxies apply to the whole batch, set it in the process environment", i+1, key)
+            }
+        }
+        if e.Name != "" {
+            continue
+        }
+        switch project, region := e.Env["PROJECT_ID"], e.Env["GCP_REGION"]; {
+        case project != "" && region != "":
+            e.Name = project + "/" + region
+        case project != "":
+            e.Name = project
+        default:
+            e.Name = fmt.Sprintf("run-%d", i+1)
+        }
+    }
+    return entries, nil
+}
+
+// Load returns the entry's config: its overrides on top of the base environment
+// The process environment only changes while loading; settings validators read while running
+// (e.g. VALIDATOR_<NAME>_*) come from the overrides kept in the config, so entries can run concurrently
+func (e BatchEntry) Load() (*Config, error) {
+    restore := e.Apply()
+    cfg, err := LoadFromEnv()
+    restore()
+    if err != nil {
+        return nil, err
+    }
+    cfg.envOverrides = e.Env
+    return cfg, nil
+}
+
+// Apply sets the entry's overrides in the process environment and hides BATCH_CONFIG_FILE, so
+// LoadFromEnv returns the entry's own config; restore puts the previous environment back
+// The environment is locked against settings reads of running validators until restore,
+// so call restore as soon as the config is loaded
+func (e BatchEntry) Apply() (restore func()) {
+    envMu.Lock()
+    overrides := map[string]string{"BATCH_CONFIG_FILE": ""}
+    for key, value := range e.Env {
+        overrides[key] = value
+    }
+
+    type previous struct {
+        value string
+        set   bool
+    }
+    saved := make(map[string]previous, len(overrides))
+    for key, value := range overrides {
+        old, set := os.LookupEnv(key)
+        saved[key] = previous{value: old, set: set}
+        _ = os.Setenv(key, value)
+    }
+
+    return func() {
+        defer envMu.Unlock()
+        for key, p := range saved {
+            if p.set {
+                _ = os.Setenv(key, p.value)
+            } else {
+                _ = os.Unsetenv(key)
+            }
+        }
+    }
+}
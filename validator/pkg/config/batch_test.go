@@ -0,0 +1,129 @@
+package config_test
+
+import (
+    "os"
+    "path/filepath"
+    "time"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/config"
+)
+
+var _ = Describe("Batch", func() {
+    writeBatchFile := func(content string) string {
+        path := filepath.Join(GinkgoT().TempDir(), "batch.json")
+        Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+        return path
+    }
+
+    Describe("LoadBatchFile", func() {
+        It("should name unnamed entries after their project and region", func() {
+            path := writeBatchFile(`[
+                {"name": "prod-us", "env": {"PROJECT_ID": "prod"}},
+                {"env": {"PROJECT_ID": "stage", "GCP_REGION": "europe-west1"}},
+                {"env": {"PROJECT_ID": "dev"}},
+                {"env": {"GCP_REGION": "us-east1"}}
+            ]`)
+
+            entries, err := config.LoadBatchFile(path)
+            Expect(err).NotTo(HaveOccurred())
+            names := make([]string, len(entries))
+            for i, e := range entries {
+                names[i] = e.Name
+            }
+            Expect(names).To(Equal([]string{"prod-us", "stage/europe-west1", "dev", "run-4"}))
+        })
+
+        It("should reject an empty list", func() {
+            _, err := config.LoadBatchFile(writeBatchFile(`[]`))
+            Expect(err).To(MatchError(ContainSubstring("lists no runs")))
+        })
+
+        It("should reject invalid JSON", func() {
+            _, err := config.LoadBatchFile(writeBatchFile(`{"PROJECT_ID": "prod"}`))
+            Expect(err).To(MatchError(ContainSubstring("failed to parse BATCH_CONFIG_FILE")))
+        })
+
+        It("should reject nested batch files", func() {
+            _, err := config.LoadBatchFile(writeBatchFile(`[{"env": {"BATCH_CONFIG_FILE": "other.json"}}]`))
+            Expect(err).To(MatchError(ContainSubstring("cannot override")))
+        })
+
+        It("should reject per-entry credentials and proxies", func() {
+            for _, key := range []string{"GOOGLE_APPLICATION_CREDENTIALS", "HTTPS_PROXY", "no_proxy"} {
+                _, err := config.LoadBatchFile(writeBatchFile(`[{"env": {"PROJECT_ID": "prod", "` + key + `": "x"}}]`))
+                Expect(err).To(MatchError(ContainSubstring("cannot override %q", key)))
+            }
+        })
+    })
+
+    Describe("BatchEntry.Apply", func() {
+        BeforeEach(func() {
+            GinkgoT().Setenv("PROJECT_ID", "base-project")
+            GinkgoT().Setenv("GCP_REGION", "")
+            GinkgoT().Setenv("BATCH_CONFIG_FILE", "batch.json")
+            GinkgoT().Setenv("VPC_NAME", "base-vpc")
+            os.Unsetenv("GCP_REGION")
+        })
+
+        It("should load the entry's config on top of the base environment and restore it", func() {
+            entry := config.BatchEntry{Name: "prod", Env: map[string]string{
+                "PROJECT_ID": "prod",
+                "GCP_REGION": "us-central1",
+            }}
+
+            restore := entry.Apply()
+            cfg, err := config.LoadFromEnv()
+            restore()
+
+            Expect(err).NotTo(HaveOccurred())
+            Expect(cfg.ProjectID).To(Equal("prod"))
+            Expect(cfg.GCPRegion).To(Equal("us-central1"))
+            Expect(cfg.VPCName).To(Equal("base-vpc"))
+            Expect(cfg.BatchConfigFile).To(BeEmpty())
+
+            Expect(os.Getenv("PROJECT_ID")).To(Equal("base-project"))
+            Expect(os.Getenv("BATCH_CONFIG_FILE")).To(Equal("batch.json"))
+            _, set := os.LookupEnv("GCP_REGION")
+            Expect(set).To(BeFalse())
+        })
+    })
+
+    Describe("BatchEntry.Load", func() {
+        BeforeEach(func() {
+            GinkgoT().Setenv("PROJECT_ID", "base-project")
+            GinkgoT().Setenv("VALIDATOR_SSL_CERT_CHECK_REGION", "base-region")
+            GinkgoT().Setenv("VALIDATOR_QUOTA_CHECK_TIMEOUT_SECONDS", "")
+        })
+
+        It("should keep the entry's overrides for settings read after loading", func() {
+            entry := config.BatchEntry{Name: "prod", Env: map[string]string{
+                "PROJECT_ID":                            "prod",
+                "VALIDATOR_SSL_CERT_CHECK_REGION":       "europe-west1",
+                "VALIDATOR_QUOTA_CHECK_TIMEOUT_SECONDS": "45",
+            }}
+
+            cfg, err := entry.Load()
+            Expect(err).NotTo(HaveOccurred())
+            Expect(cfg.ProjectID).To(Equal("prod"))
+            Expect(os.Getenv("PROJECT_ID")).To(Equal("base-project"))
+
+            Expect(cfg.ValidatorString("ssl-cert-check", "REGION", "")).To(Equal("europe-west1"))
+            Expect(cfg.ValidatorTimeout("quota-check")).To(Equal(45 * time.Second))
+        })
+
+        It("should fall back to the base environment for settings the entry does not override", func() {
+            cfg, err := config.BatchEntry{Name: "base"}.Load()
+            Expect(err).NotTo(HaveOccurred())
+            Expect(cfg.ValidatorString("ssl-cert-check", "REGION", "")).To(Equal("base-region"))
+            Expect(cfg.ValidatorTimeout("quota-check")).To(BeZero())
+        })
+
+        It("should return configuration errors", func() {
+            _, err := config.BatchEntry{Name: "bad", Env: map[string]string{"PROJECT_CONCURRENCY": "0"}}.Load()
+            Expect(err).To(MatchError(ContainSubstring("PROJECT_CONCURRENCY")))
+        })
+    })
+})
@@ -2,57 +2,376 @@ package config
 
 import (
     "fmt"
+    "math"
+    "net/netip"
     "os"
+    "slices"
     "strconv"
     "strings"
+    "sync"
+    "time"
+)
+
+// Results file formats accepted by OUTPUT_FORMAT
+const (
+    OutputFormatFull    = "full"    // Aggregated result including every validator result
+    OutputFormatSummary = "summary" // Status, message and check counts only
+)
+
+// Results destinations accepted by RESULTS_DESTINATION
+const (
+    ResultsDestinationFile    = "file"    // Write RESULTS_PATH only
+    ResultsDestinationWebhook = "webhook" // POST to RESULTS_WEBHOOK_URL only
+    ResultsDestinationBoth    = "both"    // Write the file and POST to the webhook
+)
+
+// Exit policies accepted by BATCH_EXIT_POLICY
+const (
+    BatchExitPolicyAny = "any" // Exit 1 when any batch run fails
+    BatchExitPolicyAll = "all" // Exit 1 only when every batch run fails
 )
 
 // Config holds all configuration from environment variables
 type Config struct {
     // Output
-    ResultsPath string // Default: /results/adapter-result.json
+    ResultsPath     string // Default: /results/adapter-result.json
+    ResultsHistory  int    // Default: 0 (no history), number of timestamped results to keep
+    OutputFormat    string // Default: full, or summary for status and counts only
+    ResultsChecksum bool   // Default: false, write <RESULTS_PATH>.sha256 and send the digest to the webhook and GCS
+
+    // GitHub Actions
+    GitHubAnnotations bool // Default: false, also print ::error::/::warning:: workflow commands for failed and warning validators
+
+    // Results webhook
+    ResultsWebhookURL            string // Optional, endpoint the results are POSTed to
+    ResultsWebhookTimeoutSeconds int    // Default: 10, per-attempt timeout of the webhook POST
+    ResultsDestination           string // Default: both when RESULTS_WEBHOOK_URL is set, file otherwise
+    WebhookRequired              bool   // Default: false, fail the run when the webhook POST fails
+
+    // Results upload to Cloud Storage
+    ResultsGCSURI    string // Optional, gs://<bucket>/<object> the results JSON is uploaded to
+    ResultsGCSBucket string // Bucket of RESULTS_GCS_URI
+    ResultsGCSObject string // Object name of RESULTS_GCS_URI
+    GCSRequired      bool   // Default: false, fail the run when the upload fails
+
+    // Batch mode
+    BatchConfigFile    string // Optional, JSON list of config overrides to validate
+    BatchExitPolicy    string // Default: any, when the combined batch run exits 1
+    ProjectConcurrency int    // Default: 1 (sequential), batch runs validated in parallel
 
     // GCP Configuration
-    ProjectID string // Required
+    ProjectID string // Required, except in batch mode where each run sets its own
     GCPRegion string // Optional, for regional checks
 
     // Validator Control
     DisabledValidators []string // Comma-separated list of validators to disable
+    DisabledTags       []string // Comma-separated list of tags whose validators are disabled, merged with --exclude-tag
     StopOnFirstFailure bool     // Default: false
+    StopLevelOnFailure bool     // Default: false, a failure also cancels the rest of its level
+    AllowDestructive   bool     // Default: false, validators tagged "destructive" are refused
+    FailOnSkipped      bool     // Default: false, skipped validators are neutral in the aggregate
+    FailOnPanic        bool     // Default: true, a panicking validator exits with a dedicated code
+    OnlyValidator      string   // Optional, run just this validator and its RunAfter dependencies
+    IsolateContexts    bool     // Default: false, each validator gets its own Context and service clients
+
+    TreatExperimentalAsBlocking bool // Default: false, failures of experimental validators do not fail the run
 
     // API Validator Config
-    RequiredAPIs []string // Default: compute.googleapis.com, iam.googleapis.com, etc.
+    RequiredAPIs        []string            // Default: compute.googleapis.com, iam.googleapis.com, etc.
+    FailOnEmptyAPIList  bool                // Default: false (an empty list passes with nothing checked)
+    AutoRequiredAPIs    bool                // Default: true, also check the APIs declared by the planned validators
+    AcceptableAPIStates []string            // Default: ENABLED, Service Usage states that count as enabled
+    APIPrerequisites    map[string][]string // Default: DefaultAPIPrerequisites, API -> APIs it needs enabled alongside it
+    CheckAPIPropagation bool                // Default: false, probe each enabled API with a real call
+    CheckAPIQuotas      bool                // Default: false, warn when a required API has a zero consumer quota
+    CheckAPIBaseline    bool                // Default: false, warn about enabled APIs outside the baseline
+    APIBaseline         []string            // Optional, APIs allowed to be enabled; default: the required and planned APIs
 
     // Quota Validator Config (Post-MVP)
-    RequiredVCPUs      int // Default: 0 (skip quota check)
-    RequiredDiskGB     int
+    RequiredVCPUs       int // Default: 0 (skip quota check)
+    RequiredDiskGB      int
     RequiredIPAddresses int
 
+    // MIG Capacity Validator Config
+    MIGNodeCount       int // Default: 0 (skip mig-capacity-check), instances the autoscaling node pool may scale to
+    MIGNodeVCPUs       int // Optional, vCPUs per node; counts nodes against the regional CPUS quota
+    MIGMaxSize         int // Optional, planned maximum size of the MIG; default: GCP's regional MIG limit
+    MIGHeadroomPercent int // Default: 10, share of the available capacity that should stay free at full scale
+
+    // IP Address Validator Config
+    RequiredAddresses []string // Optional, names of reserved static addresses in GCP_REGION
+
+    // Accelerator Validator Config
+    RequiredAcceleratorType  string   // Optional, e.g. "nvidia-tesla-t4"
+    RequiredAcceleratorCount int      // Default: 1, accelerators needed from the regional quota
+    AcceleratorZones         []string // Optional, zones that must offer the type; default: every zone of GCP_REGION
+    AcceleratorQuotaMetric   string   // Optional, overrides the quota metric derived from the type
+
+    // Region Validator Config
+    CheckRegionZones bool // Default: false, also require every zone in GCP_REGION to be UP
+
+    // Compute Service Account Validator Config
+    ComputeServiceAccount string // Optional, overrides the default <project-number>-compute@ SA
+
+    // Impersonation Validator Config
+    ImpersonationTargetSA string // Optional, email of a service account the installer impersonates
+
+    // SSL Certificate Validator Config
+    SSLCertName string // Optional, global Compute SSL certificate required for ingress
+
+    // Hybrid Connectivity Validator Config
+    RequireHybridConnectivity bool // Default: false, require an established VPN tunnel or Interconnect attachment
+
+    // Router BGP Validator Config
+    RouterName        string // Optional, Cloud Router in GCP_REGION used for hybrid connectivity
+    ExpectedRouterASN int64  // Optional, BGP ASN the router must use to match the on-premises peer configuration
+
+    // Service Agent Validator Config
+    ServiceAgentRoles map[string][]string // Optional, service (or agent email) -> roles its service agent must hold
+
+    // Audit Logging Validator Config
+    RequiredAuditServices map[string][]string // Optional, service (or "allServices") -> audit log types that must be enabled
+
+    // Log Sink Validator Config
+    RequiredLogSink    string // Optional, name of a project log sink that must exist and be enabled
+    LogSinkDestination string // Optional, expected destination of REQUIRED_LOG_SINK, e.g. "storage.googleapis.com/<bucket>"
+
+    // Conflict Validator Config
+    ClusterNamePrefix string // Optional, name prefix of an existing cluster's resources
+
+    // Bucket IAM Validator Config
+    RequiredBucket      string   // Optional, GCS bucket the installer uses
+    BucketIAMPrincipal  string   // Optional, member that needs access, e.g. "serviceAccount:installer@<project>.iam.gserviceaccount.com"
+    BucketRequiredRoles []string // Default: roles/storage.objectAdmin
+
+    // Bucket Policy Validator Config
+    RequireBucketVersioning bool // Default: false, REQUIRED_BUCKET must have object versioning enabled
+    MinRetentionDays        int  // Optional, minimum retention period of REQUIRED_BUCKET's retention policy
+
+    // Instance Template Validator Config
+    InstanceTemplate string // Optional, global instance template the installer uses
+
+    // Image Validator Config
+    SourceImage string // Optional, image the installer pins, "<project>/<family>" or an image self-link
+
+    // KMS Key Validator Config
+    KMSKeyName string // Optional, full resource name of the CMEK key for disk encryption
+
+    // Resource Tags Validator Config
+    RequiredTagBindings []string // Optional, tag values that must be bound to the project, "tagValues/<id>" or namespaced
+
+    // Filestore Validator Config
+    FilestoreInstance string // Optional, instance ID or full resource name
+    FilestoreLocation string // Default: GCP_REGION, zone or region of the instance
+
+    // Organization Hierarchy Validator Config
+    ExpectedParent string // Optional, e.g. "folders/123" or "organizations/456"
+
+    // IAM Deny Validator Config
+    CheckIAMDeny       bool     // Default: false, look for deny policies on the project and its ancestors
+    IAMDenyPrincipal   string   // Optional, e.g. "serviceAccount:installer@<project>.iam.gserviceaccount.com"; unset: any principal
+    IAMDenyPermissions []string // Optional, permissions the principal needs, e.g. "compute.instances.create"; unset: any permission
+
     // Network Validator Config (Post-MVP)
-    VPCName    string
-    SubnetName string
+    VPCName     string
+    SubnetName  string
+    RequiredMTU int // Default: 0 (skip MTU check), minimum MTU of the VPC network
+
+    // Firewall Effective Validator Config
+    FirewallTargetTag     string         // Optional, network tag of the cluster instances
+    RequiredFirewallFlows []FirewallFlow // Optional, ingress flows that must reach FIREWALL_TARGET_TAG
+
+    // Restricted VIP Validator Config
+    CheckRestrictedVIP bool // Default: false, require a route to restricted.googleapis.com on the VPC
+
+    // Private Service Access Validator Config
+    CheckPrivateServiceAccess bool // Default: false, require a Service Networking peering and allocated range on the VPC
+
+    // CIDR Overlap Validator Config
+    ClusterCIDR string // Optional, proposed cluster subnet range that must not overlap the VPC's subnets
+
+    // DNS Policy Validator Config
+    DNSPolicyName            string // Optional, Cloud DNS server policy that must be attached to the VPC
+    RequireInboundForwarding bool   // Default: false, DNS_POLICY_NAME must enable inbound query forwarding
+
+    // HTTP Transport (proxy is taken from HTTPS_PROXY/NO_PROXY)
+    HTTPDialTimeoutSeconds           int    // Default: 0 (Go default)
+    HTTPResponseHeaderTimeoutSeconds int    // Default: 0 (no timeout)
+    CACertFile                       string // Optional extra PEM CA bundle, e.g. for TLS-intercepting proxies
+    RetryMaxTotalSeconds             int    // Default: 0 (no cap), wall-time budget for retrying a single GCP call
+    RetryableStatusCodes             []int  // Default: nil (429, 500, 503), HTTP codes of GCP errors to retry
+
+    // OAuth scopes
+    ServiceScopes map[string][]string // Optional, API -> scopes replacing its client's read-only default
 
     // Logging
-    LogLevel string // debug, info, warn, error
+    LogLevel                string // debug, info, warn, error
+    ProgressIntervalSeconds int    // Default: 30, interval for "still running" progress logs (0 disables)
+    LogSampleRate           int    // Default: 0 (no sampling), emit one in N repetitive per-item debug lines
 
     // Timeout
-    MaxWaitTimeSeconds int // Default: 300 (5 minutes), maximum time for all validators to complete
+    MaxWaitTimeSeconds      int // Default: 300 (5 minutes), maximum time for all validators to complete
+    ValidatorTimeoutSeconds int // Default: 0 (none), time limit for a validator without its own default or override
+    SoftTimeoutSeconds      int // Default: 0 (disabled), warn about still-running validators once the run takes this long
+
+    // Startup wiring check
+    ExpectedValidatorCount int // Default: 0 (no check), minimum number of registered validators
+
+    // Integer and boolean env vars that were set but could not be parsed, so their default was used,
+    // and REQUIRED_APIS/REQUIRED_APIS_FILE entries that were dropped because they are not service names
+    ConfigWarnings []string
+
+    // Environment overrides of the batch entry this config was loaded for, see BatchEntry.Load
+    envOverrides map[string]string
 }
 
 // LoadFromEnv loads configuration from environment variables
 func LoadFromEnv() (*Config, error) {
+    // Typed values that are set but fail to parse keep their default and are reported in ConfigWarnings
+    env := &envParser{}
     cfg := &Config{
-        ResultsPath:         getEnv("RESULTS_PATH", "/results/adapter-result.json"),
-        ProjectID:           os.Getenv("PROJECT_ID"),
-        GCPRegion:           getEnv("GCP_REGION", ""),
-        StopOnFirstFailure:  getEnvBool("STOP_ON_FIRST_FAILURE", false),
-        LogLevel:            getEnv("LOG_LEVEL", "info"),
-        RequiredVCPUs:       getEnvInt("REQUIRED_VCPUS", 0),
-        RequiredDiskGB:      getEnvInt("REQUIRED_DISK_GB", 0),
-        RequiredIPAddresses: getEnvInt("REQUIRED_IP_ADDRESSES", 0),
-        VPCName:             getEnv("VPC_NAME", ""),
-        SubnetName:          getEnv("SUBNET_NAME", ""),
-        MaxWaitTimeSeconds:  getEnvInt("MAX_WAIT_TIME_SECONDS", 300),
+        ResultsPath:       getEnv("RESULTS_PATH", "/results/adapter-result.json"),
+        ResultsHistory:    env.getInt("RESULTS_HISTORY", 0),
+        OutputFormat:      strings.ToLower(getEnv("OUTPUT_FORMAT", OutputFormatFull)),
+        ResultsChecksum:   env.getBool("RESULTS_CHECKSUM", false),
+        GitHubAnnotations: env.getBool("GITHUB_ANNOTATIONS", false),
+
+        ResultsWebhookURL:            getEnv("RESULTS_WEBHOOK_URL", ""),
+        ResultsWebhookTimeoutSeconds: env.getInt("RESULTS_WEBHOOK_TIMEOUT_SECONDS", 10),
+        ResultsDestination:           strings.ToLower(getEnv("RESULTS_DESTINATION", "")),
+        WebhookRequired:              env.getBool("WEBHOOK_REQUIRED", false),
+
+        // Results upload
+        ResultsGCSURI: getEnv("RESULTS_GCS_URI", ""),
+        GCSRequired:   env.getBool("GCS_REQUIRED", false),
+
+        BatchConfigFile:    getEnv("BATCH_CONFIG_FILE", ""),
+        BatchExitPolicy:    strings.ToLower(getEnv("BATCH_EXIT_POLICY", BatchExitPolicyAny)),
+        ProjectConcurrency: env.getInt("PROJECT_CONCURRENCY", 1),
+
+        ProjectID:             os.Getenv("PROJECT_ID"),
+        GCPRegion:             getEnv("GCP_REGION", ""),
+        StopOnFirstFailure:    env.getBool("STOP_ON_FIRST_FAILURE", false),
+        StopLevelOnFailure:    env.getBool("STOP_LEVEL_ON_FAILURE", false),
+        IsolateContexts:       env.getBool("ISOLATE_CONTEXTS", false),
+        AllowDestructive:      env.getBool("ALLOW_DESTRUCTIVE", false),
+        FailOnSkipped:         env.getBool("FAIL_ON_SKIPPED", false),
+        FailOnPanic:           env.getBool("FAIL_ON_PANIC", true),
+        OnlyValidator:         strings.TrimSpace(os.Getenv("ONLY_VALIDATOR")),
+        FailOnEmptyAPIList:    env.getBool("FAIL_ON_EMPTY_API_LIST", false),
+        AutoRequiredAPIs:      env.getBool("AUTO_REQUIRED_APIS", true),
+        CheckAPIPropagation:   env.getBool("CHECK_API_PROPAGATION", false),
+        CheckAPIQuotas:        env.getBool("CHECK_API_QUOTAS", false),
+        CheckAPIBaseline:      env.getBool("CHECK_API_BASELINE", false),
+        LogLevel:              getEnv("LOG_LEVEL", "info"),
+        RequiredVCPUs:         env.getInt("REQUIRED_VCPUS", 0),
+        RequiredDiskGB:        env.getInt("REQUIRED_DISK_GB", 0),
+        RequiredIPAddresses:   env.getInt("REQUIRED_IP_ADDRESSES", 0),
+        MIGNodeCount:          env.getInt("MIG_NODE_COUNT", 0),
+        MIGNodeVCPUs:          env.getInt("MIG_NODE_VCPUS", 0),
+        MIGMaxSize:            env.getInt("MIG_MAX_SIZE", 0),
+        MIGHeadroomPercent:    env.getInt("MIG_HEADROOM_PERCENT", 10),
+        ComputeServiceAccount: getEnv("COMPUTE_SERVICE_ACCOUNT", ""),
+        VPCName:               getEnv("VPC_NAME", ""),
+        SubnetName:            getEnv("SUBNET_NAME", ""),
+        MaxWaitTimeSeconds:    env.getInt("MAX_WAIT_TIME_SECONDS", 300),
+
+        // Experimental validators
+        TreatExperimentalAsBlocking: env.getBool("TREAT_EXPERIMENTAL_AS_BLOCKING", false),
+
+        // Accelerator check
+        RequiredAcceleratorType:  getEnv("REQUIRED_ACCELERATOR_TYPE", ""),
+        RequiredAcceleratorCount: env.getInt("REQUIRED_ACCELERATOR_COUNT", 1),
+        AcceleratorQuotaMetric:   strings.ToUpper(getEnv("ACCELERATOR_QUOTA_METRIC", "")),
+
+        // MTU check
+        RequiredMTU: env.getInt("REQUIRED_MTU", 0),
+
+        // Firewall effective check
+        FirewallTargetTag: getEnv("FIREWALL_TARGET_TAG", ""),
+
+        // Restricted VIP check
+        CheckRestrictedVIP: env.getBool("CHECK_RESTRICTED_VIP", false),
+
+        // Private service access check
+        CheckPrivateServiceAccess: env.getBool("CHECK_PRIVATE_SERVICE_ACCESS", false),
+
+        // CIDR overlap check
+        ClusterCIDR: strings.TrimSpace(os.Getenv("CLUSTER_CIDR")),
+
+        // Log sink check
+        RequiredLogSink:    getEnv("REQUIRED_LOG_SINK", ""),
+        LogSinkDestination: getEnv("LOG_SINK_DESTINATION", ""),
+
+        // DNS policy check
+        DNSPolicyName:            getEnv("DNS_POLICY_NAME", ""),
+        RequireInboundForwarding: env.getBool("REQUIRE_INBOUND_FORWARDING", false),
+
+        // IAM deny check
+        CheckIAMDeny:     env.getBool("CHECK_IAM_DENY", false),
+        IAMDenyPrincipal: getEnv("IAM_DENY_PRINCIPAL", ""),
+
+        // Region check
+        CheckRegionZones: env.getBool("CHECK_REGION_ZONES", false),
+
+        // Organization hierarchy
+        ExpectedParent: getEnv("EXPECTED_PARENT", ""),
+
+        // SSL certificate
+        SSLCertName: getEnv("SSL_CERT_NAME", ""),
+
+        // Conflict check
+        ClusterNamePrefix: getEnv("CLUSTER_NAME_PREFIX", ""),
+
+        // Bucket IAM
+        RequiredBucket:     getEnv("REQUIRED_BUCKET", ""),
+        BucketIAMPrincipal: getEnv("BUCKET_IAM_PRINCIPAL", ""),
+
+        // Bucket policy
+        RequireBucketVersioning: env.getBool("REQUIRE_BUCKET_VERSIONING", false),
+        MinRetentionDays:        env.getInt("MIN_RETENTION_DAYS", 0),
+
+        // Instance template
+        InstanceTemplate: getEnv("INSTANCE_TEMPLATE", ""),
+
+        // Source image
+        SourceImage: getEnv("SOURCE_IMAGE", ""),
+
+        // Impersonation check
+        ImpersonationTargetSA: getEnv("IMPERSONATION_TARGET_SA", ""),
+
+        // KMS key
+        KMSKeyName: getEnv("KMS_KEY_NAME", ""),
+
+        // Filestore
+        FilestoreInstance: getEnv("FILESTORE_INSTANCE", ""),
+        FilestoreLocation: getEnv("FILESTORE_LOCATION", ""),
+
+        // Per-validator and soft timeouts
+        ValidatorTimeoutSeconds: env.getInt("VALIDATOR_TIMEOUT_SECONDS", 0),
+        SoftTimeoutSeconds:      env.getInt("SOFT_TIMEOUT_SECONDS", 0),
+
+        // Startup wiring check
+        ExpectedValidatorCount: env.getInt("EXPECTED_VALIDATOR_COUNT", 0),
+
+        // Hybrid connectivity
+        RequireHybridConnectivity: env.getBool("REQUIRE_HYBRID_CONNECTIVITY", false),
+
+        // Router BGP
+        RouterName:        getEnv("ROUTER_NAME", ""),
+        ExpectedRouterASN: int64(env.getInt("EXPECTED_ROUTER_ASN", 0)),
+
+        // Progress logging
+        ProgressIntervalSeconds: env.getInt("PROGRESS_INTERVAL", 30),
+
+        // Log sampling
+        LogSampleRate: env.getInt("LOG_SAMPLE_RATE", 0),
+
+        // HTTP transport
+        HTTPDialTimeoutSeconds:           env.getInt("HTTP_DIAL_TIMEOUT_SECONDS", 0),
+        HTTPResponseHeaderTimeoutSeconds: env.getInt("HTTP_RESPONSE_HEADER_TIMEOUT_SECONDS", 0),
+        CACertFile:                       getEnv("CA_CERT_FILE", ""),
+        RetryMaxTotalSeconds:             env.getInt("RETRY_MAX_TOTAL_SECONDS", 0),
     }
 
     // Parse disabled validators
@@ -64,6 +383,13 @@ func LoadFromEnv() (*Config, error) {
         }
     }
 
+    // Parse disabled tags
+    if tags := os.Getenv("DISABLED_TAGS"); tags != "" {
+        for _, tag := range strings.Split(tags, ",") {
+            cfg.DisableTag(tag)
+        }
+    }
+
     // Parse required APIs
     defaultAPIs := []string{
         "compute.googleapis.com",
@@ -71,23 +397,291 @@ func LoadFromEnv() (*Config, error) {
         "cloudresourcemanager.googleapis.com",
     }
     if apis := os.Getenv("REQUIRED_APIS"); apis != "" {
-        cfg.RequiredAPIs = strings.Split(apis, ",")
-        // Trim whitespace
-        for i, v := range cfg.RequiredAPIs {
-            cfg.RequiredAPIs[i] = strings.TrimSpace(v)
-        }
-    } else {
+        cfg.RequiredAPIs = mergeAPIs(env.normalizeAPIs("REQUIRED_APIS", strings.Split(apis, ",")))
+    } else if os.Getenv("REQUIRED_APIS_FILE") == "" {
         cfg.RequiredAPIs = defaultAPIs
     }
 
+    // Merge APIs listed in REQUIRED_APIS_FILE (union with REQUIRED_APIS, first occurrence wins)
+    if path := os.Getenv("REQUIRED_APIS_FILE"); path != "" {
+        fileAPIs, err := readAPIList(path)
+        if err != nil {
+            return nil, err
+        }
+        cfg.RequiredAPIs = mergeAPIs(cfg.RequiredAPIs, env.normalizeAPIs("REQUIRED_APIS_FILE", fileAPIs))
+    }
+
+    // Parse the API baseline
+    if baseline := os.Getenv("API_BASELINE"); baseline != "" {
+        for _, api := range strings.Split(baseline, ",") {
+            if api = strings.TrimSpace(api); api != "" {
+                cfg.APIBaseline = append(cfg.APIBaseline, api)
+            }
+        }
+    }
+
+    // Parse acceptable API states (upper-cased to match Service Usage states)
+    cfg.AcceptableAPIStates = []string{"ENABLED"}
+    if states := os.Getenv("ACCEPTABLE_API_STATES"); states != "" {
+        cfg.AcceptableAPIStates = nil
+        for _, s := range strings.Split(states, ",") {
+            if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+                cfg.AcceptableAPIStates = append(cfg.AcceptableAPIStates, s)
+            }
+        }
+    }
+
+    // Parse API prerequisites ("<api>=<prerequisite>" pairs), replacing the built-in map
+    cfg.APIPrerequisites = DefaultAPIPrerequisites()
+    if prereqs := os.Getenv("API_PREREQUISITES"); prereqs != "" {
+        apis, err := parseAPIPrerequisites(prereqs)
+        if err != nil {
+            return nil, err
+        }
+        cfg.APIPrerequisites = apis
+    }
+
+    // A bare service account email is the common case for the bucket principal
+    if cfg.BucketIAMPrincipal != "" && !strings.Contains(cfg.BucketIAMPrincipal, ":") {
+        cfg.BucketIAMPrincipal = "serviceAccount:" + cfg.BucketIAMPrincipal
+    }
+
+    // Same for the principal checked against deny policies
+    if cfg.IAMDenyPrincipal != "" && !strings.Contains(cfg.IAMDenyPrincipal, ":") {
+        cfg.IAMDenyPrincipal = "serviceAccount:" + cfg.IAMDenyPrincipal
+    }
+
+    // Parse permissions checked against deny policies
+    if perms := os.Getenv("IAM_DENY_PERMISSIONS"); perms != "" {
+        for _, p := range strings.Split(perms, ",") {
+            if p = strings.TrimSpace(p); p != "" {
+                cfg.IAMDenyPermissions = append(cfg.IAMDenyPermissions, p)
+            }
+        }
+    }
+
+    // Parse accelerator zones
+    if zones := os.Getenv("ACCELERATOR_ZONES"); zones != "" {
+        for _, z := range strings.Split(zones, ",") {
+            if z = strings.TrimSpace(z); z != "" {
+                cfg.AcceleratorZones = append(cfg.AcceleratorZones, z)
+            }
+        }
+    }
+
+    // Parse required static address names
+    if names := os.Getenv("REQUIRED_ADDRESSES"); names != "" {
+        for _, n := range strings.Split(names, ",") {
+            if n = strings.TrimSpace(n); n != "" {
+                cfg.RequiredAddresses = append(cfg.RequiredAddresses, n)
+            }
+        }
+    }
+
+    // Parse per-service OAuth scopes ("<api>=<scope>" pairs)
+    if scopes := os.Getenv("SERVICE_SCOPES"); scopes != "" {
+        parsed, err := parseServiceScopes(scopes)
+        if err != nil {
+            return nil, err
+        }
+        cfg.ServiceScopes = parsed
+    }
+
+    // Parse retryable HTTP status codes
+    if codes := os.Getenv("RETRYABLE_STATUS_CODES"); codes != "" {
+        for _, c := range strings.Split(codes, ",") {
+            c = strings.TrimSpace(c)
+            if c == "" {
+                continue
+            }
+            code, err := strconv.Atoi(c)
+            if err != nil || code < 100 || code > 599 {
+                return nil, fmt.Errorf("RETRYABLE_STATUS_CODES entry %q is not an HTTP status code", c)
+            }
+            cfg.RetryableStatusCodes = append(cfg.RetryableStatusCodes, code)
+        }
+    }
+
+    // Parse roles the bucket principal needs
+    cfg.BucketRequiredRoles = []string{"roles/storage.objectAdmin"}
+    if roles := os.Getenv("BUCKET_REQUIRED_ROLES"); roles != "" {
+        cfg.BucketRequiredRoles = nil
+        for _, r := range strings.Split(roles, ",") {
+            if r = strings.TrimSpace(r); r != "" {
+                cfg.BucketRequiredRoles = append(cfg.BucketRequiredRoles, r)
+            }
+        }
+    }
+
+    // Parse service agent role expectations ("<service>=<role>" pairs; repeat a service for several roles)
+    if agents := os.Getenv("SERVICE_AGENT_ROLES"); agents != "" {
+        roles, err := parseServiceAgentRoles(agents)
+        if err != nil {
+            return nil, err
+        }
+        cfg.ServiceAgentRoles = roles
+    }
+
+    // Parse audit logging expectations ("<service>" or "<service>=<log type>"; repeat a service for several types)
+    if services := os.Getenv("REQUIRED_AUDIT_SERVICES"); services != "" {
+        logTypes, err := parseAuditServices(services)
+        if err != nil {
+            return nil, err
+        }
+        cfg.RequiredAuditServices = logTypes
+    }
+
+    // Parse required firewall flows ("<protocol>[:<port>][@<source>]")
+    if flows := os.Getenv("REQUIRED_FIREWALL_FLOWS"); flows != "" {
+        parsed, err := parseFirewallFlows(flows)
+        if err != nil {
+            return nil, err
+        }
+        cfg.RequiredFirewallFlows = parsed
+    }
+
+    // Parse required tag bindings ("tagValues/<id>", "<parent>/<key>/<value>" or "<parent>/<key>")
+    if bindings := os.Getenv("REQUIRED_TAG_BINDINGS"); bindings != "" {
+        parsed, err := parseTagBindings(bindings)
+        if err != nil {
+            return nil, err
+        }
+        cfg.RequiredTagBindings = parsed
+    }
+
     // Validation
-    if cfg.ProjectID == "" {
+    if cfg.ProjectID == "" && cfg.BatchConfigFile == "" {
         return nil, fmt.Errorf("PROJECT_ID is required")
     }
+    if cfg.BatchExitPolicy != BatchExitPolicyAny && cfg.BatchExitPolicy != BatchExitPolicyAll {
+        return nil, fmt.Errorf("BATCH_EXIT_POLICY must be %q or %q, got %q", BatchExitPolicyAny, BatchExitPolicyAll, cfg.BatchExitPolicy)
+    }
+    if cfg.ProjectConcurrency < 1 {
+        return nil, fmt.Errorf("PROJECT_CONCURRENCY must be at least 1, got %d", cfg.ProjectConcurrency)
+    }
+    if cfg.OutputFormat != OutputFormatFull && cfg.OutputFormat != OutputFormatSummary {
+        return nil, fmt.Errorf("OUTPUT_FORMAT must be %q or %q, got %q", OutputFormatFull, OutputFormatSummary, cfg.OutputFormat)
+    }
+    if cfg.ResultsDestination == "" {
+        cfg.ResultsDestination = ResultsDestinationFile
+        if cfg.ResultsWebhookURL != "" {
+            cfg.ResultsDestination = ResultsDestinationBoth
+        }
+    }
+    switch cfg.ResultsDestination {
+    case ResultsDestinationFile:
+    case ResultsDestinationWebhook, ResultsDestinationBoth:
+        if cfg.ResultsWebhookURL == "" {
+            return nil, fmt.Errorf("RESULTS_DESTINATION=%s requires RESULTS_WEBHOOK_URL", cfg.ResultsDestination)
+        }
+    default:
+        return nil, fmt.Errorf("RESULTS_DESTINATION must be %q, %q or %q, got %q",
+            ResultsDestinationFile, ResultsDestinationWebhook, ResultsDestinationBoth, cfg.ResultsDestination)
+    }
+    if cfg.ResultsGCSURI != "" {
+        bucket, object, ok := parseGCSURI(cfg.ResultsGCSURI)
+        if !ok {
+            return nil, fmt.Errorf("RESULTS_GCS_URI must be gs://<bucket>/<object>, got %q", cfg.ResultsGCSURI)
+        }
+        cfg.ResultsGCSBucket, cfg.ResultsGCSObject = bucket, object
+    }
+    // A non-positive budget would create an already-expired root context and fail every validator
+    if cfg.MaxWaitTimeSeconds <= 0 {
+        return nil, fmt.Errorf("MAX_WAIT_TIME_SECONDS must be positive, got %d", cfg.MaxWaitTimeSeconds)
+    }
+    if cfg.ValidatorTimeoutSeconds < 0 {
+        return nil, fmt.Errorf("VALIDATOR_TIMEOUT_SECONDS must not be negative, got %d", cfg.ValidatorTimeoutSeconds)
+    }
+    // A soft timeout at or past the hard deadline would never warn in time
+    if cfg.SoftTimeoutSeconds < 0 || cfg.SoftTimeoutSeconds >= cfg.MaxWaitTimeSeconds {
+        return nil, fmt.Errorf("SOFT_TIMEOUT_SECONDS must be between 0 and MAX_WAIT_TIME_SECONDS (%d), got %d",
+            cfg.MaxWaitTimeSeconds, cfg.SoftTimeoutSeconds)
+    }
+    if cfg.ClusterCIDR != "" {
+        if _, err := netip.ParsePrefix(cfg.ClusterCIDR); err != nil {
+            return nil, fmt.Errorf("CLUSTER_CIDR must be a CIDR range such as 10.128.0.0/14, got %q", cfg.ClusterCIDR)
+        }
+    }
+    if cfg.ExpectedValidatorCount < 0 {
+        return nil, fmt.Errorf("EXPECTED_VALIDATOR_COUNT must not be negative, got %d", cfg.ExpectedValidatorCount)
+    }
+    if cfg.ImpersonationTargetSA != "" && !strings.Contains(cfg.ImpersonationTargetSA, "@") {
+        return nil, fmt.Errorf("IMPERSONATION_TARGET_SA must be a service account email, got %q", cfg.ImpersonationTargetSA)
+    }
+    if cfg.MIGNodeCount < 0 || cfg.MIGNodeVCPUs < 0 || cfg.MIGMaxSize < 0 {
+        return nil, fmt.Errorf("MIG_NODE_COUNT, MIG_NODE_VCPUS and MIG_MAX_SIZE must not be negative")
+    }
+    if cfg.MIGHeadroomPercent < 0 || cfg.MIGHeadroomPercent > 99 {
+        return nil, fmt.Errorf("MIG_HEADROOM_PERCENT must be between 0 and 99, got %d", cfg.MIGHeadroomPercent)
+    }
+    if cfg.MinRetentionDays < 0 {
+        return nil, fmt.Errorf("MIN_RETENTION_DAYS must not be negative, got %d", cfg.MinRetentionDays)
+    }
+    // BGP ASNs are 32-bit
+    if cfg.ExpectedRouterASN < 0 || cfg.ExpectedRouterASN > math.MaxUint32 {
+        return nil, fmt.Errorf("EXPECTED_ROUTER_ASN must be between 1 and %d, got %d", uint32(math.MaxUint32), cfg.ExpectedRouterASN)
+    }
 
+    cfg.ConfigWarnings = env.warnings
     return cfg, nil
 }
 
+// readAPIList reads a newline-delimited API list, ignoring blank lines and "#" comments
+func readAPIList(path string) ([]string, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read REQUIRED_APIS_FILE: %w", err)
+    }
+    var apis []string
+    for _, line := range strings.Split(string(data), "\n") {
+        line = strings.TrimSpace(line)
+        if line == "" || strings.HasPrefix(line, "#") {
+            continue
+        }
+        apis = append(apis, line)
+    }
+    return apis, nil
+}
+
+// normalizeAPIs reduces pasted entries such as projects/X/services/compute.googleapis.com to the bare
+// service name api-enabled builds its lookup path from. Entries that are not *.googleapis.com services
+// are dropped with a warning, blank ones silently
+func (e *envParser) normalizeAPIs(key string, apis []string) []string {
+    var normalized []string
+    for _, api := range apis {
+        api = strings.TrimSpace(api)
+        // Strip the projects/<project>/services/ prefix of a full Service Usage resource name
+        if parts := strings.SplitN(api, "/", 4); len(parts) == 4 && parts[0] == "projects" && parts[2] == "services" {
+            api = parts[3]
+        }
+        if api == "" {
+            continue
+        }
+        if !strings.HasSuffix(api, ".googleapis.com") || strings.Contains(api, "/") {
+            e.warnings = append(e.warnings, fmt.Sprintf("%s entry %q is not a service name like compute.googleapis.com, ignoring it", key, api))
+            continue
+        }
+        normalized = append(normalized, api)
+    }
+    return normalized
+}
+
+// mergeAPIs returns the union of the lists in order of first occurrence, without empty entries
+func mergeAPIs(lists ...[]string) []string {
+    seen := map[string]bool{}
+    var merged []string
+    for _, list := range lists {
+        for _, api := range list {
+            if api == "" || seen[api] {
+                continue
+            }
+            seen[api] = true
+            merged = append(merged, api)
+        }
+    }
+    return merged
+}
+
 // getEnv retrieves an environment variable or returns a default value if not set
 func getEnv(key, defaultValue string) string {
     if value := os.Getenv(key); value != "" {
@@ -96,28 +690,375 @@ func getEnv(key, defaultValue string) string {
     return defaultValue
 }
 
-// getEnvBool retrieves a boolean environment variable or returns a default value if not set or invalid
-func getEnvBool(key string, defaultValue bool) bool {
+// GetValidatorString reads a validator-scoped setting from the process environment
+// See Config.ValidatorString, which validators use so batch entry overrides apply
+func GetValidatorString(validatorName, key, defaultValue string) string {
+    return (&Config{}).ValidatorString(validatorName, key, defaultValue)
+}
+
+// GetValidatorTimeout returns the VALIDATOR_<NAME>_TIMEOUT_SECONDS override from the process environment
+// See Config.ValidatorTimeout, which the executor uses so batch entry overrides apply
+func GetValidatorTimeout(validatorName string) time.Duration {
+    return (&Config{}).ValidatorTimeout(validatorName)
+}
+
+// ValidatorString reads a validator-scoped setting, avoiding clashes between validators
+// VALIDATOR_<NAME>_<KEY> takes precedence (e.g., VALIDATOR_NETWORK_CHECK_VPC_NAME for
+// "network-check"), then the generic KEY, then defaultValue
+func (c *Config) ValidatorString(validatorName, key, defaultValue string) string {
+    if value := c.getenv(ValidatorEnvKey(validatorName, key)); value != "" {
+        return value
+    }
+    if value := c.getenv(key); value != "" {
+        return value
+    }
+    return defaultValue
+}
+
+// ValidatorTimeout returns the operator's VALIDATOR_<NAME>_TIMEOUT_SECONDS override for a
+// validator, or 0 when it is unset or not a positive number
+func (c *Config) ValidatorTimeout(validatorName string) time.Duration {
+    seconds, err := strconv.Atoi(c.getenv(ValidatorEnvKey(validatorName, "TIMEOUT_SECONDS")))
+    if err != nil || seconds <= 0 {
+        return 0
+    }
+    return time.Duration(seconds) * time.Second
+}
+
+// envMu guards the process environment against batch entries applying their overrides
+// while validators of other entries read settings from it
+var envMu sync.RWMutex
+
+// getenv reads a setting validators look up while running: the batch entry's override when the
+// config was loaded by BatchEntry.Load, else the process environment
+func (c *Config) getenv(key string) string {
+    if value, ok := c.envOverrides[key]; ok {
+        return value
+    }
+    envMu.RLock()
+    defer envMu.RUnlock()
+    return os.Getenv(key)
+}
+
+// ValidatorEnvKey returns the namespaced env var name for a validator setting
+func ValidatorEnvKey(validatorName, key string) string {
+    name := strings.ToUpper(strings.ReplaceAll(validatorName, "-", "_"))
+    return "VALIDATOR_" + name + "_" + key
+}
+
+// parseServiceAgentRoles parses "svc=role,svc=role" into roles keyed by service, keeping first-seen role order
+func parseServiceAgentRoles(value string) (map[string][]string, error) {
+    roles := map[string][]string{}
+    for _, entry := range strings.Split(value, ",") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+        service, role, ok := strings.Cut(entry, "=")
+        service, role = strings.TrimSpace(service), strings.TrimSpace(role)
+        if !ok || service == "" || role == "" {
+            return nil, fmt.Errorf("SERVICE_AGENT_ROLES entry %q must be <service>=<role>", entry)
+        }
+        roles[service] = append(roles[service], role)
+    }
+    return roles, nil
+}
+
+// DefaultAPIPrerequisites returns the built-in APIs that only work with other APIs enabled too
+func DefaultAPIPrerequisites() map[string][]string {
+    return map[string][]string{
+        "container.googleapis.com":         {"compute.googleapis.com"},
+        "file.googleapis.com":              {"compute.googleapis.com"},
+        "servicenetworking.googleapis.com": {"compute.googleapis.com"},
+        "iamcredentials.googleapis.com":    {"iam.googleapis.com"},
+    }
+}
+
+// parseAPIPrerequisites parses "api=prereq,api=prereq" into prerequisites keyed by API, keeping first-seen order
+func parseAPIPrerequisites(value string) (map[string][]string, error) {
+    prereqs := map[string][]string{}
+    for _, entry := range strings.Split(value, ",") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+        api, prereq, ok := strings.Cut(entry, "=")
+        api, prereq = strings.TrimSpace(api), strings.TrimSpace(prereq)
+        if !ok || api == "" || prereq == "" {
+            return nil, fmt.Errorf("API_PREREQUISITES entry %q must be <api>=<prerequisite api>", entry)
+        }
+        if !slices.Contains(prereqs[api], prereq) {
+            prereqs[api] = append(prereqs[api], prereq)
+        }
+    }
+    return prereqs, nil
+}
+
+// scopeURLPrefix is prepended to scopes given by their short name, e.g. "cloud-platform"
+const scopeURLPrefix = "https://www.googleapis.com/auth/"
+
+// parseServiceScopes parses "api=scope,..." into scopes keyed by API, keeping first-seen order
+func parseServiceScopes(value string) (map[string][]string, error) {
+    scopes := map[string][]string{}
+    for _, entry := range strings.Split(value, ",") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+        api, scope, ok := strings.Cut(entry, "=")
+        api, scope = strings.TrimSpace(api), strings.TrimSpace(scope)
+        if !ok || api == "" || scope == "" {
+            return nil, fmt.Errorf("SERVICE_SCOPES entry %q must be <api>=<scope>", entry)
+        }
+        if !strings.Contains(scope, "://") {
+            scope = scopeURLPrefix + scope
+        }
+        if !slices.Contains(scopes[api], scope) {
+            scopes[api] = append(scopes[api], scope)
+        }
+    }
+    return scopes, nil
+}
+
+// auditLogTypes are the log types of an IAM policy audit config
+var auditLogTypes = map[string]bool{"ADMIN_READ": true, "DATA_READ": true, "DATA_WRITE": true}
+
+// parseAuditServices parses "svc,svc=LOG_TYPE" into log types keyed by service, keeping first-seen order
+// A bare service requires the Data Access log types, DATA_READ and DATA_WRITE
+func parseAuditServices(value string) (map[string][]string, error) {
+    logTypes := map[string][]string{}
+    for _, entry := range strings.Split(value, ",") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+        service, logType, ok := strings.Cut(entry, "=")
+        service, logType = strings.TrimSpace(service), strings.ToUpper(strings.TrimSpace(logType))
+        if service == "" {
+            return nil, fmt.Errorf("REQUIRED_AUDIT_SERVICES entry %q must be <service> or <service>=<log type>", entry)
+        }
+        types := []string{"DATA_READ", "DATA_WRITE"}
+        if ok {
+            if !auditLogTypes[logType] {
+                return nil, fmt.Errorf("REQUIRED_AUDIT_SERVICES entry %q has log type %q, want ADMIN_READ, DATA_READ or DATA_WRITE", entry, logType)
+            }
+            types = []string{logType}
+        }
+        for _, t := range types {
+            if !slices.Contains(logTypes[service], t) {
+                logTypes[service] = append(logTypes[service], t)
+            }
+        }
+    }
+    return logTypes, nil
+}
+
+// FirewallFlow is an ingress flow that must be allowed to reach the cluster instances
+type FirewallFlow struct {
+    Protocol string       // Lower-case IP protocol name, e.g. "tcp", or its number
+    Port     int          // Destination port; 0 for protocols without ports such as icmp
+    Source   netip.Prefix // Source range of the traffic
+}
+
+// String renders the flow in the REQUIRED_FIREWALL_FLOWS syntax, e.g. "tcp:6443@10.0.0.0/8"
+func (f FirewallFlow) String() string {
+    if f.Port == 0 {
+        return fmt.Sprintf("%s@%s", f.Protocol, f.Source)
+    }
+    return fmt.Sprintf("%s:%d@%s", f.Protocol, f.Port, f.Source)
+}
+
+// portProtocols are the protocols whose flows must name a destination port
+var portProtocols = map[string]bool{"tcp": true, "udp": true, "sctp": true}
+
+// parseFirewallFlows parses "tcp:6443,udp:53@10.0.0.0/8,icmp" into flows
+// The source defaults to 0.0.0.0/0; a bare address is taken as a single-host range
+func parseFirewallFlows(value string) ([]FirewallFlow, error) {
+    var flows []FirewallFlow
+    for _, entry := range strings.Split(value, ",") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+        invalid := func(why string) error {
+            return fmt.Errorf("REQUIRED_FIREWALL_FLOWS entry %q %s", entry, why)
+        }
+
+        spec, source, hasSource := strings.Cut(entry, "@")
+        protocol, port, hasPort := strings.Cut(spec, ":")
+        flow := FirewallFlow{
+            Protocol: strings.ToLower(strings.TrimSpace(protocol)),
+            Source:   netip.MustParsePrefix("0.0.0.0/0"),
+        }
+        if flow.Protocol == "" {
+            return nil, invalid("must be <protocol>[:<port>][@<source>]")
+        }
+        if hasPort {
+            p, err := strconv.Atoi(strings.TrimSpace(port))
+            if err != nil || p < 1 || p > 65535 {
+                return nil, invalid("has an invalid port")
+            }
+            flow.Port = p
+        } else if portProtocols[flow.Protocol] {
+            return nil, invalid("must name a port for " + flow.Protocol)
+        }
+        if hasSource {
+            source = strings.TrimSpace(source)
+            prefix, err := netip.ParsePrefix(source)
+            if err != nil {
+                addr, addrErr := netip.ParseAddr(source)
+                if addrErr != nil {
+                    return nil, invalid("has an invalid source range")
+                }
+                prefix = netip.PrefixFrom(addr, addr.BitLen())
+            }
+            flow.Source = prefix.Masked()
+        }
+        flows = append(flows, flow)
+    }
+    return flows, nil
+}
+
+// parseTagBindings parses "tagValues/123,456/env/prod,456/cost-center" into required tag bindings
+// Namespaced entries are "<org id or project>/<key>/<value>", or "<org id or project>/<key>" for any value of the key
+func parseTagBindings(value string) ([]string, error) {
+    var bindings []string
+    for _, entry := range strings.Split(value, ",") {
+        entry = strings.TrimSpace(entry)
+        if entry == "" {
+            continue
+        }
+        parts := strings.Split(entry, "/")
+        valid := len(parts) == 2 || len(parts) == 3
+        for _, p := range parts {
+            valid = valid && p != ""
+        }
+        if strings.HasPrefix(entry, "tagKeys/") || !valid {
+            return nil, fmt.Errorf("REQUIRED_TAG_BINDINGS entry %q must be tagValues/<id>, <parent>/<key>/<value> or <parent>/<key>", entry)
+        }
+        bindings = append(bindings, entry)
+    }
+    return bindings, nil
+}
+
+// parseGCSURI splits gs://<bucket>/<object> into its bucket and object name
+func parseGCSURI(uri string) (bucket, object string, ok bool) {
+    rest, found := strings.CutPrefix(uri, "gs://")
+    if !found {
+        return "", "", false
+    }
+    bucket, object, _ = strings.Cut(rest, "/")
+    if bucket == "" || object == "" || strings.HasSuffix(object, "/") {
+        return "", "", false
+    }
+    return bucket, object, true
+}
+
+// envParser reads typed environment variables, recording a warning for each value that fails to parse
+type envParser struct {
+    warnings []string
+}
+
+// getBool retrieves a boolean environment variable or returns a default value if not set or invalid
+func (p *envParser) getBool(key string, defaultValue bool) bool {
     if value := os.Getenv(key); value != "" {
         b, err := strconv.ParseBool(value)
         if err == nil {
             return b
         }
+        p.warnings = append(p.warnings, fmt.Sprintf("%s=%q is not a boolean, using default %t", key, value, defaultValue))
     }
     return defaultValue
 }
 
-// getEnvInt retrieves an integer environment variable or returns a default value if not set or invalid
-func getEnvInt(key string, defaultValue int) int {
+// getInt retrieves an integer environment variable or returns a default value if not set or invalid
+func (p *envParser) getInt(key string, defaultValue int) int {
     if value := os.Getenv(key); value != "" {
         i, err := strconv.Atoi(value)
         if err == nil {
             return i
         }
+        p.warnings = append(p.warnings, fmt.Sprintf("%s=%q is not an integer, using default %d", key, value, defaultValue))
     }
     return defaultValue
 }
 
+// settingPresence reports whether optional validator settings are configured, keyed by env var name
+var settingPresence = map[string]func(c *Config) bool{
+    "GCP_REGION":                   func(c *Config) bool { return c.GCPRegion != "" },
+    "REQUIRED_APIS":                func(c *Config) bool { return len(c.RequiredAPIs) > 0 },
+    "CHECK_API_PROPAGATION":        func(c *Config) bool { return c.CheckAPIPropagation },
+    "CHECK_API_BASELINE":           func(c *Config) bool { return c.CheckAPIBaseline },
+    "CHECK_API_QUOTAS":             func(c *Config) bool { return c.CheckAPIQuotas },
+    "REQUIRED_VCPUS":               func(c *Config) bool { return c.RequiredVCPUs > 0 },
+    "REQUIRED_DISK_GB":             func(c *Config) bool { return c.RequiredDiskGB > 0 },
+    "REQUIRED_IP_ADDRESSES":        func(c *Config) bool { return c.RequiredIPAddresses > 0 },
+    "MIG_NODE_COUNT":               func(c *Config) bool { return c.MIGNodeCount > 0 },
+    "MIG_NODE_VCPUS":               func(c *Config) bool { return c.MIGNodeVCPUs > 0 },
+    "MIG_MAX_SIZE":                 func(c *Config) bool { return c.MIGMaxSize > 0 },
+    "REQUIRED_ADDRESSES":           func(c *Config) bool { return len(c.RequiredAddresses) > 0 },
+    "COMPUTE_SERVICE_ACCOUNT":      func(c *Config) bool { return c.ComputeServiceAccount != "" },
+    "EXPECTED_PARENT":              func(c *Config) bool { return c.ExpectedParent != "" },
+    "SSL_CERT_NAME":                func(c *Config) bool { return c.SSLCertName != "" },
+    "REQUIRE_HYBRID_CONNECTIVITY":  func(c *Config) bool { return c.RequireHybridConnectivity },
+    "ROUTER_NAME":                  func(c *Config) bool { return c.RouterName != "" },
+    "EXPECTED_ROUTER_ASN":          func(c *Config) bool { return c.ExpectedRouterASN > 0 },
+    "SERVICE_AGENT_ROLES":          func(c *Config) bool { return len(c.ServiceAgentRoles) > 0 },
+    "REQUIRED_AUDIT_SERVICES":      func(c *Config) bool { return len(c.RequiredAuditServices) > 0 },
+    "REQUIRED_LOG_SINK":            func(c *Config) bool { return c.RequiredLogSink != "" },
+    "LOG_SINK_DESTINATION":         func(c *Config) bool { return c.LogSinkDestination != "" },
+    "INSTANCE_TEMPLATE":            func(c *Config) bool { return c.InstanceTemplate != "" },
+    "SOURCE_IMAGE":                 func(c *Config) bool { return c.SourceImage != "" },
+    "IMPERSONATION_TARGET_SA":      func(c *Config) bool { return c.ImpersonationTargetSA != "" },
+    "REQUIRED_BUCKET":              func(c *Config) bool { return c.RequiredBucket != "" },
+    "REQUIRE_BUCKET_VERSIONING":    func(c *Config) bool { return c.RequireBucketVersioning },
+    "MIN_RETENTION_DAYS":           func(c *Config) bool { return c.MinRetentionDays > 0 },
+    "FILESTORE_INSTANCE":           func(c *Config) bool { return c.FilestoreInstance != "" },
+    "KMS_KEY_NAME":                 func(c *Config) bool { return c.KMSKeyName != "" },
+    "REQUIRED_TAG_BINDINGS":        func(c *Config) bool { return len(c.RequiredTagBindings) > 0 },
+    "CLUSTER_NAME_PREFIX":          func(c *Config) bool { return c.ClusterNamePrefix != "" },
+    "VPC_NAME":                     func(c *Config) bool { return c.VPCName != "" },
+    "SUBNET_NAME":                  func(c *Config) bool { return c.SubnetName != "" },
+    "REQUIRED_MTU":                 func(c *Config) bool { return c.RequiredMTU > 0 },
+    "CHECK_RESTRICTED_VIP":         func(c *Config) bool { return c.CheckRestrictedVIP },
+    "CHECK_PRIVATE_SERVICE_ACCESS": func(c *Config) bool { return c.CheckPrivateServiceAccess },
+    "CLUSTER_CIDR":                 func(c *Config) bool { return c.ClusterCIDR != "" },
+    "DNS_POLICY_NAME":              func(c *Config) bool { return c.DNSPolicyName != "" },
+    "REQUIRE_INBOUND_FORWARDING":   func(c *Config) bool { return c.RequireInboundForwarding },
+    "CHECK_IAM_DENY":               func(c *Config) bool { return c.CheckIAMDeny },
+    "FIREWALL_TARGET_TAG":          func(c *Config) bool { return c.FirewallTargetTag != "" },
+    "REQUIRED_FIREWALL_FLOWS":      func(c *Config) bool { return len(c.RequiredFirewallFlows) > 0 },
+    "REQUIRED_ACCELERATOR_TYPE":    func(c *Config) bool { return c.RequiredAcceleratorType != "" },
+}
+
+// IsSet reports whether the setting named by its env var is configured (non-empty, non-zero, or true)
+// Settings without a Config field fall back to the environment, so new keys work before being modeled
+func (c *Config) IsSet(key string) bool {
+    if present, ok := settingPresence[key]; ok {
+        return present(c)
+    }
+    return c.getenv(key) != ""
+}
+
+// DisableTag adds tag to DisabledTags, ignoring blanks and tags already present
+func (c *Config) DisableTag(tag string) {
+    tag = strings.TrimSpace(tag)
+    if tag == "" || slices.Contains(c.DisabledTags, tag) {
+        return
+    }
+    c.DisabledTags = append(c.DisabledTags, tag)
+}
+
+// DisabledTag returns the first of tags that is in DisabledTags
+func (c *Config) DisabledTag(tags []string) (string, bool) {
+    for _, tag := range tags {
+        if slices.Contains(c.DisabledTags, tag) {
+            return tag, true
+        }
+    }
+    return "", false
+}
+
 // IsValidatorEnabled checks if a validator should run
 // All validators are enabled by default unless explicitly disabled
 func (c *Config) IsValidatorEnabled(name string) bool {
@@ -1,6 +1,11 @@
 package config_test
 
 import (
+    "net/netip"
+    "os"
+    "path/filepath"
+    "time"
+
     . "github.com/onsi/ginkgo/v2"
     . "github.com/onsi/gomega"
 
@@ -11,11 +16,27 @@ var _ = Describe("Config", func() {
     BeforeEach(func() {
         // Clear environment variables - GinkgoT().Setenv automatically restores them
         envVars := []string{
-            "RESULTS_PATH", "PROJECT_ID", "GCP_REGION",
-            "DISABLED_VALIDATORS", "STOP_ON_FIRST_FAILURE",
-            "REQUIRED_APIS", "LOG_LEVEL",
+            "RESULTS_PATH", "RESULTS_HISTORY", "OUTPUT_FORMAT", "PROJECT_ID", "GCP_REGION",
+            "GITHUB_ANNOTATIONS", "RESULTS_CHECKSUM", "RESULTS_WEBHOOK_URL", "RESULTS_WEBHOOK_TIMEOUT_SECONDS", "RESULTS_DESTINATION", "WEBHOOK_REQUIRED", "RESULTS_GCS_URI", "GCS_REQUIRED",
+            "DISABLED_VALIDATORS", "DISABLED_TAGS", "TREAT_EXPERIMENTAL_AS_BLOCKING", "STOP_ON_FIRST_FAILURE", "STOP_LEVEL_ON_FAILURE", "ALLOW_DESTRUCTIVE", "ISOLATE_CONTEXTS", "FAIL_ON_SKIPPED", "ONLY_VALIDATOR",
+            "REQUIRED_APIS", "REQUIRED_APIS_FILE", "FAIL_ON_EMPTY_API_LIST", "AUTO_REQUIRED_APIS", "API_PREREQUISITES", "ACCEPTABLE_API_STATES", "LOG_LEVEL",
             "REQUIRED_VCPUS", "REQUIRED_DISK_GB", "REQUIRED_IP_ADDRESSES",
-            "VPC_NAME", "SUBNET_NAME", "MAX_WAIT_TIME_SECONDS",
+            "MIG_NODE_COUNT", "MIG_NODE_VCPUS", "MIG_MAX_SIZE", "MIG_HEADROOM_PERCENT", "REQUIRED_ADDRESSES",
+            "VPC_NAME", "SUBNET_NAME", "REQUIRED_MTU", "CHECK_RESTRICTED_VIP", "DNS_POLICY_NAME", "REQUIRE_INBOUND_FORWARDING",
+            "REQUIRED_ACCELERATOR_TYPE", "REQUIRED_ACCELERATOR_COUNT", "ACCELERATOR_ZONES", "ACCELERATOR_QUOTA_METRIC", "MAX_WAIT_TIME_SECONDS",
+            "VALIDATOR_TIMEOUT_SECONDS", "SOFT_TIMEOUT_SECONDS", "EXPECTED_VALIDATOR_COUNT", "FAIL_ON_PANIC",
+            "COMPUTE_SERVICE_ACCOUNT", "CA_CERT_FILE",
+            "HTTP_DIAL_TIMEOUT_SECONDS", "HTTP_RESPONSE_HEADER_TIMEOUT_SECONDS", "RETRY_MAX_TOTAL_SECONDS",
+            "RETRYABLE_STATUS_CODES", "SERVICE_SCOPES",
+            "PROGRESS_INTERVAL", "EXPECTED_PARENT", "CHECK_API_PROPAGATION", "CHECK_API_QUOTAS", "CHECK_API_BASELINE", "API_BASELINE",
+            "CHECK_REGION_ZONES", "SSL_CERT_NAME", "LOG_SAMPLE_RATE",
+            "REQUIRE_HYBRID_CONNECTIVITY", "SERVICE_AGENT_ROLES", "REQUIRED_AUDIT_SERVICES", "REQUIRED_LOG_SINK", "LOG_SINK_DESTINATION",
+            "REQUIRED_BUCKET", "BUCKET_IAM_PRINCIPAL", "BUCKET_REQUIRED_ROLES", "REQUIRE_BUCKET_VERSIONING", "MIN_RETENTION_DAYS", "CLUSTER_NAME_PREFIX",
+            "FILESTORE_INSTANCE", "FILESTORE_LOCATION", "INSTANCE_TEMPLATE", "SOURCE_IMAGE",
+            "FIREWALL_TARGET_TAG", "REQUIRED_FIREWALL_FLOWS", "KMS_KEY_NAME", "REQUIRED_TAG_BINDINGS",
+            "CHECK_IAM_DENY", "IAM_DENY_PRINCIPAL", "IAM_DENY_PERMISSIONS", "ROUTER_NAME", "EXPECTED_ROUTER_ASN",
+            "IMPERSONATION_TARGET_SA", "CHECK_PRIVATE_SERVICE_ACCESS", "CLUSTER_CIDR",
+            "BATCH_CONFIG_FILE", "BATCH_EXIT_POLICY", "PROJECT_CONCURRENCY",
         }
         for _, key := range envVars {
             GinkgoT().Setenv(key, "")
@@ -33,8 +54,24 @@ var _ = Describe("Config", func() {
                 Expect(err).NotTo(HaveOccurred())
                 Expect(cfg.ProjectID).To(Equal("test-project-123"))
                 Expect(cfg.ResultsPath).To(Equal("/results/adapter-result.json"))
+                Expect(cfg.ResultsHistory).To(Equal(0))
+                Expect(cfg.OutputFormat).To(Equal(config.OutputFormatFull))
                 Expect(cfg.LogLevel).To(Equal("info"))
                 Expect(cfg.StopOnFirstFailure).To(BeFalse())
+                Expect(cfg.FailOnEmptyAPIList).To(BeFalse())
+                Expect(cfg.AutoRequiredAPIs).To(BeTrue())
+                Expect(cfg.CheckAPIPropagation).To(BeFalse())
+                Expect(cfg.CheckAPIQuotas).To(BeFalse())
+                Expect(cfg.AcceptableAPIStates).To(Equal([]string{"ENABLED"}))
+                Expect(cfg.AllowDestructive).To(BeFalse())
+                Expect(cfg.FailOnSkipped).To(BeFalse())
+                Expect(cfg.FailOnPanic).To(BeTrue())
+                Expect(cfg.TreatExperimentalAsBlocking).To(BeFalse())
+                Expect(cfg.CheckRegionZones).To(BeFalse())
+                Expect(cfg.ProgressIntervalSeconds).To(Equal(30))
+                Expect(cfg.LogSampleRate).To(Equal(0))
+                Expect(cfg.MaxWaitTimeSeconds).To(Equal(300))
+                Expect(cfg.ConfigWarnings).To(BeEmpty())
             })
 
             It("should set default required APIs", func() {
@@ -54,6 +91,36 @@ var _ = Describe("Config", func() {
                 Expect(err).To(HaveOccurred())
                 Expect(err.Error()).To(ContainSubstring("PROJECT_ID is required"))
             })
+
+            It("should not require it in batch mode", func() {
+                GinkgoT().Setenv("BATCH_CONFIG_FILE", "/config/batch.json")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.BatchExitPolicy).To(Equal(config.BatchExitPolicyAny))
+                Expect(cfg.ProjectConcurrency).To(Equal(1))
+            })
+
+            It("should parse PROJECT_CONCURRENCY", func() {
+                GinkgoT().Setenv("BATCH_CONFIG_FILE", "/config/batch.json")
+                GinkgoT().Setenv("PROJECT_CONCURRENCY", "4")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ProjectConcurrency).To(Equal(4))
+            })
+
+            It("should reject a PROJECT_CONCURRENCY below 1", func() {
+                GinkgoT().Setenv("BATCH_CONFIG_FILE", "/config/batch.json")
+                GinkgoT().Setenv("PROJECT_CONCURRENCY", "0")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("PROJECT_CONCURRENCY")))
+            })
+
+            It("should reject an unknown BATCH_EXIT_POLICY", func() {
+                GinkgoT().Setenv("BATCH_CONFIG_FILE", "/config/batch.json")
+                GinkgoT().Setenv("BATCH_EXIT_POLICY", "most")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("BATCH_EXIT_POLICY")))
+            })
         })
 
         Context("with custom configuration", func() {
@@ -63,6 +130,12 @@ var _ = Describe("Config", func() {
                 GinkgoT().Setenv("GCP_REGION", "us-central1")
                 GinkgoT().Setenv("LOG_LEVEL", "debug")
                 GinkgoT().Setenv("STOP_ON_FIRST_FAILURE", "true")
+                GinkgoT().Setenv("STOP_LEVEL_ON_FAILURE", "true")
+                GinkgoT().Setenv("ALLOW_DESTRUCTIVE", "true")
+                GinkgoT().Setenv("FAIL_ON_SKIPPED", "true")
+                GinkgoT().Setenv("FAIL_ON_PANIC", "false")
+                GinkgoT().Setenv("TREAT_EXPERIMENTAL_AS_BLOCKING", "true")
+                GinkgoT().Setenv("ONLY_VALIDATOR", " quota-check ")
             })
 
             It("should load all custom values", func() {
@@ -73,6 +146,12 @@ var _ = Describe("Config", func() {
                 Expect(cfg.GCPRegion).To(Equal("us-central1"))
                 Expect(cfg.LogLevel).To(Equal("debug"))
                 Expect(cfg.StopOnFirstFailure).To(BeTrue())
+                Expect(cfg.StopLevelOnFailure).To(BeTrue())
+                Expect(cfg.AllowDestructive).To(BeTrue())
+                Expect(cfg.FailOnSkipped).To(BeTrue())
+                Expect(cfg.FailOnPanic).To(BeFalse())
+                Expect(cfg.TreatExperimentalAsBlocking).To(BeTrue())
+                Expect(cfg.OnlyValidator).To(Equal("quota-check"))
             })
         })
 
@@ -102,6 +181,33 @@ var _ = Describe("Config", func() {
             })
         })
 
+        Context("with disabled tags", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("DISABLED_TAGS", " slow , network,,slow")
+            })
+
+            It("should parse the tags, trimmed and without blanks or duplicates", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.DisabledTags).To(Equal([]string{"slow", "network"}))
+            })
+
+            It("should add tags disabled later to the parsed ones", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                cfg.DisableTag("post-mvp")
+                cfg.DisableTag("network")
+                Expect(cfg.DisabledTags).To(Equal([]string{"slow", "network", "post-mvp"}))
+
+                tag, ok := cfg.DisabledTag([]string{"mvp", "network"})
+                Expect(ok).To(BeTrue())
+                Expect(tag).To(Equal("network"))
+                _, ok = cfg.DisabledTag([]string{"mvp"})
+                Expect(ok).To(BeFalse())
+            })
+        })
+
         Context("with custom required APIs", func() {
             BeforeEach(func() {
                 GinkgoT().Setenv("PROJECT_ID", "test-project")
@@ -112,6 +218,164 @@ var _ = Describe("Config", func() {
                 cfg, err := config.LoadFromEnv()
                 Expect(err).NotTo(HaveOccurred())
                 Expect(cfg.RequiredAPIs).To(ConsistOf("compute.googleapis.com", "storage.googleapis.com"))
+                Expect(cfg.ConfigWarnings).To(BeEmpty())
+            })
+
+            It("should strip the projects/<project>/services/ prefix of full resource names", func() {
+                GinkgoT().Setenv("REQUIRED_APIS", "projects/my-project/services/compute.googleapis.com, projects/123456/services/iam.googleapis.com,dns.googleapis.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAPIs).To(Equal([]string{"compute.googleapis.com", "iam.googleapis.com", "dns.googleapis.com"}))
+                Expect(cfg.ConfigWarnings).To(BeEmpty())
+            })
+
+            It("should drop duplicates, including ones that only match after normalizing", func() {
+                GinkgoT().Setenv("REQUIRED_APIS", "compute.googleapis.com,iam.googleapis.com, compute.googleapis.com,projects/p/services/iam.googleapis.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAPIs).To(Equal([]string{"compute.googleapis.com", "iam.googleapis.com"}))
+            })
+
+            It("should drop entries that are not googleapis.com services with a warning", func() {
+                GinkgoT().Setenv("REQUIRED_APIS", "compute,compute.googleapis.com, ,services/iam.googleapis.com,example.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAPIs).To(Equal([]string{"compute.googleapis.com"}))
+                Expect(cfg.ConfigWarnings).To(ConsistOf(
+                    ContainSubstring(`REQUIRED_APIS entry "compute" is not a service name`),
+                    ContainSubstring(`REQUIRED_APIS entry "services/iam.googleapis.com" is not a service name`),
+                    ContainSubstring(`REQUIRED_APIS entry "example.com" is not a service name`),
+                ))
+            })
+        })
+
+        Context("with a required APIs file", func() {
+            var path string
+
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                path = filepath.Join(GinkgoT().TempDir(), "apis.txt")
+                Expect(os.WriteFile(path, []byte(
+                    "# Core APIs\n"+
+                        "compute.googleapis.com\n"+
+                        "\n"+
+                        "  storage.googleapis.com  \n"+
+                        "   # indented comment\n"+
+                        "dns.googleapis.com\n"), 0644)).To(Succeed())
+                GinkgoT().Setenv("REQUIRED_APIS_FILE", path)
+            })
+
+            It("should read the file ignoring comments, blank lines and whitespace", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAPIs).To(Equal([]string{
+                    "compute.googleapis.com", "storage.googleapis.com", "dns.googleapis.com",
+                }))
+            })
+
+            It("should merge with REQUIRED_APIS without duplicates", func() {
+                GinkgoT().Setenv("REQUIRED_APIS", "iam.googleapis.com, compute.googleapis.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAPIs).To(Equal([]string{
+                    "iam.googleapis.com", "compute.googleapis.com", "storage.googleapis.com", "dns.googleapis.com",
+                }))
+            })
+
+            It("should normalize file entries like REQUIRED_APIS", func() {
+                Expect(os.WriteFile(path, []byte("projects/my-project/services/compute.googleapis.com\nstorage\n"), 0644)).To(Succeed())
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAPIs).To(Equal([]string{"compute.googleapis.com"}))
+                Expect(cfg.ConfigWarnings).To(ConsistOf(ContainSubstring(`REQUIRED_APIS_FILE entry "storage"`)))
+            })
+
+            It("should return an error when the file cannot be read", func() {
+                GinkgoT().Setenv("REQUIRED_APIS_FILE", filepath.Join(GinkgoT().TempDir(), "missing.txt"))
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("REQUIRED_APIS_FILE")))
+            })
+        })
+
+        Context("with fail on empty API list enabled", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("FAIL_ON_EMPTY_API_LIST", "true")
+            })
+
+            It("should enable the flag", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.FailOnEmptyAPIList).To(BeTrue())
+            })
+        })
+
+        Context("with region zone checks enabled", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("CHECK_REGION_ZONES", "true")
+            })
+
+            It("should enable the flag", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.CheckRegionZones).To(BeTrue())
+            })
+        })
+
+        Context("with API propagation check enabled", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("CHECK_API_PROPAGATION", "true")
+            })
+
+            It("should enable the flag", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.CheckAPIPropagation).To(BeTrue())
+            })
+        })
+
+        Context("with acceptable API states", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("ACCEPTABLE_API_STATES", "enabled, STATE_UNSPECIFIED,")
+            })
+
+            It("should parse, trim and upper-case the list", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.AcceptableAPIStates).To(Equal([]string{"ENABLED", "STATE_UNSPECIFIED"}))
+            })
+        })
+
+        Context("with API quota check enabled", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("CHECK_API_QUOTAS", "true")
+            })
+
+            It("should enable the flag", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.CheckAPIQuotas).To(BeTrue())
+                Expect(cfg.IsSet("CHECK_API_QUOTAS")).To(BeTrue())
+            })
+        })
+
+        Context("with API baseline check enabled", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("CHECK_API_BASELINE", "true")
+                GinkgoT().Setenv("API_BASELINE", " compute.googleapis.com, ,iam.googleapis.com")
+            })
+
+            It("should enable the flag and parse the baseline", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.CheckAPIBaseline).To(BeTrue())
+                Expect(cfg.IsSet("CHECK_API_BASELINE")).To(BeTrue())
+                Expect(cfg.APIBaseline).To(Equal([]string{"compute.googleapis.com", "iam.googleapis.com"}))
             })
         })
 
@@ -121,6 +385,9 @@ var _ = Describe("Config", func() {
                 GinkgoT().Setenv("REQUIRED_VCPUS", "100")
                 GinkgoT().Setenv("REQUIRED_DISK_GB", "500")
                 GinkgoT().Setenv("REQUIRED_IP_ADDRESSES", "10")
+                GinkgoT().Setenv("RESULTS_HISTORY", "5")
+                GinkgoT().Setenv("PROGRESS_INTERVAL", "10")
+                GinkgoT().Setenv("LOG_SAMPLE_RATE", "25")
             })
 
             It("should parse integer values", func() {
@@ -129,9 +396,438 @@ var _ = Describe("Config", func() {
                 Expect(cfg.RequiredVCPUs).To(Equal(100))
                 Expect(cfg.RequiredDiskGB).To(Equal(500))
                 Expect(cfg.RequiredIPAddresses).To(Equal(10))
+                Expect(cfg.ResultsHistory).To(Equal(5))
+                Expect(cfg.ProgressIntervalSeconds).To(Equal(10))
+                Expect(cfg.LogSampleRate).To(Equal(25))
+            })
+        })
+
+        Context("with custom max wait time", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("MAX_WAIT_TIME_SECONDS", "900")
+            })
+
+            It("should use the configured timeout", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.MaxWaitTimeSeconds).To(Equal(900))
+            })
+        })
+
+        Context("with non-positive max wait time", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("MAX_WAIT_TIME_SECONDS", "0")
+            })
+
+            It("should return an error", func() {
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("MAX_WAIT_TIME_SECONDS must be positive")))
+            })
+        })
+
+        Context("with a negative validator timeout", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("VALIDATOR_TIMEOUT_SECONDS", "-1")
+            })
+
+            It("should return an error", func() {
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("VALIDATOR_TIMEOUT_SECONDS must not be negative")))
+            })
+        })
+
+        Context("with a soft timeout", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("MAX_WAIT_TIME_SECONDS", "300")
+            })
+
+            It("should accept one below the hard deadline", func() {
+                GinkgoT().Setenv("SOFT_TIMEOUT_SECONDS", "240")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.SoftTimeoutSeconds).To(Equal(240))
+            })
+
+            It("should reject one at or past the hard deadline", func() {
+                GinkgoT().Setenv("SOFT_TIMEOUT_SECONDS", "300")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("SOFT_TIMEOUT_SECONDS must be between 0 and MAX_WAIT_TIME_SECONDS")))
+            })
+        })
+
+        Context("with IAM deny check config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("CHECK_IAM_DENY", "true")
+                GinkgoT().Setenv("IAM_DENY_PRINCIPAL", "installer@test-project.iam.gserviceaccount.com")
+                GinkgoT().Setenv("IAM_DENY_PERMISSIONS", "compute.instances.create, ,iam.googleapis.com/serviceAccounts.actAs")
+            })
+
+            It("should parse the principal and permissions", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.CheckIAMDeny).To(BeTrue())
+                Expect(cfg.IAMDenyPrincipal).To(Equal("serviceAccount:installer@test-project.iam.gserviceaccount.com"))
+                Expect(cfg.IAMDenyPermissions).To(Equal([]string{"compute.instances.create", "iam.googleapis.com/serviceAccounts.actAs"}))
+                Expect(cfg.IsSet("CHECK_IAM_DENY")).To(BeTrue())
+            })
+
+            It("should keep a principal with a type prefix", func() {
+                GinkgoT().Setenv("IAM_DENY_PRINCIPAL", "user:alice@example.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.IAMDenyPrincipal).To(Equal("user:alice@example.com"))
+            })
+        })
+
+        Context("with an impersonation target", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should parse the service account", func() {
+                GinkgoT().Setenv("IMPERSONATION_TARGET_SA", "installer@test-project.iam.gserviceaccount.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ImpersonationTargetSA).To(Equal("installer@test-project.iam.gserviceaccount.com"))
+                Expect(cfg.IsSet("IMPERSONATION_TARGET_SA")).To(BeTrue())
+            })
+
+            It("should reject a value that is not an email", func() {
+                GinkgoT().Setenv("IMPERSONATION_TARGET_SA", "installer")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("IMPERSONATION_TARGET_SA must be a service account email")))
+            })
+        })
+
+        Context("with an expected validator count", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should default to no check", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ExpectedValidatorCount).To(BeZero())
+            })
+
+            It("should parse the count", func() {
+                GinkgoT().Setenv("EXPECTED_VALIDATOR_COUNT", "20")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ExpectedValidatorCount).To(Equal(20))
+            })
+
+            It("should reject a negative count", func() {
+                GinkgoT().Setenv("EXPECTED_VALIDATOR_COUNT", "-1")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("EXPECTED_VALIDATOR_COUNT must not be negative")))
+            })
+        })
+
+        Context("with bucket policy expectations", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should default to no expectations", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequireBucketVersioning).To(BeFalse())
+                Expect(cfg.MinRetentionDays).To(BeZero())
+                Expect(cfg.IsSet("REQUIRE_BUCKET_VERSIONING")).To(BeFalse())
+                Expect(cfg.IsSet("MIN_RETENTION_DAYS")).To(BeFalse())
+            })
+
+            It("should parse the expectations", func() {
+                GinkgoT().Setenv("REQUIRE_BUCKET_VERSIONING", "true")
+                GinkgoT().Setenv("MIN_RETENTION_DAYS", "30")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequireBucketVersioning).To(BeTrue())
+                Expect(cfg.MinRetentionDays).To(Equal(30))
+            })
+
+            It("should reject a negative retention", func() {
+                GinkgoT().Setenv("MIN_RETENTION_DAYS", "-1")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("MIN_RETENTION_DAYS must not be negative")))
+            })
+        })
+
+        Context("with router BGP config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("ROUTER_NAME", "hybrid-router")
+            })
+
+            It("should parse a 4-byte ASN", func() {
+                GinkgoT().Setenv("EXPECTED_ROUTER_ASN", "4200000000")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ExpectedRouterASN).To(Equal(int64(4200000000)))
+                Expect(cfg.IsSet("EXPECTED_ROUTER_ASN")).To(BeTrue())
+            })
+
+            It("should reject an ASN outside the 32-bit range", func() {
+                GinkgoT().Setenv("EXPECTED_ROUTER_ASN", "4294967296")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("EXPECTED_ROUTER_ASN")))
+            })
+        })
+
+        Context("with bucket IAM config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("REQUIRED_BUCKET", "installer-bucket")
+                GinkgoT().Setenv("BUCKET_IAM_PRINCIPAL", "installer@test-project.iam.gserviceaccount.com")
+            })
+
+            It("should default the required roles and prefix a bare principal", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredBucket).To(Equal("installer-bucket"))
+                Expect(cfg.BucketIAMPrincipal).To(Equal("serviceAccount:installer@test-project.iam.gserviceaccount.com"))
+                Expect(cfg.BucketRequiredRoles).To(Equal([]string{"roles/storage.objectAdmin"}))
+                Expect(cfg.IsSet("REQUIRED_BUCKET")).To(BeTrue())
+            })
+
+            It("should parse custom required roles", func() {
+                GinkgoT().Setenv("BUCKET_REQUIRED_ROLES", "roles/storage.objectViewer, roles/storage.legacyBucketWriter")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.BucketRequiredRoles).To(Equal([]string{"roles/storage.objectViewer", "roles/storage.legacyBucketWriter"}))
+            })
+        })
+
+        Context("with API prerequisites", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should default to the built-in prerequisites", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.APIPrerequisites).To(Equal(config.DefaultAPIPrerequisites()))
+                Expect(cfg.APIPrerequisites).To(HaveKeyWithValue("container.googleapis.com", []string{"compute.googleapis.com"}))
+            })
+
+            It("should replace them with API_PREREQUISITES", func() {
+                GinkgoT().Setenv("API_PREREQUISITES",
+                    "container.googleapis.com=compute.googleapis.com, container.googleapis.com=gkehub.googleapis.com")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.APIPrerequisites).To(Equal(map[string][]string{
+                    "container.googleapis.com": {"compute.googleapis.com", "gkehub.googleapis.com"},
+                }))
+            })
+
+            It("should reject malformed entries", func() {
+                GinkgoT().Setenv("API_PREREQUISITES", "container.googleapis.com")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("API_PREREQUISITES")))
+            })
+        })
+
+        Context("with service agent roles", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("SERVICE_AGENT_ROLES",
+                    "compute.googleapis.com=roles/compute.serviceAgent, container.googleapis.com=roles/container.serviceAgent,container.googleapis.com=roles/container.hostServiceAgentUser")
+            })
+
+            It("should group roles by service", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ServiceAgentRoles).To(Equal(map[string][]string{
+                    "compute.googleapis.com":   {"roles/compute.serviceAgent"},
+                    "container.googleapis.com": {"roles/container.serviceAgent", "roles/container.hostServiceAgentUser"},
+                }))
+                Expect(cfg.IsSet("SERVICE_AGENT_ROLES")).To(BeTrue())
+            })
+
+            It("should reject malformed entries", func() {
+                GinkgoT().Setenv("SERVICE_AGENT_ROLES", "compute.googleapis.com")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("SERVICE_AGENT_ROLES")))
+            })
+        })
+
+        Context("with required audit services", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("REQUIRED_AUDIT_SERVICES",
+                    "storage.googleapis.com, iam.googleapis.com=admin_read,iam.googleapis.com=DATA_WRITE")
+            })
+
+            It("should default bare services to the Data Access log types", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAuditServices).To(Equal(map[string][]string{
+                    "storage.googleapis.com": {"DATA_READ", "DATA_WRITE"},
+                    "iam.googleapis.com":     {"ADMIN_READ", "DATA_WRITE"},
+                }))
+                Expect(cfg.IsSet("REQUIRED_AUDIT_SERVICES")).To(BeTrue())
+            })
+
+            It("should reject unknown log types", func() {
+                GinkgoT().Setenv("REQUIRED_AUDIT_SERVICES", "storage.googleapis.com=DATA_DELETE")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("DATA_DELETE")))
+            })
+        })
+
+        Context("with required firewall flows", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("REQUIRED_FIREWALL_FLOWS", "TCP:6443, udp:53@10.1.2.0/16,icmp@10.0.0.5")
+            })
+
+            It("should parse protocol, port and source", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredFirewallFlows).To(Equal([]config.FirewallFlow{
+                    {Protocol: "tcp", Port: 6443, Source: netip.MustParsePrefix("0.0.0.0/0")},
+                    {Protocol: "udp", Port: 53, Source: netip.MustParsePrefix("10.1.0.0/16")},
+                    {Protocol: "icmp", Source: netip.MustParsePrefix("10.0.0.5/32")},
+                }))
+                Expect(cfg.RequiredFirewallFlows[1].String()).To(Equal("udp:53@10.1.0.0/16"))
+                Expect(cfg.IsSet("REQUIRED_FIREWALL_FLOWS")).To(BeTrue())
+            })
+
+            It("should require a port for tcp", func() {
+                GinkgoT().Setenv("REQUIRED_FIREWALL_FLOWS", "tcp@10.0.0.0/8")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("must name a port")))
+            })
+
+            It("should reject an invalid source", func() {
+                GinkgoT().Setenv("REQUIRED_FIREWALL_FLOWS", "tcp:443@somewhere")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("invalid source range")))
+            })
+        })
+
+        Context("with required tag bindings", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should parse value IDs and namespaced names", func() {
+                GinkgoT().Setenv("REQUIRED_TAG_BINDINGS", "tagValues/123, 456/env/prod,,456/cost-center")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredTagBindings).To(Equal([]string{"tagValues/123", "456/env/prod", "456/cost-center"}))
+                Expect(cfg.IsSet("REQUIRED_TAG_BINDINGS")).To(BeTrue())
+            })
+
+            It("should reject malformed entries", func() {
+                for _, entry := range []string{"prod", "456/env/prod/extra", "456//prod", "tagKeys/789"} {
+                    GinkgoT().Setenv("REQUIRED_TAG_BINDINGS", entry)
+                    _, err := config.LoadFromEnv()
+                    Expect(err).To(MatchError(ContainSubstring("REQUIRED_TAG_BINDINGS entry")), entry)
+                }
+            })
+        })
+
+        Context("with summary output format", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("OUTPUT_FORMAT", "Summary")
+            })
+
+            It("should accept it case-insensitively", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.OutputFormat).To(Equal(config.OutputFormatSummary))
             })
         })
 
+        Context("with unknown output format", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("OUTPUT_FORMAT", "yaml")
+            })
+
+            It("should return an error", func() {
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("OUTPUT_FORMAT")))
+            })
+        })
+
+        Context("with a results webhook", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("RESULTS_WEBHOOK_URL", "https://results.example.com/hook")
+            })
+
+            It("should default to writing the file and posting", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ResultsDestination).To(Equal(config.ResultsDestinationBoth))
+                Expect(cfg.ResultsWebhookTimeoutSeconds).To(Equal(10))
+                Expect(cfg.WebhookRequired).To(BeFalse())
+            })
+
+            It("should accept webhook as the only destination", func() {
+                GinkgoT().Setenv("RESULTS_DESTINATION", "Webhook")
+                GinkgoT().Setenv("WEBHOOK_REQUIRED", "true")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ResultsDestination).To(Equal(config.ResultsDestinationWebhook))
+                Expect(cfg.WebhookRequired).To(BeTrue())
+            })
+
+            It("should reject an unknown destination", func() {
+                GinkgoT().Setenv("RESULTS_DESTINATION", "s3")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("RESULTS_DESTINATION")))
+            })
+        })
+
+        Context("with a webhook destination but no URL", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("RESULTS_DESTINATION", "webhook")
+            })
+
+            It("should return an error", func() {
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("RESULTS_WEBHOOK_URL")))
+            })
+        })
+
+        Context("with a results upload to Cloud Storage", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should split the URI into bucket and object", func() {
+                GinkgoT().Setenv("RESULTS_GCS_URI", "gs://results-bucket/runs/test-project.json")
+                GinkgoT().Setenv("GCS_REQUIRED", "true")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ResultsGCSBucket).To(Equal("results-bucket"))
+                Expect(cfg.ResultsGCSObject).To(Equal("runs/test-project.json"))
+                Expect(cfg.GCSRequired).To(BeTrue())
+            })
+
+            DescribeTable("should reject URIs without a bucket and object",
+                func(uri string) {
+                    GinkgoT().Setenv("RESULTS_GCS_URI", uri)
+                    _, err := config.LoadFromEnv()
+                    Expect(err).To(MatchError(ContainSubstring("RESULTS_GCS_URI")))
+                },
+                Entry("not gs://", "https://storage.googleapis.com/results-bucket/results.json"),
+                Entry("bucket only", "gs://results-bucket"),
+                Entry("folder", "gs://results-bucket/runs/"),
+            )
+        })
+
         Context("with invalid integer values", func() {
             BeforeEach(func() {
                 GinkgoT().Setenv("PROJECT_ID", "test-project")
@@ -143,6 +839,12 @@ var _ = Describe("Config", func() {
                 Expect(err).NotTo(HaveOccurred())
                 Expect(cfg.RequiredVCPUs).To(Equal(0))
             })
+
+            It("should report the ignored value in ConfigWarnings", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ConfigWarnings).To(ConsistOf(`REQUIRED_VCPUS="not-a-number" is not an integer, using default 0`))
+            })
         })
 
         Context("with invalid boolean values", func() {
@@ -156,6 +858,77 @@ var _ = Describe("Config", func() {
                 Expect(err).NotTo(HaveOccurred())
                 Expect(cfg.StopOnFirstFailure).To(BeFalse())
             })
+
+            It("should report the ignored value in ConfigWarnings", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ConfigWarnings).To(ConsistOf(`STOP_ON_FIRST_FAILURE="not-a-bool" is not a boolean, using default false`))
+            })
+        })
+
+        Context("with HTTP transport config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("HTTP_DIAL_TIMEOUT_SECONDS", "5")
+                GinkgoT().Setenv("HTTP_RESPONSE_HEADER_TIMEOUT_SECONDS", "20")
+                GinkgoT().Setenv("CA_CERT_FILE", "/etc/pki/proxy-ca.pem")
+                GinkgoT().Setenv("RETRY_MAX_TOTAL_SECONDS", "45")
+            })
+
+            It("should load transport settings", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.HTTPDialTimeoutSeconds).To(Equal(5))
+                Expect(cfg.HTTPResponseHeaderTimeoutSeconds).To(Equal(20))
+                Expect(cfg.CACertFile).To(Equal("/etc/pki/proxy-ca.pem"))
+                Expect(cfg.RetryMaxTotalSeconds).To(Equal(45))
+                Expect(cfg.RetryableStatusCodes).To(BeNil())
+            })
+
+            It("should parse retryable status codes", func() {
+                GinkgoT().Setenv("RETRYABLE_STATUS_CODES", "429, 502,503")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RetryableStatusCodes).To(Equal([]int{429, 502, 503}))
+            })
+
+            It("should reject an invalid retryable status code", func() {
+                GinkgoT().Setenv("RETRYABLE_STATUS_CODES", "429,bad")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("RETRYABLE_STATUS_CODES")))
+            })
+        })
+
+        Context("with service scopes", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should keep the default scopes when unset", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ServiceScopes).To(BeNil())
+            })
+
+            It("should parse scopes per API, expanding short names", func() {
+                GinkgoT().Setenv("SERVICE_SCOPES",
+                    "iam.googleapis.com=cloud-platform, compute.googleapis.com=https://www.googleapis.com/auth/compute.readonly,compute.googleapis.com=https://example.com/custom-audience,iam.googleapis.com=cloud-platform")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ServiceScopes).To(Equal(map[string][]string{
+                    "iam.googleapis.com": {"https://www.googleapis.com/auth/cloud-platform"},
+                    "compute.googleapis.com": {
+                        "https://www.googleapis.com/auth/compute.readonly",
+                        "https://example.com/custom-audience",
+                    },
+                }))
+            })
+
+            It("should reject an entry without a scope", func() {
+                GinkgoT().Setenv("SERVICE_SCOPES", "compute.googleapis.com")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("SERVICE_SCOPES entry \"compute.googleapis.com\" must be <api>=<scope>")))
+            })
         })
 
         Context("with network validator config", func() {
@@ -163,6 +936,8 @@ var _ = Describe("Config", func() {
                 GinkgoT().Setenv("PROJECT_ID", "test-project")
                 GinkgoT().Setenv("VPC_NAME", "my-vpc")
                 GinkgoT().Setenv("SUBNET_NAME", "my-subnet")
+                GinkgoT().Setenv("REQUIRED_MTU", "1460")
+                GinkgoT().Setenv("EXPECTED_PARENT", "folders/123")
             })
 
             It("should load network configuration", func() {
@@ -170,7 +945,211 @@ var _ = Describe("Config", func() {
                 Expect(err).NotTo(HaveOccurred())
                 Expect(cfg.VPCName).To(Equal("my-vpc"))
                 Expect(cfg.SubnetName).To(Equal("my-subnet"))
+                Expect(cfg.RequiredMTU).To(Equal(1460))
+                Expect(cfg.IsSet("REQUIRED_MTU")).To(BeTrue())
+                Expect(cfg.ExpectedParent).To(Equal("folders/123"))
+            })
+        })
+
+        Context("with a cluster CIDR", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+            })
+
+            It("should load it", func() {
+                GinkgoT().Setenv("CLUSTER_CIDR", " 10.128.0.0/14 ")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ClusterCIDR).To(Equal("10.128.0.0/14"))
+                Expect(cfg.IsSet("CLUSTER_CIDR")).To(BeTrue())
             })
+
+            It("should reject a value that is not a CIDR range", func() {
+                GinkgoT().Setenv("CLUSTER_CIDR", "10.128.0.0")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("CLUSTER_CIDR")))
+            })
+        })
+
+        Context("with MIG capacity config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("MIG_NODE_COUNT", "100")
+            })
+
+            It("should default the headroom to 10 percent", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.MIGNodeCount).To(Equal(100))
+                Expect(cfg.MIGHeadroomPercent).To(Equal(10))
+                Expect(cfg.IsSet("MIG_NODE_COUNT")).To(BeTrue())
+                Expect(cfg.IsSet("MIG_MAX_SIZE")).To(BeFalse())
+            })
+
+            It("should reject a headroom of 100 percent or more", func() {
+                GinkgoT().Setenv("MIG_HEADROOM_PERCENT", "100")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("MIG_HEADROOM_PERCENT")))
+            })
+
+            It("should reject negative sizes", func() {
+                GinkgoT().Setenv("MIG_NODE_VCPUS", "-4")
+                _, err := config.LoadFromEnv()
+                Expect(err).To(MatchError(ContainSubstring("must not be negative")))
+            })
+        })
+
+        Context("with log sink config", func() {
+            It("should load the sink and its expected destination", func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("REQUIRED_LOG_SINK", "audit-export")
+                GinkgoT().Setenv("LOG_SINK_DESTINATION", "storage.googleapis.com/audit-logs")
+
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredLogSink).To(Equal("audit-export"))
+                Expect(cfg.LogSinkDestination).To(Equal("storage.googleapis.com/audit-logs"))
+                Expect(cfg.IsSet("REQUIRED_LOG_SINK")).To(BeTrue())
+            })
+        })
+
+        Context("with DNS policy config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("DNS_POLICY_NAME", "hybrid-dns")
+                GinkgoT().Setenv("REQUIRE_INBOUND_FORWARDING", "true")
+            })
+
+            It("should load the policy name and forwarding expectation", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.DNSPolicyName).To(Equal("hybrid-dns"))
+                Expect(cfg.RequireInboundForwarding).To(BeTrue())
+                Expect(cfg.IsSet("DNS_POLICY_NAME")).To(BeTrue())
+                Expect(cfg.IsSet("REQUIRE_INBOUND_FORWARDING")).To(BeTrue())
+            })
+        })
+
+        Context("with SSL certificate config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("SSL_CERT_NAME", "ingress-cert")
+            })
+
+            It("should load the certificate name", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.SSLCertName).To(Equal("ingress-cert"))
+                Expect(cfg.IsSet("SSL_CERT_NAME")).To(BeTrue())
+            })
+        })
+
+        Context("with accelerator config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("REQUIRED_ACCELERATOR_TYPE", "nvidia-tesla-t4")
+            })
+
+            It("should default to one accelerator in every zone", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAcceleratorType).To(Equal("nvidia-tesla-t4"))
+                Expect(cfg.RequiredAcceleratorCount).To(Equal(1))
+                Expect(cfg.AcceleratorZones).To(BeEmpty())
+                Expect(cfg.IsSet("REQUIRED_ACCELERATOR_TYPE")).To(BeTrue())
+            })
+
+            It("should parse count, zones and quota metric", func() {
+                GinkgoT().Setenv("REQUIRED_ACCELERATOR_COUNT", "4")
+                GinkgoT().Setenv("ACCELERATOR_ZONES", "us-central1-a, us-central1-b")
+                GinkgoT().Setenv("ACCELERATOR_QUOTA_METRIC", "preemptible_nvidia_t4_gpus")
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.RequiredAcceleratorCount).To(Equal(4))
+                Expect(cfg.AcceleratorZones).To(Equal([]string{"us-central1-a", "us-central1-b"}))
+                Expect(cfg.AcceleratorQuotaMetric).To(Equal("PREEMPTIBLE_NVIDIA_T4_GPUS"))
+            })
+        })
+
+        Context("with conflict check config", func() {
+            BeforeEach(func() {
+                GinkgoT().Setenv("PROJECT_ID", "test-project")
+                GinkgoT().Setenv("CLUSTER_NAME_PREFIX", "prod-a")
+            })
+
+            It("should load the cluster name prefix", func() {
+                cfg, err := config.LoadFromEnv()
+                Expect(err).NotTo(HaveOccurred())
+                Expect(cfg.ClusterNamePrefix).To(Equal("prod-a"))
+                Expect(cfg.IsSet("CLUSTER_NAME_PREFIX")).To(BeTrue())
+            })
+        })
+    })
+
+    Describe("IsSet", func() {
+        var cfg *config.Config
+
+        BeforeEach(func() {
+            GinkgoT().Setenv("PROJECT_ID", "test-project")
+            var err error
+            cfg, err = config.LoadFromEnv()
+            Expect(err).NotTo(HaveOccurred())
+        })
+
+        It("should report modeled settings from the config", func() {
+            Expect(cfg.IsSet("EXPECTED_PARENT")).To(BeFalse())
+            Expect(cfg.IsSet("REQUIRED_VCPUS")).To(BeFalse())
+
+            cfg.ExpectedParent = "folders/123"
+            cfg.RequiredVCPUs = 8
+            Expect(cfg.IsSet("EXPECTED_PARENT")).To(BeTrue())
+            Expect(cfg.IsSet("REQUIRED_VCPUS")).To(BeTrue())
+        })
+
+        It("should fall back to the environment for other keys", func() {
+            GinkgoT().Setenv("SOME_FUTURE_SETTING", "")
+            Expect(cfg.IsSet("SOME_FUTURE_SETTING")).To(BeFalse())
+            GinkgoT().Setenv("SOME_FUTURE_SETTING", "value")
+            Expect(cfg.IsSet("SOME_FUTURE_SETTING")).To(BeTrue())
+        })
+    })
+
+    Describe("GetValidatorString", func() {
+        BeforeEach(func() {
+            GinkgoT().Setenv("VALIDATOR_NETWORK_CHECK_VPC_NAME", "")
+        })
+
+        It("should build the namespaced key from the validator name", func() {
+            Expect(config.ValidatorEnvKey("network-check", "VPC_NAME")).To(Equal("VALIDATOR_NETWORK_CHECK_VPC_NAME"))
+        })
+
+        It("should prefer the namespaced variable", func() {
+            GinkgoT().Setenv("VPC_NAME", "generic-vpc")
+            GinkgoT().Setenv("VALIDATOR_NETWORK_CHECK_VPC_NAME", "scoped-vpc")
+            Expect(config.GetValidatorString("network-check", "VPC_NAME", "default-vpc")).To(Equal("scoped-vpc"))
+        })
+
+        It("should fall back to the generic variable", func() {
+            GinkgoT().Setenv("VPC_NAME", "generic-vpc")
+            Expect(config.GetValidatorString("network-check", "VPC_NAME", "default-vpc")).To(Equal("generic-vpc"))
+        })
+
+        It("should fall back to the default when neither is set", func() {
+            Expect(config.GetValidatorString("network-check", "VPC_NAME", "default-vpc")).To(Equal("default-vpc"))
+        })
+    })
+
+    Describe("GetValidatorTimeout", func() {
+        It("should read the namespaced timeout in seconds", func() {
+            GinkgoT().Setenv("VALIDATOR_API_ENABLED_TIMEOUT_SECONDS", "45")
+            Expect(config.GetValidatorTimeout("api-enabled")).To(Equal(45 * time.Second))
+        })
+
+        It("should return zero when unset or not positive", func() {
+            GinkgoT().Setenv("VALIDATOR_API_ENABLED_TIMEOUT_SECONDS", "")
+            Expect(config.GetValidatorTimeout("api-enabled")).To(BeZero())
+            GinkgoT().Setenv("VALIDATOR_API_ENABLED_TIMEOUT_SECONDS", "0")
+            Expect(config.GetValidatorTimeout("api-enabled")).To(BeZero())
         })
     })
 
@@ -0,0 +1,709 @@
+package gcp
+
+import (
+    "bytes"
+    "context"
+    "net/url"
+
+    "cloud.google.com/go/compute/metadata"
+    "google.golang.org/api/cloudkms/v1"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/dns/v1"
+    file "google.golang.org/api/file/v1"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/iam/v1"
+    iamv2 "google.golang.org/api/iam/v2"
+    "google.golang.org/api/iamcredentials/v1"
+    logging "google.golang.org/api/logging/v2"
+    "google.golang.org/api/servicenetworking/v1"
+    "google.golang.org/api/serviceusage/v1"
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+    "google.golang.org/api/storage/v1"
+)
+
+// ServiceUsageAPI is the subset of Service Usage operations used by validators
+// Validators depend on this interface rather than *serviceusage.Service so tests can inject fakes
+type ServiceUsageAPI interface {
+    // GetService returns the service state, name is "projects/<project>/services/<api>"
+    GetService(ctx context.Context, name string) (*serviceusage.GoogleApiServiceusageV1Service, error)
+    // ListEnabledServices returns every enabled service of a parent, e.g. "projects/<project>"
+    ListEnabledServices(ctx context.Context, parent string) ([]*serviceusage.GoogleApiServiceusageV1Service, error)
+}
+
+// ServiceQuotaAPI is the subset of Service Usage v1beta1 operations used to read per-API consumer quotas
+// Consumer quota metrics are only exposed by the v1beta1 surface
+type ServiceQuotaAPI interface {
+    // ListConsumerQuotaMetrics returns every quota metric of a service, parent is "projects/<project>/services/<api>"
+    ListConsumerQuotaMetrics(ctx context.Context, parent string) ([]*serviceusagebeta.ConsumerQuotaMetric, error)
+}
+
+// ComputeAPI is the subset of Compute Engine operations used by validators
+type ComputeAPI interface {
+    // GetProject returns the project resource including global quotas
+    GetProject(ctx context.Context, project string) (*compute.Project, error)
+
+    // GetRegion returns the region resource including regional quotas
+    GetRegion(ctx context.Context, project, region string) (*compute.Region, error)
+
+    // GetZone returns the zone resource including its status
+    GetZone(ctx context.Context, project, zone string) (*compute.Zone, error)
+
+    // GetSslCertificate returns a global SSL certificate resource
+    GetSslCertificate(ctx context.Context, project, name string) (*compute.SslCertificate, error)
+
+    // GetAcceleratorType returns an accelerator type offered in a zone
+    GetAcceleratorType(ctx context.Context, project, zone, name string) (*compute.AcceleratorType, error)
+
+    // GetNetwork returns a VPC network resource
+    GetNetwork(ctx context.Context, project, name string) (*compute.Network, error)
+
+    // GetAddress returns a regional static IP address resource
+    GetAddress(ctx context.Context, project, region, name string) (*compute.Address, error)
+
+    // ListAddresses returns the static IP addresses of a region
+    ListAddresses(ctx context.Context, project, region string) ([]*compute.Address, error)
+
+    // ListGlobalAddresses returns the project's global addresses, including ranges allocated for VPC peering
+    ListGlobalAddresses(ctx context.Context, project string) ([]*compute.Address, error)
+
+    // GetRouter returns a regional Cloud Router resource
+    GetRouter(ctx context.Context, project, region, name string) (*compute.Router, error)
+
+    // GetRouterStatus returns the runtime status of a Cloud Router, including its BGP sessions
+    GetRouterStatus(ctx context.Context, project, region, name string) (*compute.RouterStatusResponse, error)
+
+    // ListVpnTunnels returns the Cloud VPN tunnels of every region
+    ListVpnTunnels(ctx context.Context, project string) ([]*compute.VpnTunnel, error)
+
+    // ListInterconnectAttachments returns the Interconnect VLAN attachments of every region
+    ListInterconnectAttachments(ctx context.Context, project string) ([]*compute.InterconnectAttachment, error)
+
+    // ListInstances returns the VM instances of every zone
+    ListInstances(ctx context.Context, project string) ([]*compute.Instance, error)
+
+    // ListDisks returns the persistent disks of every zone
+    ListDisks(ctx context.Context, project string) ([]*compute.Disk, error)
+
+    // ListNetworks returns the project's VPC networks
+    ListNetworks(ctx context.Context, project string) ([]*compute.Network, error)
+
+    // ListSubnetworks returns the subnetworks of every region
+    ListSubnetworks(ctx context.Context, project string) ([]*compute.Subnetwork, error)
+
+    // ListRoutes returns the project's routes across all VPC networks
+    ListRoutes(ctx context.Context, project string) ([]*compute.Route, error)
+
+    // ListFirewalls returns the project's VPC firewall rules across all networks
+    ListFirewalls(ctx context.Context, project string) ([]*compute.Firewall, error)
+
+    // GetInstanceTemplate returns a global instance template resource
+    GetInstanceTemplate(ctx context.Context, project, name string) (*compute.InstanceTemplate, error)
+
+    // GetMachineType returns a machine type offered in a zone
+    GetMachineType(ctx context.Context, project, zone, name string) (*compute.MachineType, error)
+
+    // GetImage returns an image; project is the image's project, e.g. "rhcos-cloud"
+    GetImage(ctx context.Context, project, name string) (*compute.Image, error)
+
+    // GetImageFromFamily returns the latest non-deprecated image of an image family
+    GetImageFromFamily(ctx context.Context, project, family string) (*compute.Image, error)
+}
+
+// ResourceManagerAPI is the subset of Cloud Resource Manager operations used by validators
+type ResourceManagerAPI interface {
+    // GetProject returns the project metadata (number, parent, lifecycle state)
+    GetProject(ctx context.Context, projectID string) (*cloudresourcemanager.Project, error)
+
+    // GetIamPolicy returns the project-level IAM policy
+    GetIamPolicy(ctx context.Context, projectID string) (*cloudresourcemanager.Policy, error)
+
+    // GetAncestry returns the project followed by its folders and organization, nearest first
+    GetAncestry(ctx context.Context, projectID string) ([]*cloudresourcemanager.Ancestor, error)
+
+    // GetEffectiveOrgPolicy returns the org policy in effect for a constraint on the project,
+    // merged down the hierarchy, e.g. constraint "constraints/compute.requireShieldedVm"
+    GetEffectiveOrgPolicy(ctx context.Context, projectID, constraint string) (*cloudresourcemanager.OrgPolicy, error)
+}
+
+// IAMAPI is the subset of IAM operations used by validators
+type IAMAPI interface {
+    // GetServiceAccount returns a service account, name is "projects/<project>/serviceAccounts/<email>"
+    GetServiceAccount(ctx context.Context, name string) (*iam.ServiceAccount, error)
+}
+
+// StorageAPI is the subset of Cloud Storage operations used by validators
+type StorageAPI interface {
+    // GetBucketIamPolicy returns the IAM policy of a bucket
+    GetBucketIamPolicy(ctx context.Context, bucket string) (*storage.Policy, error)
+    // GetBucket returns a bucket's metadata, including its versioning and retention settings
+    GetBucket(ctx context.Context, bucket string) (*storage.Bucket, error)
+}
+
+// StorageWriterAPI uploads objects to Cloud Storage; only the results upload uses it
+type StorageWriterAPI interface {
+    // UploadObject creates or replaces an object with data and optional custom metadata
+    UploadObject(ctx context.Context, bucket, name, contentType string, data []byte, metadata map[string]string) error
+}
+
+// FilestoreAPI is the subset of Filestore operations used by validators
+type FilestoreAPI interface {
+    // GetInstance returns a Filestore instance by its full resource name
+    GetInstance(ctx context.Context, name string) (*file.Instance, error)
+}
+
+// KMSAPI is the subset of Cloud KMS operations used by validators
+type KMSAPI interface {
+    // GetCryptoKey returns a key, name is "projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>"
+    GetCryptoKey(ctx context.Context, name string) (*cloudkms.CryptoKey, error)
+
+    // GetCryptoKeyIamPolicy returns the IAM policy set on a key
+    GetCryptoKeyIamPolicy(ctx context.Context, name string) (*cloudkms.Policy, error)
+}
+
+// IAMDenyAPI is the subset of IAM v2 deny policy operations used by validators
+type IAMDenyAPI interface {
+    // ListDenyPolicies returns the deny policies attached to a resource, without their rules;
+    // attachmentPoint is a full resource name, e.g. "cloudresourcemanager.googleapis.com/projects/<project>"
+    ListDenyPolicies(ctx context.Context, attachmentPoint string) ([]*iamv2.GoogleIamV2Policy, error)
+
+    // GetDenyPolicy returns a deny policy with its rules, name is as returned by ListDenyPolicies
+    GetDenyPolicy(ctx context.Context, name string) (*iamv2.GoogleIamV2Policy, error)
+}
+
+// ServiceNetworkingAPI is the subset of Service Networking operations used by validators
+type ServiceNetworkingAPI interface {
+    // ListConnections returns the private service access connections of a VPC network,
+    // network is "projects/<project-number>/global/networks/<name>"
+    ListConnections(ctx context.Context, network string) ([]*servicenetworking.Connection, error)
+}
+
+// IAMCredentialsAPI is the subset of IAM Credentials operations used by validators
+type IAMCredentialsAPI interface {
+    // GenerateAccessToken mints a short-lived token for a service account the caller impersonates,
+    // name is "projects/-/serviceAccounts/<email>" and lifetime a duration such as "300s"
+    GenerateAccessToken(ctx context.Context, name string, scopes []string, lifetime string) (*iamcredentials.GenerateAccessTokenResponse, error)
+}
+
+// LoggingAPI is the subset of Cloud Logging operations used by validators
+type LoggingAPI interface {
+    // ListSinks returns every log sink of parent, e.g. "projects/<project>", all pages included
+    ListSinks(ctx context.Context, parent string) ([]*logging.LogSink, error)
+}
+
+// MetadataAPI is the subset of the Compute Engine metadata server used by validators
+// The metadata server needs no credentials, so it is not created through the ClientFactory
+type MetadataAPI interface {
+    // OnGCE reports whether the process runs on Compute Engine, GKE included; the probe result is cached
+    OnGCE() bool
+    // ProjectID returns the project the instance or node runs in
+    ProjectID(ctx context.Context) (string, error)
+}
+
+// DNSAPI is the subset of Cloud DNS operations used by validators
+type DNSAPI interface {
+    // GetPolicy returns a DNS server policy of a project
+    GetPolicy(ctx context.Context, project, name string) (*dns.Policy, error)
+}
+
+// TagsAPI is the subset of Resource Manager v3 tag operations used by validators
+type TagsAPI interface {
+    // ListTagBindings returns the tags bound directly to a resource,
+    // parent is a full resource name, e.g. "//cloudresourcemanager.googleapis.com/projects/<number>"
+    ListTagBindings(ctx context.Context, parent string) ([]*crmv3.TagBinding, error)
+}
+
+// serviceUsageClient is the default ServiceUsageAPI backed by the real client
+type serviceUsageClient struct {
+    svc *serviceusage.Service
+}
+
+// NewServiceUsageAPI wraps a Service Usage client in the ServiceUsageAPI interface
+func NewServiceUsageAPI(svc *serviceusage.Service) ServiceUsageAPI {
+    return &serviceUsageClient{svc: svc}
+}
+
+// GetService returns the state of a single service
+func (c *serviceUsageClient) GetService(ctx context.Context, name string) (*serviceusage.GoogleApiServiceusageV1Service, error) {
+    return c.svc.Services.Get(name).Context(ctx).Do()
+}
+
+// ListEnabledServices returns the enabled services of a parent, following pagination
+func (c *serviceUsageClient) ListEnabledServices(ctx context.Context, parent string) ([]*serviceusage.GoogleApiServiceusageV1Service, error) {
+    var services []*serviceusage.GoogleApiServiceusageV1Service
+    err := c.svc.Services.List(parent).Filter("state:ENABLED").Pages(ctx,
+        func(page *serviceusage.ListServicesResponse) error {
+            services = append(services, page.Services...)
+            return nil
+        })
+    return services, err
+}
+
+// serviceQuotaClient is the default ServiceQuotaAPI backed by the real v1beta1 client
+type serviceQuotaClient struct {
+    svc *serviceusagebeta.APIService
+}
+
+// NewServiceQuotaAPI wraps a Service Usage v1beta1 client in the ServiceQuotaAPI interface
+func NewServiceQuotaAPI(svc *serviceusagebeta.APIService) ServiceQuotaAPI {
+    return &serviceQuotaClient{svc: svc}
+}
+
+// ListConsumerQuotaMetrics returns all quota metrics of a service, following pagination
+func (c *serviceQuotaClient) ListConsumerQuotaMetrics(ctx context.Context, parent string) ([]*serviceusagebeta.ConsumerQuotaMetric, error) {
+    var metrics []*serviceusagebeta.ConsumerQuotaMetric
+    err := c.svc.Services.ConsumerQuotaMetrics.List(parent).View("BASIC").Pages(ctx,
+        func(page *serviceusagebeta.ListConsumerQuotaMetricsResponse) error {
+            metrics = append(metrics, page.Metrics...)
+            return nil
+        })
+    return metrics, err
+}
+
+// computeClient is the default ComputeAPI backed by the real client
+type computeClient struct {
+    svc *compute.Service
+}
+
+// NewComputeAPI wraps a Compute Engine client in the ComputeAPI interface
+func NewComputeAPI(svc *compute.Service) ComputeAPI {
+    return &computeClient{svc: svc}
+}
+
+// GetProject returns the project resource
+func (c *computeClient) GetProject(ctx context.Context, project string) (*compute.Project, error) {
+    return c.svc.Projects.Get(project).Context(ctx).Do()
+}
+
+// GetRegion returns the region resource
+func (c *computeClient) GetRegion(ctx context.Context, project, region string) (*compute.Region, error) {
+    return c.svc.Regions.Get(project, region).Context(ctx).Do()
+}
+
+// GetZone returns the zone resource
+func (c *computeClient) GetZone(ctx context.Context, project, zone string) (*compute.Zone, error) {
+    return c.svc.Zones.Get(project, zone).Context(ctx).Do()
+}
+
+// GetSslCertificate returns a global SSL certificate resource
+func (c *computeClient) GetSslCertificate(ctx context.Context, project, name string) (*compute.SslCertificate, error) {
+    return c.svc.SslCertificates.Get(project, name).Context(ctx).Do()
+}
+
+// GetAcceleratorType returns an accelerator type offered in a zone
+func (c *computeClient) GetAcceleratorType(ctx context.Context, project, zone, name string) (*compute.AcceleratorType, error) {
+    return c.svc.AcceleratorTypes.Get(project, zone, name).Context(ctx).Do()
+}
+
+// GetNetwork returns a VPC network resource
+func (c *computeClient) GetNetwork(ctx context.Context, project, name string) (*compute.Network, error) {
+    return c.svc.Networks.Get(project, name).Context(ctx).Do()
+}
+
+// GetAddress returns a regional static IP address resource
+func (c *computeClient) GetAddress(ctx context.Context, project, region, name string) (*compute.Address, error) {
+    return c.svc.Addresses.Get(project, region, name).Context(ctx).Do()
+}
+
+// ListAddresses returns the static IP addresses of a region, following pagination
+func (c *computeClient) ListAddresses(ctx context.Context, project, region string) ([]*compute.Address, error) {
+    var addresses []*compute.Address
+    err := c.svc.Addresses.List(project, region).Pages(ctx, func(page *compute.AddressList) error {
+        addresses = append(addresses, page.Items...)
+        return nil
+    })
+    return addresses, err
+}
+
+// ListGlobalAddresses returns the project's global addresses, following pagination
+func (c *computeClient) ListGlobalAddresses(ctx context.Context, project string) ([]*compute.Address, error) {
+    var addresses []*compute.Address
+    err := c.svc.GlobalAddresses.List(project).Pages(ctx, func(page *compute.AddressList) error {
+        addresses = append(addresses, page.Items...)
+        return nil
+    })
+    return addresses, err
+}
+
+// GetRouter returns a regional Cloud Router resource
+func (c *computeClient) GetRouter(ctx context.Context, project, region, name string) (*compute.Router, error) {
+    return c.svc.Routers.Get(project, region, name).Context(ctx).Do()
+}
+
+// GetRouterStatus returns the runtime status of a Cloud Router, including its BGP sessions
+func (c *computeClient) GetRouterStatus(ctx context.Context, project, region, name string) (*compute.RouterStatusResponse, error) {
+    return c.svc.Routers.GetRouterStatus(project, region, name).Context(ctx).Do()
+}
+
+// ListVpnTunnels returns the VPN tunnels of every region, following pagination
+func (c *computeClient) ListVpnTunnels(ctx context.Context, project string) ([]*compute.VpnTunnel, error) {
+    var tunnels []*compute.VpnTunnel
+    err := c.svc.VpnTunnels.AggregatedList(project).Pages(ctx, func(page *compute.VpnTunnelAggregatedList) error {
+        for _, scoped := range page.Items {
+            tunnels = append(tunnels, scoped.VpnTunnels...)
+        }
+        return nil
+    })
+    return tunnels, err
+}
+
+// ListInterconnectAttachments returns the Interconnect attachments of every region, following pagination
+func (c *computeClient) ListInterconnectAttachments(ctx context.Context, project string) ([]*compute.InterconnectAttachment, error) {
+    var attachments []*compute.InterconnectAttachment
+    err := c.svc.InterconnectAttachments.AggregatedList(project).Pages(ctx, func(page *compute.InterconnectAttachmentAggregatedList) error {
+        for _, scoped := range page.Items {
+            attachments = append(attachments, scoped.InterconnectAttachments...)
+        }
+        return nil
+    })
+    return attachments, err
+}
+
+// ListInstances returns the instances of every zone, following pagination
+func (c *computeClient) ListInstances(ctx context.Context, project string) ([]*compute.Instance, error) {
+    var instances []*compute.Instance
+    err := c.svc.Instances.AggregatedList(project).Pages(ctx, func(page *compute.InstanceAggregatedList) error {
+        for _, scoped := range page.Items {
+            instances = append(instances, scoped.Instances...)
+        }
+        return nil
+    })
+    return instances, err
+}
+
+// ListDisks returns the disks of every zone, following pagination
+func (c *computeClient) ListDisks(ctx context.Context, project string) ([]*compute.Disk, error) {
+    var disks []*compute.Disk
+    err := c.svc.Disks.AggregatedList(project).Pages(ctx, func(page *compute.DiskAggregatedList) error {
+        for _, scoped := range page.Items {
+            disks = append(disks, scoped.Disks...)
+        }
+        return nil
+    })
+    return disks, err
+}
+
+// ListNetworks returns the project's networks, following pagination
+func (c *computeClient) ListNetworks(ctx context.Context, project string) ([]*compute.Network, error) {
+    var networks []*compute.Network
+    err := c.svc.Networks.List(project).Pages(ctx, func(page *compute.NetworkList) error {
+        networks = append(networks, page.Items...)
+        return nil
+    })
+    return networks, err
+}
+
+// ListSubnetworks returns the subnetworks of every region, following pagination
+func (c *computeClient) ListSubnetworks(ctx context.Context, project string) ([]*compute.Subnetwork, error) {
+    var subnetworks []*compute.Subnetwork
+    err := c.svc.Subnetworks.AggregatedList(project).Pages(ctx, func(page *compute.SubnetworkAggregatedList) error {
+        for _, scoped := range page.Items {
+            subnetworks = append(subnetworks, scoped.Subnetworks...)
+        }
+        return nil
+    })
+    return subnetworks, err
+}
+
+// ListRoutes returns the project's routes, following pagination
+func (c *computeClient) ListRoutes(ctx context.Context, project string) ([]*compute.Route, error) {
+    var routes []*compute.Route
+    err := c.svc.Routes.List(project).Pages(ctx, func(page *compute.RouteList) error {
+        routes = append(routes, page.Items...)
+        return nil
+    })
+    return routes, err
+}
+
+// ListFirewalls returns the project's VPC firewall rules, following pagination
+func (c *computeClient) ListFirewalls(ctx context.Context, project string) ([]*compute.Firewall, error) {
+    var firewalls []*compute.Firewall
+    err := c.svc.Firewalls.List(project).Pages(ctx, func(page *compute.FirewallList) error {
+        firewalls = append(firewalls, page.Items...)
+        return nil
+    })
+    return firewalls, err
+}
+
+// GetInstanceTemplate returns a global instance template resource
+func (c *computeClient) GetInstanceTemplate(ctx context.Context, project, name string) (*compute.InstanceTemplate, error) {
+    return c.svc.InstanceTemplates.Get(project, name).Context(ctx).Do()
+}
+
+// GetMachineType returns a machine type offered in a zone
+func (c *computeClient) GetMachineType(ctx context.Context, project, zone, name string) (*compute.MachineType, error) {
+    return c.svc.MachineTypes.Get(project, zone, name).Context(ctx).Do()
+}
+
+// GetImage returns an image
+func (c *computeClient) GetImage(ctx context.Context, project, name string) (*compute.Image, error) {
+    return c.svc.Images.Get(project, name).Context(ctx).Do()
+}
+
+// GetImageFromFamily returns the latest non-deprecated image of an image family
+func (c *computeClient) GetImageFromFamily(ctx context.Context, project, family string) (*compute.Image, error) {
+    return c.svc.Images.GetFromFamily(project, family).Context(ctx).Do()
+}
+
+// resourceManagerClient is the default ResourceManagerAPI backed by the real client
+type resourceManagerClient struct {
+    svc *cloudresourcemanager.Service
+}
+
+// NewResourceManagerAPI wraps a Cloud Resource Manager client in the ResourceManagerAPI interface
+func NewResourceManagerAPI(svc *cloudresourcemanager.Service) ResourceManagerAPI {
+    return &resourceManagerClient{svc: svc}
+}
+
+// GetProject returns the project metadata
+func (c *resourceManagerClient) GetProject(ctx context.Context, projectID string) (*cloudresourcemanager.Project, error) {
+    return c.svc.Projects.Get(projectID).Context(ctx).Do()
+}
+
+// GetIamPolicy returns the project-level IAM policy
+func (c *resourceManagerClient) GetIamPolicy(ctx context.Context, projectID string) (*cloudresourcemanager.Policy, error) {
+    return c.svc.Projects.GetIamPolicy(projectID, &cloudresourcemanager.GetIamPolicyRequest{}).Context(ctx).Do()
+}
+
+// GetAncestry returns the project followed by its folders and organization
+func (c *resourceManagerClient) GetAncestry(ctx context.Context, projectID string) ([]*cloudresourcemanager.Ancestor, error) {
+    resp, err := c.svc.Projects.GetAncestry(projectID, &cloudresourcemanager.GetAncestryRequest{}).Context(ctx).Do()
+    if err != nil {
+        return nil, err
+    }
+    return resp.Ancestor, nil
+}
+
+// GetEffectiveOrgPolicy returns the effective org policy for a constraint on the project
+func (c *resourceManagerClient) GetEffectiveOrgPolicy(ctx context.Context, projectID, constraint string) (*cloudresourcemanager.OrgPolicy, error) {
+    req := &cloudresourcemanager.GetEffectiveOrgPolicyRequest{Constraint: constraint}
+    return c.svc.Projects.GetEffectiveOrgPolicy("projects/"+projectID, req).Context(ctx).Do()
+}
+
+// iamClient is the default IAMAPI backed by the real client
+type iamClient struct {
+    svc *iam.Service
+}
+
+// NewIAMAPI wraps an IAM client in the IAMAPI interface
+func NewIAMAPI(svc *iam.Service) IAMAPI {
+    return &iamClient{svc: svc}
+}
+
+// GetServiceAccount returns a single service account
+func (c *iamClient) GetServiceAccount(ctx context.Context, name string) (*iam.ServiceAccount, error) {
+    return c.svc.Projects.ServiceAccounts.Get(name).Context(ctx).Do()
+}
+
+// storageClient is the default StorageAPI backed by the real client
+type storageClient struct {
+    svc *storage.Service
+}
+
+// NewStorageAPI wraps a Cloud Storage client in the StorageAPI interface
+func NewStorageAPI(svc *storage.Service) StorageAPI {
+    return &storageClient{svc: svc}
+}
+
+// storageWriterClient is the default StorageWriterAPI backed by the real client
+type storageWriterClient struct {
+    svc *storage.Service
+}
+
+// NewStorageWriterAPI wraps a write-capable Cloud Storage client in the StorageWriterAPI interface
+func NewStorageWriterAPI(svc *storage.Service) StorageWriterAPI {
+    return &storageWriterClient{svc: svc}
+}
+
+// UploadObject creates or replaces an object with data in a single request
+func (c *storageWriterClient) UploadObject(ctx context.Context, bucket, name, contentType string, data []byte, metadata map[string]string) error {
+    object := &storage.Object{Name: name, ContentType: contentType, Metadata: metadata}
+    _, err := c.svc.Objects.Insert(bucket, object).
+        Media(bytes.NewReader(data), googleapi.ContentType(contentType)).
+        Context(ctx).Do()
+    return err
+}
+
+// GetBucketIamPolicy returns the IAM policy of a bucket
+func (c *storageClient) GetBucketIamPolicy(ctx context.Context, bucket string) (*storage.Policy, error) {
+    return c.svc.Buckets.GetIamPolicy(bucket).Context(ctx).Do()
+}
+
+// GetBucket returns a bucket's metadata
+func (c *storageClient) GetBucket(ctx context.Context, bucket string) (*storage.Bucket, error) {
+    return c.svc.Buckets.Get(bucket).Context(ctx).Do()
+}
+
+// filestoreClient is the default FilestoreAPI backed by the real client
+type filestoreClient struct {
+    svc *file.Service
+}
+
+// NewFilestoreAPI wraps a Filestore client in the FilestoreAPI interface
+func NewFilestoreAPI(svc *file.Service) FilestoreAPI {
+    return &filestoreClient{svc: svc}
+}
+
+// GetInstance returns a Filestore instance by its full resource name
+func (c *filestoreClient) GetInstance(ctx context.Context, name string) (*file.Instance, error) {
+    return c.svc.Projects.Locations.Instances.Get(name).Context(ctx).Do()
+}
+
+// kmsClient is the default KMSAPI backed by the real client
+type kmsClient struct {
+    svc *cloudkms.Service
+}
+
+// NewKMSAPI wraps a Cloud KMS client in the KMSAPI interface
+func NewKMSAPI(svc *cloudkms.Service) KMSAPI {
+    return &kmsClient{svc: svc}
+}
+
+// GetCryptoKey returns a key by its full resource name
+func (c *kmsClient) GetCryptoKey(ctx context.Context, name string) (*cloudkms.CryptoKey, error) {
+    return c.svc.Projects.Locations.KeyRings.CryptoKeys.Get(name).Context(ctx).Do()
+}
+
+// GetCryptoKeyIamPolicy returns the IAM policy set on a key
+func (c *kmsClient) GetCryptoKeyIamPolicy(ctx context.Context, name string) (*cloudkms.Policy, error) {
+    return c.svc.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(name).Context(ctx).Do()
+}
+
+// tagsClient is the default TagsAPI backed by the real client
+type tagsClient struct {
+    svc *crmv3.Service
+}
+
+// NewTagsAPI wraps a Resource Manager v3 client in the TagsAPI interface
+func NewTagsAPI(svc *crmv3.Service) TagsAPI {
+    return &tagsClient{svc: svc}
+}
+
+// ListTagBindings returns the tags bound directly to a resource, following pagination
+func (c *tagsClient) ListTagBindings(ctx context.Context, parent string) ([]*crmv3.TagBinding, error) {
+    var bindings []*crmv3.TagBinding
+    err := c.svc.TagBindings.List().Parent(parent).Pages(ctx, func(page *crmv3.ListTagBindingsResponse) error {
+        bindings = append(bindings, page.TagBindings...)
+        return nil
+    })
+    return bindings, err
+}
+
+// iamDenyClient is the default IAMDenyAPI backed by the real IAM v2 client
+type iamDenyClient struct {
+    svc *iamv2.Service
+}
+
+// NewIAMDenyAPI wraps an IAM v2 client in the IAMDenyAPI interface
+func NewIAMDenyAPI(svc *iamv2.Service) IAMDenyAPI {
+    return &iamDenyClient{svc: svc}
+}
+
+// ListDenyPolicies returns the deny policies attached to a resource, following pagination
+// The attachment point is URL-encoded into the parent as the API requires
+func (c *iamDenyClient) ListDenyPolicies(ctx context.Context, attachmentPoint string) ([]*iamv2.GoogleIamV2Policy, error) {
+    parent := "policies/" + url.PathEscape(attachmentPoint) + "/denypolicies"
+    var policies []*iamv2.GoogleIamV2Policy
+    err := c.svc.Policies.ListPolicies(parent).Pages(ctx, func(page *iamv2.GoogleIamV2ListPoliciesResponse) error {
+        policies = append(policies, page.Policies...)
+        return nil
+    })
+    return policies, err
+}
+
+// GetDenyPolicy returns a deny policy with its rules
+func (c *iamDenyClient) GetDenyPolicy(ctx context.Context, name string) (*iamv2.GoogleIamV2Policy, error) {
+    return c.svc.Policies.Get(name).Context(ctx).Do()
+}
+
+// iamCredentialsClient is the default IAMCredentialsAPI backed by the real client
+type iamCredentialsClient struct {
+    svc *iamcredentials.Service
+}
+
+// NewIAMCredentialsAPI wraps an IAM Credentials client in the IAMCredentialsAPI interface
+func NewIAMCredentialsAPI(svc *iamcredentials.Service) IAMCredentialsAPI {
+    return &iamCredentialsClient{svc: svc}
+}
+
+// GenerateAccessToken mints a short-lived access token for the service account
+func (c *iamCredentialsClient) GenerateAccessToken(ctx context.Context, name string, scopes []string, lifetime string) (*iamcredentials.GenerateAccessTokenResponse, error) {
+    req := &iamcredentials.GenerateAccessTokenRequest{Scope: scopes, Lifetime: lifetime}
+    return c.svc.Projects.ServiceAccounts.GenerateAccessToken(name, req).Context(ctx).Do()
+}
+
+// serviceNetworkingClient is the default ServiceNetworkingAPI backed by the real client
+type serviceNetworkingClient struct {
+    svc *servicenetworking.APIService
+}
+
+// NewServiceNetworkingAPI wraps a Service Networking client in the ServiceNetworkingAPI interface
+func NewServiceNetworkingAPI(svc *servicenetworking.APIService) ServiceNetworkingAPI {
+    return &serviceNetworkingClient{svc: svc}
+}
+
+// loggingClient is the default LoggingAPI backed by the real client
+type loggingClient struct {
+    svc *logging.Service
+}
+
+// NewLoggingAPI wraps a Cloud Logging client in the LoggingAPI interface
+func NewLoggingAPI(svc *logging.Service) LoggingAPI {
+    return &loggingClient{svc: svc}
+}
+
+// ListSinks pages through the log sinks of parent
+func (c *loggingClient) ListSinks(ctx context.Context, parent string) ([]*logging.LogSink, error) {
+    var sinks []*logging.LogSink
+    err := c.svc.Projects.Sinks.List(parent).Pages(ctx, func(resp *logging.ListSinksResponse) error {
+        sinks = append(sinks, resp.Sinks...)
+        return nil
+    })
+    return sinks, err
+}
+
+// metadataClient is the default MetadataAPI backed by the metadata server
+type metadataClient struct {
+    client *metadata.Client
+}
+
+// NewMetadataAPI returns a MetadataAPI querying the metadata server directly, bypassing any proxy
+func NewMetadataAPI() MetadataAPI {
+    return &metadataClient{client: metadata.NewClient(nil)}
+}
+
+// OnGCE probes for the metadata server once per process
+func (c *metadataClient) OnGCE() bool {
+    return metadata.OnGCE()
+}
+
+// ProjectID reads computeMetadata/v1/project/project-id
+func (c *metadataClient) ProjectID(ctx context.Context) (string, error) {
+    return c.client.ProjectIDWithContext(ctx)
+}
+
+// dnsClient is the default DNSAPI backed by the real client
+type dnsClient struct {
+    svc *dns.Service
+}
+
+// NewDNSAPI wraps a Cloud DNS client in the DNSAPI interface
+func NewDNSAPI(svc *dns.Service) DNSAPI {
+    return &dnsClient{svc: svc}
+}
+
+// GetPolicy returns a single DNS server policy
+func (c *dnsClient) GetPolicy(ctx context.Context, project, name string) (*dns.Policy, error) {
+    return c.svc.Policies.Get(project, name).Context(ctx).Do()
+}
+
+// ListConnections returns the connections of every service producer peered with the network
+// The "-" wildcard lists connections for all services, not just servicenetworking.googleapis.com
+func (c *serviceNetworkingClient) ListConnections(ctx context.Context, network string) ([]*servicenetworking.Connection, error) {
+    resp, err := c.svc.Services.Connections.List("services/-").Network(network).Context(ctx).Do()
+    if err != nil {
+        return nil, err
+    }
+    return resp.Connections, nil
+}
@@ -2,19 +2,30 @@ package gcp
 
 import (
     "context"
+    "errors"
     "fmt"
     "log/slog"
     "net/http"
     "time"
 
-    "golang.org/x/oauth2/google"
+    "golang.org/x/oauth2"
+    "google.golang.org/api/cloudkms/v1"
     "google.golang.org/api/cloudresourcemanager/v1"
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
     "google.golang.org/api/compute/v1"
+    "google.golang.org/api/dns/v1"
+    file "google.golang.org/api/file/v1"
     "google.golang.org/api/googleapi"
     "google.golang.org/api/iam/v1"
+    iamv2 "google.golang.org/api/iam/v2"
+    "google.golang.org/api/iamcredentials/v1"
+    logging "google.golang.org/api/logging/v2"
     "google.golang.org/api/monitoring/v3"
     "google.golang.org/api/option"
+    "google.golang.org/api/servicenetworking/v1"
     "google.golang.org/api/serviceusage/v1"
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+    "google.golang.org/api/storage/v1"
 )
 
 const (
@@ -23,33 +34,100 @@ const (
     maxBackoff     = 30 * time.Second
     maxRetries     = 5
 
-    // Retryable HTTP status codes
+    // Default retryable HTTP status codes
     statusRateLimited    = 429
     statusServiceUnavail = 503
     statusInternalError  = 500
 )
 
-// getDefaultClient creates an HTTP client with WIF authentication
-// Creates a new client for each call with the specified scopes
-// google.DefaultClient handles connection pooling and credential caching internally
-func getDefaultClient(ctx context.Context, scopes ...string) (*http.Client, error) {
-    return google.DefaultClient(ctx, scopes...)
+// OAuth scopes requested for each service client; all are read-only
+const (
+    ComputeScope           = compute.ComputeReadonlyScope
+    IAMScope               = "https://www.googleapis.com/auth/cloud-platform.read-only"
+    ResourceManagerScope   = cloudresourcemanager.CloudPlatformReadOnlyScope
+    ServiceUsageScope      = serviceusage.CloudPlatformReadOnlyScope
+    ServiceUsageBetaScope  = serviceusagebeta.CloudPlatformReadOnlyScope
+    MonitoringScope        = monitoring.MonitoringReadScope
+    StorageScope           = storage.DevstorageReadOnlyScope
+    FilestoreScope         = "https://www.googleapis.com/auth/cloud-platform.read-only" // Filestore defines no narrower scope
+    KMSScope               = cloudkms.CloudkmsScope                                     // KMS defines no read-only scope; IAM limits the calls to reads
+    TagsScope              = crmv3.CloudPlatformReadOnlyScope
+    IAMDenyScope           = "https://www.googleapis.com/auth/cloud-platform.read-only" // IAM v2 declares only cloud-platform; reads accept read-only
+    ServiceNetworkingScope = "https://www.googleapis.com/auth/cloud-platform.read-only" // Service Networking declares no read-only scope; reads accept it
+    DNSScope               = dns.NdevClouddnsReadonlyScope
+    LoggingScope           = logging.LoggingReadScope
+)
+
+// StorageWriteScope is the only write scope, requested just for uploading results to RESULTS_GCS_URI
+const StorageWriteScope = storage.DevstorageReadWriteScope
+
+// IAMCredentialsScope is requested just by impersonation-check to mint a short-lived token
+// IAM Credentials declares only cloud-platform; the minted token itself is discarded
+const IAMCredentialsScope = iamcredentials.CloudPlatformScope
+
+// ErrCredentials marks failures to find or load Application Default Credentials
+// Every CreateXXXService wraps it when its authenticated HTTP client cannot be built
+var ErrCredentials = errors.New("credentials unavailable")
+
+// RetryConfig bounds how retryWithBackoff retries transient GCP errors
+type RetryConfig struct {
+    InitialBackoff        time.Duration // Base sleep, doubled before each retry
+    MaxBackoff            time.Duration // Cap on a single sleep
+    MaxRetries            int           // Total attempts, including the first
+    MaxTotalRetryDuration time.Duration // Cap on cumulative wall-time across attempts; 0 means no cap
+    RetryableStatusCodes  []int         // HTTP codes of googleapi errors worth retrying; nil means 429/500/503
+}
+
+// DefaultRetryConfig returns the retry settings used when none are configured
+func DefaultRetryConfig() RetryConfig {
+    return RetryConfig{
+        InitialBackoff: initialBackoff,
+        MaxBackoff:     maxBackoff,
+        MaxRetries:     maxRetries,
+        RetryableStatusCodes: []int{
+            statusRateLimited,
+            statusInternalError,
+            statusServiceUnavail,
+        },
+    }
+}
+
+// isRetryableStatus reports whether a googleapi error with the given code should be retried
+func (c RetryConfig) isRetryableStatus(code int) bool {
+    codes := c.RetryableStatusCodes
+    if codes == nil {
+        codes = DefaultRetryConfig().RetryableStatusCodes
+    }
+    for _, retryable := range codes {
+        if code == retryable {
+            return true
+        }
+    }
+    return false
 }
 
 // retryWithBackoff wraps GCP API calls with exponential backoff retry logic
-func retryWithBackoff(ctx context.Context, operation func() error) error {
+// With MaxTotalRetryDuration set, it gives up with the last error rather than sleep past the budget
+func retryWithBackoff(ctx context.Context, cfg RetryConfig, operation func() error) error {
     var lastErr error
-    backoff := initialBackoff
+    backoff := cfg.InitialBackoff
+    start := time.Now()
 
-    for attempt := 0; attempt < maxRetries; attempt++ {
+    for attempt := 0; attempt < cfg.MaxRetries; attempt++ {
         if attempt > 0 {
             // Calculate exponential backoff with jitter
-            if backoff < maxBackoff {
+            if backoff < cfg.MaxBackoff {
                 backoff = backoff * 2
-                if backoff > maxBackoff {
-                    backoff = maxBackoff
+                if backoff > cfg.MaxBackoff {
+                    backoff = cfg.MaxBackoff
                 }
             }
+
+            // Stop before the next sleep would overrun the total retry budget
+            if cfg.MaxTotalRetryDuration > 0 && time.Since(start)+backoff > cfg.MaxTotalRetryDuration {
+                return fmt.Errorf("retry budget of %s exceeded after %d attempt(s): %w",
+                    cfg.MaxTotalRetryDuration, attempt, lastErr)
+            }
             slog.Debug("Retrying GCP API call", "attempt", attempt, "backoff", backoff)
 
             select {
@@ -64,12 +142,16 @@ func retryWithBackoff(ctx context.Context, operation func() error) error {
             return nil // Success
         }
 
+        // The operation already knows this error is not worth retrying
+        var permanent *permanentError
+        if errors.As(lastErr, &permanent) {
+            return permanent.err
+        }
+
         // Check if error is retryable
         if apiErr, ok := lastErr.(*googleapi.Error); ok {
-            // Retry on rate limit, service unavailable, and internal errors
-            if apiErr.Code == statusRateLimited ||
-               apiErr.Code == statusServiceUnavail ||
-               apiErr.Code == statusInternalError {
+            // Retry on the configured codes (rate limit, service unavailable, and internal errors by default)
+            if cfg.isRetryableStatus(apiErr.Code) {
                 continue
             }
             // Don't retry on other errors (4xx client errors, etc.)
@@ -85,18 +167,142 @@ func retryWithBackoff(ctx context.Context, operation func() error) error {
     return fmt.Errorf("max retries exceeded: %w", lastErr)
 }
 
+// permanentError makes retryWithBackoff return the wrapped error without further attempts
+type permanentError struct {
+    err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Retry runs operation under cfg's retry policy, for non-GCP calls such as the results webhook
+// Operations should return *googleapi.Error for HTTP failures so RetryableStatusCodes applies
+func Retry(ctx context.Context, cfg RetryConfig, operation func() error) error {
+    return retryWithBackoff(ctx, cfg, operation)
+}
+
 // ClientFactory creates GCP service clients with WIF authentication
 type ClientFactory struct {
-    projectID string
-    logger    *slog.Logger
+    projectID   string
+    logger      *slog.Logger
+    credentials CredentialSource // Authenticates every client; ADCSource unless WithCredentialSource is given
+    baseClient  *http.Client     // Optional base client (custom transport/proxy), nil uses Go defaults
+    retry       RetryConfig      // Retry policy for service construction
+    // Optional scopes replacing a service's default, keyed by API name (e.g. "compute.googleapis.com")
+    serviceScopes map[string][]string
 }
 
 // NewClientFactory creates a new GCP client factory
-func NewClientFactory(projectID string, logger *slog.Logger) *ClientFactory {
-    return &ClientFactory{
-        projectID: projectID,
-        logger:    logger,
+func NewClientFactory(projectID string, logger *slog.Logger, opts ...ClientFactoryOption) *ClientFactory {
+    f := &ClientFactory{
+        projectID:   projectID,
+        logger:      logger,
+        credentials: ADCSource{},
+        retry:       DefaultRetryConfig(),
     }
+    for _, opt := range opts {
+        opt(f)
+    }
+    return f
+}
+
+// ScopedAPIs are the APIs whose client scopes WithServiceScopes can replace
+// The results upload keeps StorageWriteScope whatever storage.googleapis.com is set to
+var ScopedAPIs = []string{
+    "cloudkms.googleapis.com",
+    "cloudresourcemanager.googleapis.com",
+    "compute.googleapis.com",
+    "dns.googleapis.com",
+    "file.googleapis.com",
+    "iam.googleapis.com",
+    "iamcredentials.googleapis.com",
+    "logging.googleapis.com",
+    "monitoring.googleapis.com",
+    "servicenetworking.googleapis.com",
+    "serviceusage.googleapis.com",
+    "storage.googleapis.com",
+}
+
+// ServiceScopes returns the scopes requested for api's clients: overrides[api] when set, else defaultScope
+func ServiceScopes(overrides map[string][]string, api, defaultScope string) []string {
+    if scopes := overrides[api]; len(scopes) > 0 {
+        return scopes
+    }
+    return []string{defaultScope}
+}
+
+// scopes returns the scopes the factory requests for api's clients
+func (f *ClientFactory) scopes(api, defaultScope string) []string {
+    return ServiceScopes(f.serviceScopes, api, defaultScope)
+}
+
+// defaultClient creates an authenticated HTTP client from the credential source, layered on the
+// base client when configured; oauth2 picks the base client up from the context for both token
+// fetches and wrapped requests
+func (f *ClientFactory) defaultClient(ctx context.Context, scopes ...string) (*http.Client, error) {
+    if f.baseClient != nil {
+        ctx = context.WithValue(ctx, oauth2.HTTPClient, f.baseClient)
+    }
+    // Only transient failures such as a flaky metadata server are retried; missing or invalid
+    // credentials fail fast
+    var client *http.Client
+    err := retryWithBackoff(ctx, f.retry, func() error {
+        var clientErr error
+        client, clientErr = f.credentials.Client(ctx, scopes...)
+        if clientErr != nil && ClassifyError(clientErr) != ErrorClassRetryable {
+            return &permanentError{err: clientErr}
+        }
+        return clientErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("%w: %w", ErrCredentials, err)
+    }
+    return client, nil
+}
+
+// CredentialProjects are the projects the Application Default Credentials are tied to; either may be empty
+type CredentialProjects struct {
+    ProjectID      string // project_id of the credentials file, or the metadata server's project
+    QuotaProjectID string // quota_project_id of the credentials file, billed for API quota
+}
+
+// DefaultCredentialProjects reads the projects of the factory's credentials without fetching a token
+// Sources that do not implement CredentialProjectsSource report no projects
+func (f *ClientFactory) DefaultCredentialProjects(ctx context.Context) (*CredentialProjects, error) {
+    source, ok := f.credentials.(CredentialProjectsSource)
+    if !ok {
+        return &CredentialProjects{}, nil
+    }
+    projects, err := source.CredentialProjects(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("%w: %w", ErrCredentials, err)
+    }
+    return projects, nil
+}
+
+// IsAuthError reports whether err is a clear authentication or authorization failure:
+// missing credentials, a rejected token exchange, or a 401/403 from a GCP API
+// Transient failures such as 429 or 503 are not auth errors
+func IsAuthError(err error) bool {
+    if errors.Is(err, ErrCredentials) {
+        return true
+    }
+
+    var apiErr *googleapi.Error
+    if errors.As(err, &apiErr) {
+        return apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden
+    }
+
+    // The token endpoint answers invalid_grant and similar rejections with a 400
+    var retrieveErr *oauth2.RetrieveError
+    if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+        switch retrieveErr.Response.StatusCode {
+        case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+            return true
+        }
+    }
+    return false
 }
 
 // CreateComputeService creates a Compute Engine service client with minimal scopes
@@ -104,13 +310,13 @@ func (f *ClientFactory) CreateComputeService(ctx context.Context) (*compute.Serv
     f.logger.Debug("Creating Compute Engine service client with WIF")
 
     // Use readonly scope for read-only operations (quota checks, list instances, etc.)
-    client, err := getDefaultClient(ctx, compute.ComputeReadonlyScope)
+    client, err := f.defaultClient(ctx, f.scopes("compute.googleapis.com", ComputeScope)...)
     if err != nil {
         return nil, fmt.Errorf("failed to create default client: %w", err)
     }
 
     var svc *compute.Service
-    err = retryWithBackoff(ctx, func() error {
+    err = retryWithBackoff(ctx, f.retry, func() error {
         var createErr error
         svc, createErr = compute.NewService(ctx, option.WithHTTPClient(client))
         return createErr
@@ -127,13 +333,13 @@ func (f *ClientFactory) CreateIAMService(ctx context.Context) (*iam.Service, err
     f.logger.Debug("Creating IAM service client with WIF")
 
     // Use readonly scope for validation (checking service accounts, roles, etc.)
-    client, err := getDefaultClient(ctx, "https://www.googleapis.com/auth/cloud-platform.read-only")
+    client, err := f.defaultClient(ctx, f.scopes("iam.googleapis.com", IAMScope)...)
     if err != nil {
         return nil, fmt.Errorf("failed to create default client: %w", err)
     }
 
     var svc *iam.Service
-    err = retryWithBackoff(ctx, func() error {
+    err = retryWithBackoff(ctx, f.retry, func() error {
         var createErr error
         svc, createErr = iam.NewService(ctx, option.WithHTTPClient(client))
         return createErr
@@ -145,18 +351,132 @@ func (f *ClientFactory) CreateIAMService(ctx context.Context) (*iam.Service, err
     return svc, nil
 }
 
+// CreateIAMV2Service creates an IAM v2 service client, which serves deny policies, with minimal scopes
+func (f *ClientFactory) CreateIAMV2Service(ctx context.Context) (*iamv2.Service, error) {
+    f.logger.Debug("Creating IAM v2 service client with WIF")
+
+    // Use readonly scope for listing and reading deny policies
+    client, err := f.defaultClient(ctx, f.scopes("iam.googleapis.com", IAMDenyScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *iamv2.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = iamv2.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create IAM v2 service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateServiceNetworkingService creates a Service Networking service client with minimal scopes
+func (f *ClientFactory) CreateServiceNetworkingService(ctx context.Context) (*servicenetworking.APIService, error) {
+    f.logger.Debug("Creating Service Networking service client with WIF")
+
+    // Use readonly scope for listing private service access connections
+    client, err := f.defaultClient(ctx, f.scopes("servicenetworking.googleapis.com", ServiceNetworkingScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *servicenetworking.APIService
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = servicenetworking.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create Service Networking service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateLoggingService creates a Cloud Logging service client with minimal scopes
+func (f *ClientFactory) CreateLoggingService(ctx context.Context) (*logging.Service, error) {
+    f.logger.Debug("Creating Cloud Logging service client with WIF")
+
+    // Use read scope for listing log sinks
+    client, err := f.defaultClient(ctx, f.scopes("logging.googleapis.com", LoggingScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *logging.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = logging.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create Cloud Logging service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateDNSService creates a Cloud DNS service client with minimal scopes
+func (f *ClientFactory) CreateDNSService(ctx context.Context) (*dns.Service, error) {
+    f.logger.Debug("Creating Cloud DNS service client with WIF")
+
+    // Use readonly scope for reading DNS server policies
+    client, err := f.defaultClient(ctx, f.scopes("dns.googleapis.com", DNSScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *dns.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = dns.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create Cloud DNS service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateIAMCredentialsService creates an IAM Credentials service client for minting impersonated tokens
+func (f *ClientFactory) CreateIAMCredentialsService(ctx context.Context) (*iamcredentials.Service, error) {
+    f.logger.Debug("Creating IAM Credentials service client with WIF")
+
+    client, err := f.defaultClient(ctx, f.scopes("iamcredentials.googleapis.com", IAMCredentialsScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *iamcredentials.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = iamcredentials.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create IAM Credentials service: %w", err)
+    }
+
+    return svc, nil
+}
+
 // CreateCloudResourceManagerService creates a Cloud Resource Manager service client with minimal scopes
 func (f *ClientFactory) CreateCloudResourceManagerService(ctx context.Context) (*cloudresourcemanager.Service, error) {
     f.logger.Debug("Creating Cloud Resource Manager service client with WIF")
 
     // Use readonly scope for read-only project operations
-    client, err := getDefaultClient(ctx, cloudresourcemanager.CloudPlatformReadOnlyScope)
+    client, err := f.defaultClient(ctx, f.scopes("cloudresourcemanager.googleapis.com", ResourceManagerScope)...)
     if err != nil {
         return nil, fmt.Errorf("failed to create default client: %w", err)
     }
 
     var svc *cloudresourcemanager.Service
-    err = retryWithBackoff(ctx, func() error {
+    err = retryWithBackoff(ctx, f.retry, func() error {
         var createErr error
         svc, createErr = cloudresourcemanager.NewService(ctx, option.WithHTTPClient(client))
         return createErr
@@ -173,13 +493,13 @@ func (f *ClientFactory) CreateServiceUsageService(ctx context.Context) (*service
     f.logger.Debug("Creating Service Usage service client with WIF")
 
     // Use readonly scope for checking API enablement status
-    client, err := getDefaultClient(ctx, serviceusage.CloudPlatformReadOnlyScope)
+    client, err := f.defaultClient(ctx, f.scopes("serviceusage.googleapis.com", ServiceUsageScope)...)
     if err != nil {
         return nil, fmt.Errorf("failed to create default client: %w", err)
     }
 
     var svc *serviceusage.Service
-    err = retryWithBackoff(ctx, func() error {
+    err = retryWithBackoff(ctx, f.retry, func() error {
         var createErr error
         svc, createErr = serviceusage.NewService(ctx, option.WithHTTPClient(client))
         return createErr
@@ -191,18 +511,41 @@ func (f *ClientFactory) CreateServiceUsageService(ctx context.Context) (*service
     return svc, nil
 }
 
+// CreateServiceUsageBetaService creates a Service Usage v1beta1 client, needed for consumer quota metrics
+func (f *ClientFactory) CreateServiceUsageBetaService(ctx context.Context) (*serviceusagebeta.APIService, error) {
+    f.logger.Debug("Creating Service Usage v1beta1 service client with WIF")
+
+    // Use readonly scope for reading consumer quotas
+    client, err := f.defaultClient(ctx, f.scopes("serviceusage.googleapis.com", ServiceUsageBetaScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *serviceusagebeta.APIService
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = serviceusagebeta.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create service usage v1beta1 service: %w", err)
+    }
+
+    return svc, nil
+}
+
 // CreateMonitoringService creates a Monitoring service client with minimal scopes
 func (f *ClientFactory) CreateMonitoringService(ctx context.Context) (*monitoring.Service, error) {
     f.logger.Debug("Creating Monitoring service client with WIF")
 
     // Use readonly scope for reading metrics/alerts
-    client, err := getDefaultClient(ctx, monitoring.MonitoringReadScope)
+    client, err := f.defaultClient(ctx, f.scopes("monitoring.googleapis.com", MonitoringScope)...)
     if err != nil {
         return nil, fmt.Errorf("failed to create default client: %w", err)
     }
 
     var svc *monitoring.Service
-    err = retryWithBackoff(ctx, func() error {
+    err = retryWithBackoff(ctx, f.retry, func() error {
         var createErr error
         svc, createErr = monitoring.NewService(ctx, option.WithHTTPClient(client))
         return createErr
@@ -214,14 +557,134 @@ func (f *ClientFactory) CreateMonitoringService(ctx context.Context) (*monitorin
     return svc, nil
 }
 
+// CreateStorageService creates a Cloud Storage service client with minimal scopes
+func (f *ClientFactory) CreateStorageService(ctx context.Context) (*storage.Service, error) {
+    f.logger.Debug("Creating Cloud Storage service client with WIF")
+
+    // Use readonly scope for reading bucket metadata and IAM policies
+    client, err := f.defaultClient(ctx, f.scopes("storage.googleapis.com", StorageScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *storage.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = storage.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create storage service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateStorageWriterService creates a Cloud Storage service client that can upload objects
+// It is only created when results are uploaded, so validation runs keep read-only scopes otherwise
+func (f *ClientFactory) CreateStorageWriterService(ctx context.Context) (*storage.Service, error) {
+    f.logger.Debug("Creating Cloud Storage writer service client with WIF")
+
+    client, err := f.defaultClient(ctx, StorageWriteScope)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *storage.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = storage.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create storage writer service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateFilestoreService creates a Filestore service client with minimal scopes
+func (f *ClientFactory) CreateFilestoreService(ctx context.Context) (*file.Service, error) {
+    f.logger.Debug("Creating Filestore service client with WIF")
+
+    // Use readonly scope for reading instance state
+    client, err := f.defaultClient(ctx, f.scopes("file.googleapis.com", FilestoreScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *file.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = file.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create filestore service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateKMSService creates a Cloud KMS service client with minimal scopes
+func (f *ClientFactory) CreateKMSService(ctx context.Context) (*cloudkms.Service, error) {
+    f.logger.Debug("Creating Cloud KMS service client with WIF")
+
+    // Use the KMS scope for reading key state and key IAM policies
+    client, err := f.defaultClient(ctx, f.scopes("cloudkms.googleapis.com", KMSScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *cloudkms.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = cloudkms.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create kms service: %w", err)
+    }
+
+    return svc, nil
+}
+
+// CreateTagsService creates a Resource Manager v3 service client, which serves the Tags API, with minimal scopes
+func (f *ClientFactory) CreateTagsService(ctx context.Context) (*crmv3.Service, error) {
+    f.logger.Debug("Creating Resource Manager v3 service client with WIF")
+
+    // Use read-only scope for listing tag bindings
+    client, err := f.defaultClient(ctx, f.scopes("cloudresourcemanager.googleapis.com", TagsScope)...)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create default client: %w", err)
+    }
+
+    var svc *crmv3.Service
+    err = retryWithBackoff(ctx, f.retry, func() error {
+        var createErr error
+        svc, createErr = crmv3.NewService(ctx, option.WithHTTPClient(client))
+        return createErr
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to create tags service: %w", err)
+    }
+
+    return svc, nil
+}
+
 // Test helpers - exported for testing purposes only
 
-// GetDefaultClientForTesting exposes getDefaultClient for testing
+// GetDefaultClientForTesting exposes the default ADC credential source for testing
 func GetDefaultClientForTesting(ctx context.Context, scopes ...string) (*http.Client, error) {
-    return getDefaultClient(ctx, scopes...)
+    return ADCSource{}.Client(ctx, scopes...)
 }
 
-// RetryWithBackoffForTesting exposes retryWithBackoff for testing
+// RetryWithBackoffForTesting exposes retryWithBackoff with the default retry config for testing
 func RetryWithBackoffForTesting(ctx context.Context, operation func() error) error {
-    return retryWithBackoff(ctx, operation)
+    return retryWithBackoff(ctx, DefaultRetryConfig(), operation)
+}
+
+// RetryWithConfigForTesting exposes retryWithBackoff with a custom retry config for testing
+func RetryWithConfigForTesting(ctx context.Context, cfg RetryConfig, operation func() error) error {
+    return retryWithBackoff(ctx, cfg, operation)
 }
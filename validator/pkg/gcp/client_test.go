@@ -3,11 +3,17 @@ package gcp_test
 import (
     "context"
     "errors"
+    "fmt"
     "log/slog"
+    "net"
+    "net/http"
+    "os"
+    "path/filepath"
     "time"
 
     . "github.com/onsi/ginkgo/v2"
     . "github.com/onsi/gomega"
+    "golang.org/x/oauth2"
     "google.golang.org/api/googleapi"
 
     "validator/pkg/gcp"
@@ -137,6 +143,88 @@ var _ = Describe("GCP Client", func() {
             })
         })
 
+        Context("with a total retry budget", func() {
+            It("should return the last error before exhausting max retries", func() {
+                cfg := gcp.DefaultRetryConfig()
+                cfg.MaxTotalRetryDuration = 300 * time.Millisecond
+
+                callCount := 0
+                operation := func() error {
+                    callCount++
+                    return &googleapi.Error{Code: 503} // Always fail with retryable error
+                }
+
+                start := time.Now()
+                err := gcp.RetryWithConfigForTesting(ctx, cfg, operation)
+                Expect(time.Since(start)).To(BeNumerically("<", cfg.MaxTotalRetryDuration))
+                Expect(err).To(MatchError(ContainSubstring("retry budget")))
+                var apiErr *googleapi.Error
+                Expect(errors.As(err, &apiErr)).To(BeTrue(), "Should wrap the last error")
+                Expect(apiErr.Code).To(Equal(503))
+                Expect(callCount).To(BeNumerically("<", cfg.MaxRetries))
+            })
+
+            It("should not limit retries when unset", func() {
+                cfg := gcp.DefaultRetryConfig()
+                cfg.InitialBackoff = time.Millisecond
+
+                callCount := 0
+                err := gcp.RetryWithConfigForTesting(ctx, cfg, func() error {
+                    callCount++
+                    return &googleapi.Error{Code: 503}
+                })
+                Expect(err).To(MatchError(ContainSubstring("max retries exceeded")))
+                Expect(callCount).To(Equal(cfg.MaxRetries))
+            })
+        })
+
+        Context("with custom retryable status codes", func() {
+            var cfg gcp.RetryConfig
+
+            BeforeEach(func() {
+                cfg = gcp.DefaultRetryConfig()
+                cfg.InitialBackoff = time.Millisecond
+                cfg.RetryableStatusCodes = []int{429, 502, 503}
+            })
+
+            It("should retry a code added to the set", func() {
+                callCount := 0
+                err := gcp.RetryWithConfigForTesting(ctx, cfg, func() error {
+                    callCount++
+                    if callCount < 3 {
+                        return &googleapi.Error{Code: 502}
+                    }
+                    return nil
+                })
+                Expect(err).NotTo(HaveOccurred())
+                Expect(callCount).To(Equal(3))
+            })
+
+            It("should not retry a default code left out of the set", func() {
+                callCount := 0
+                err := gcp.RetryWithConfigForTesting(ctx, cfg, func() error {
+                    callCount++
+                    return &googleapi.Error{Code: 500}
+                })
+                Expect(err).To(HaveOccurred())
+                Expect(callCount).To(Equal(1), "Should not retry 500 when excluded")
+            })
+
+            It("should fall back to the default codes when nil", func() {
+                cfg.RetryableStatusCodes = nil
+                callCount := 0
+                err := gcp.RetryWithConfigForTesting(ctx, cfg, func() error {
+                    callCount++
+                    if callCount < 2 {
+                        return &googleapi.Error{Code: 500}
+                    }
+                    return nil
+                })
+                Expect(err).NotTo(HaveOccurred())
+                Expect(callCount).To(Equal(2))
+            })
+        })
+
         Context("with non-googleapi errors", func() {
             It("should retry generic errors until max retries", func() {
                 callCount := 0
@@ -152,6 +240,23 @@ var _ = Describe("GCP Client", func() {
         })
     })
 
+    Describe("IsAuthError", func() {
+        DescribeTable("should classify errors",
+            func(err error, expected bool) {
+                Expect(gcp.IsAuthError(err)).To(Equal(expected))
+            },
+            Entry("missing credentials", fmt.Errorf("failed to create default client: %w", gcp.ErrCredentials), true),
+            Entry("401 from an API", &googleapi.Error{Code: 401}, true),
+            Entry("403 from an API", &googleapi.Error{Code: 403}, true),
+            Entry("rejected token exchange", &oauth2.RetrieveError{Response: &http.Response{StatusCode: 400}}, true),
+            Entry("503 from an API", &googleapi.Error{Code: 503}, false),
+            Entry("429 from an API", &googleapi.Error{Code: 429}, false),
+            Entry("token endpoint outage", &oauth2.RetrieveError{Response: &http.Response{StatusCode: 503}}, false),
+            Entry("generic error", errors.New("boom"), false),
+            Entry("nil", nil, false),
+        )
+    })
+
     Describe("ClientFactory", func() {
         var (
             projectID string
@@ -178,12 +283,160 @@ var _ = Describe("GCP Client", func() {
             })
         })
 
-        // Note: Testing actual GCP service creation requires either:
-        // 1. Mocking google.DefaultClient (complex, requires dependency injection)
-        // 2. Integration tests with real GCP credentials
-        // 3. Using interfaces and dependency injection (architectural change)
-        //
-        // For now, we test the factory creation and leave service creation for integration tests.
-        // The CreateXXXService methods follow the same pattern, so testing one validates the pattern.
+        Describe("DefaultCredentialProjects", func() {
+            It("should read the project and quota project of the credentials file", func() {
+                path := filepath.Join(GinkgoT().TempDir(), "adc.json")
+                Expect(os.WriteFile(path, []byte(`{
+                    "type": "authorized_user",
+                    "client_id": "id",
+                    "client_secret": "secret",
+                    "refresh_token": "token",
+                    "project_id": "adc-project",
+                    "quota_project_id": "billing-project"
+                }`), 0o600)).To(Succeed())
+                GinkgoT().Setenv("GOOGLE_APPLICATION_CREDENTIALS", path)
+
+                projects, err := gcp.NewClientFactory(projectID, logger).DefaultCredentialProjects(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(projects).To(Equal(&gcp.CredentialProjects{ProjectID: "adc-project", QuotaProjectID: "billing-project"}))
+            })
+
+            It("should wrap ErrCredentials when the credentials cannot be loaded", func() {
+                GinkgoT().Setenv("GOOGLE_APPLICATION_CREDENTIALS", filepath.Join(GinkgoT().TempDir(), "missing.json"))
+
+                _, err := gcp.NewClientFactory(projectID, logger).DefaultCredentialProjects(context.Background())
+                Expect(errors.Is(err, gcp.ErrCredentials)).To(BeTrue())
+            })
+        })
+
+        Describe("WithCredentialSource", func() {
+            var (
+                gotScopes []string
+                gotBase   *http.Client
+                source    gcp.CredentialSourceFunc
+            )
+
+            BeforeEach(func() {
+                gotScopes, gotBase = nil, nil
+                source = func(ctx context.Context, scopes ...string) (*http.Client, error) {
+                    gotScopes = scopes
+                    gotBase, _ = ctx.Value(oauth2.HTTPClient).(*http.Client)
+                    return &http.Client{}, nil
+                }
+            })
+
+            It("should request the service's scope from the source", func() {
+                factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(source))
+
+                svc, err := factory.CreateComputeService(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(svc).NotTo(BeNil())
+                Expect(gotScopes).To(Equal([]string{gcp.ComputeScope}))
+                Expect(gotBase).To(BeNil())
+            })
+
+            It("should request overridden scopes and still build a working client", func() {
+                factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(source),
+                    gcp.WithServiceScopes(map[string][]string{
+                        "compute.googleapis.com": {"https://www.googleapis.com/auth/cloud-platform"},
+                    }))
+
+                svc, err := factory.CreateComputeService(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(svc).NotTo(BeNil())
+                Expect(gotScopes).To(Equal([]string{"https://www.googleapis.com/auth/cloud-platform"}))
+
+                // Services without an override keep their default
+                _, err = factory.CreateStorageService(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(gotScopes).To(Equal([]string{gcp.StorageScope}))
+            })
+
+            It("should keep the write scope for the results upload", func() {
+                factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(source),
+                    gcp.WithServiceScopes(map[string][]string{
+                        "storage.googleapis.com": {"https://www.googleapis.com/auth/cloud-platform.read-only"},
+                    }))
+
+                _, err := factory.CreateStorageWriterService(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(gotScopes).To(Equal([]string{gcp.StorageWriteScope}))
+            })
+
+            It("should hand the base transport to the source", func() {
+                transport := &http.Transport{}
+                factory := gcp.NewClientFactory(projectID, logger,
+                    gcp.WithHTTPTransport(transport), gcp.WithCredentialSource(source))
+
+                _, err := factory.CreateIAMService(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(gotBase).NotTo(BeNil())
+                Expect(gotBase.Transport).To(BeIdenticalTo(transport))
+            })
+
+            It("should wrap source errors in ErrCredentials", func() {
+                failing := gcp.CredentialSourceFunc(func(ctx context.Context, scopes ...string) (*http.Client, error) {
+                    return nil, errors.New("token exchange denied")
+                })
+                factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(failing))
+
+                _, err := factory.CreateComputeService(context.Background())
+                Expect(errors.Is(err, gcp.ErrCredentials)).To(BeTrue())
+                Expect(err).To(MatchError(ContainSubstring("token exchange denied")))
+            })
+
+            Context("when the source fails", func() {
+                var (
+                    calls int
+                    fast  gcp.ClientFactoryOption
+                )
+
+                BeforeEach(func() {
+                    calls = 0
+                    fast = gcp.WithRetryConfig(gcp.RetryConfig{
+                        InitialBackoff: time.Millisecond,
+                        MaxBackoff:     time.Millisecond,
+                        MaxRetries:     3,
+                    })
+                })
+
+                It("should retry a transient metadata server failure", func() {
+                    flaky := gcp.CredentialSourceFunc(func(ctx context.Context, scopes ...string) (*http.Client, error) {
+                        calls++
+                        if calls == 1 {
+                            return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+                        }
+                        return &http.Client{}, nil
+                    })
+                    factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(flaky), fast)
+
+                    svc, err := factory.CreateComputeService(context.Background())
+                    Expect(err).NotTo(HaveOccurred())
+                    Expect(svc).NotTo(BeNil())
+                    Expect(calls).To(Equal(2))
+                })
+
+                It("should not retry missing credentials", func() {
+                    missing := gcp.CredentialSourceFunc(func(ctx context.Context, scopes ...string) (*http.Client, error) {
+                        calls++
+                        return nil, errors.New("google: could not find default credentials")
+                    })
+                    factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(missing), fast)
+
+                    _, err := factory.CreateComputeService(context.Background())
+                    Expect(errors.Is(err, gcp.ErrCredentials)).To(BeTrue())
+                    Expect(err).NotTo(MatchError(ContainSubstring("max retries exceeded")))
+                    Expect(calls).To(Equal(1))
+                })
+            })
+
+            It("should report no credential projects for sources that do not know them", func() {
+                factory := gcp.NewClientFactory(projectID, logger, gcp.WithCredentialSource(source))
+
+                projects, err := factory.DefaultCredentialProjects(context.Background())
+                Expect(err).NotTo(HaveOccurred())
+                Expect(projects).To(Equal(&gcp.CredentialProjects{}))
+            })
+        })
     })
 })
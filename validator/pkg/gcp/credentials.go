@@ -0,0 +1,60 @@
+package gcp
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+
+    "golang.org/x/oauth2/google"
+)
+
+// CredentialSource builds the authenticated HTTP clients behind every service client
+// The ClientFactory puts its base client (custom transport or proxy) in ctx under oauth2.HTTPClient,
+// so sources built on oauth2 use it for token fetches and API calls alike
+type CredentialSource interface {
+    // Client returns an HTTP client authorized for scopes
+    Client(ctx context.Context, scopes ...string) (*http.Client, error)
+}
+
+// CredentialProjectsSource is implemented by credential sources that know which projects their
+// credentials are tied to; DefaultCredentialProjects reports nothing for sources without it
+type CredentialProjectsSource interface {
+    CredentialProjects(ctx context.Context) (*CredentialProjects, error)
+}
+
+// CredentialSourceFunc adapts a function to the CredentialSource interface
+type CredentialSourceFunc func(ctx context.Context, scopes ...string) (*http.Client, error)
+
+// Client calls f
+func (f CredentialSourceFunc) Client(ctx context.Context, scopes ...string) (*http.Client, error) {
+    return f(ctx, scopes...)
+}
+
+// ADCSource is the default CredentialSource: Application Default Credentials, i.e.
+// GOOGLE_APPLICATION_CREDENTIALS (WIF credential configs included), gcloud user credentials,
+// or the metadata server
+type ADCSource struct{}
+
+// Client creates a new client for each call with the specified scopes
+// google.DefaultClient handles connection pooling and credential caching internally
+func (ADCSource) Client(ctx context.Context, scopes ...string) (*http.Client, error) {
+    return google.DefaultClient(ctx, scopes...)
+}
+
+// CredentialProjects reads the projects of the Application Default Credentials without fetching a token
+func (ADCSource) CredentialProjects(ctx context.Context) (*CredentialProjects, error) {
+    creds, err := google.FindDefaultCredentials(ctx)
+    if err != nil {
+        return nil, err
+    }
+    projects := &CredentialProjects{ProjectID: creds.ProjectID}
+    if len(creds.JSON) > 0 {
+        var credsFile struct {
+            QuotaProjectID string `json:"quota_project_id"`
+        }
+        if err := json.Unmarshal(creds.JSON, &credsFile); err == nil {
+            projects.QuotaProjectID = credsFile.QuotaProjectID
+        }
+    }
+    return projects, nil
+}
@@ -0,0 +1,92 @@
+package gcp
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "net"
+    "net/http"
+
+    "google.golang.org/api/googleapi"
+)
+
+// ErrorClass is the coarse kind of a GCP error, used to decide whether to retry or how to report it
+type ErrorClass string
+
+// Error classes returned by ClassifyError
+const (
+    ErrorClassNone      ErrorClass = ""          // No error
+    ErrorClassAuth      ErrorClass = "auth"      // Missing credentials, rejected token, 401 or 403
+    ErrorClassNotFound  ErrorClass = "not_found" // 404: the resource does not exist
+    ErrorClassClient    ErrorClass = "client"    // Any other 4xx: the request or configuration is wrong
+    ErrorClassRetryable ErrorClass = "retryable" // Rate limits, 5xx and network failures; worth retrying
+    ErrorClassUnknown   ErrorClass = "unknown"   // Not a GCP API or network error
+)
+
+// rateLimitReasons are GCP error reasons that signal throttling, which some APIs return with a 403
+var rateLimitReasons = map[string]bool{
+    "rateLimitExceeded":     true,
+    "userRateLimitExceeded": true,
+}
+
+// ClassifyError sorts err into an ErrorClass, looking through wrapped errors
+// A 403 carrying a rate-limit reason is retryable rather than an auth error
+func ClassifyError(err error) ErrorClass {
+    if err == nil {
+        return ErrorClassNone
+    }
+
+    var apiErr *googleapi.Error
+    if errors.As(err, &apiErr) && rateLimitReasons[ExtractReason(apiErr, "")] {
+        return ErrorClassRetryable
+    }
+    if IsAuthError(err) {
+        return ErrorClassAuth
+    }
+
+    if apiErr != nil {
+        switch {
+        case apiErr.Code == http.StatusNotFound:
+            return ErrorClassNotFound
+        case apiErr.Code == http.StatusTooManyRequests, apiErr.Code >= http.StatusInternalServerError:
+            return ErrorClassRetryable
+        case apiErr.Code >= http.StatusBadRequest:
+            return ErrorClassClient
+        }
+        return ErrorClassUnknown
+    }
+
+    // The caller's own deadline or cancellation is not worth retrying, although
+    // context.DeadlineExceeded satisfies net.Error
+    if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+        return ErrorClassUnknown
+    }
+
+    var netErr net.Error
+    if errors.As(err, &netErr) {
+        return ErrorClassRetryable
+    }
+    return ErrorClassUnknown
+}
+
+// ExtractReason extracts a structured error reason from GCP API errors
+// Prioritizes the GCP-specific reason, then "HTTP_<code>"; errors that are not GCP API errors get fallback
+func ExtractReason(err error, fallback string) string {
+    if err == nil {
+        return fallback
+    }
+
+    var apiErr *googleapi.Error
+    if errors.As(err, &apiErr) {
+        // First, try to get GCP-specific reason (more detailed)
+        if len(apiErr.Errors) > 0 && apiErr.Errors[0].Reason != "" {
+            return apiErr.Errors[0].Reason
+        }
+
+        // No specific reason provided, return generic HTTP code
+        return fmt.Sprintf("HTTP_%d", apiErr.Code)
+    }
+
+    // Not a GCP API error, use fallback
+    return fallback
+}
@@ -0,0 +1,50 @@
+package gcp_test
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "net"
+    "net/url"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/gcp"
+)
+
+var _ = Describe("GCP errors", func() {
+    forbidden := &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+    DescribeTable("ClassifyError",
+        func(err error, expected gcp.ErrorClass) {
+            Expect(gcp.ClassifyError(err)).To(Equal(expected))
+        },
+        Entry("nil", nil, gcp.ErrorClassNone),
+        Entry("403 from an API", forbidden, gcp.ErrorClassAuth),
+        Entry("403 rate limit", &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}, gcp.ErrorClassRetryable),
+        Entry("missing credentials", fmt.Errorf("failed to create default client: %w", gcp.ErrCredentials), gcp.ErrorClassAuth),
+        Entry("404 from an API", &googleapi.Error{Code: 404}, gcp.ErrorClassNotFound),
+        Entry("400 from an API", &googleapi.Error{Code: 400}, gcp.ErrorClassClient),
+        Entry("429 from an API", &googleapi.Error{Code: 429}, gcp.ErrorClassRetryable),
+        Entry("503 from an API", &googleapi.Error{Code: 503}, gcp.ErrorClassRetryable),
+        Entry("wrapped 503", fmt.Errorf("failed to list routes: %w", &googleapi.Error{Code: 503}), gcp.ErrorClassRetryable),
+        Entry("API error nested in a transport error", &url.Error{Op: "Get", URL: "https://compute.googleapis.com", Err: &googleapi.Error{Code: 404}}, gcp.ErrorClassNotFound),
+        Entry("network error", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, gcp.ErrorClassRetryable),
+        Entry("context deadline", context.DeadlineExceeded, gcp.ErrorClassUnknown),
+        Entry("generic error", errors.New("boom"), gcp.ErrorClassUnknown),
+    )
+
+    DescribeTable("ExtractReason",
+        func(err error, expected string) {
+            Expect(gcp.ExtractReason(err, "Fallback")).To(Equal(expected))
+        },
+        Entry("nil", nil, "Fallback"),
+        Entry("API error with a reason", forbidden, "forbidden"),
+        Entry("API error without a reason", &googleapi.Error{Code: 503}, "HTTP_503"),
+        Entry("wrapped API error", fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", forbidden)), "forbidden"),
+        Entry("API error nested in a transport error", &url.Error{Op: "Get", URL: "https://compute.googleapis.com", Err: forbidden}, "forbidden"),
+        Entry("generic error", errors.New("boom"), "Fallback"),
+    )
+})
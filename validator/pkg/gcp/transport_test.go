@@ -0,0 +1,106 @@
+package gcp_test
+
+import (
+    "crypto/tls"
+    "encoding/pem"
+    "log/slog"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "path/filepath"
+    "time"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/gcp"
+)
+
+var _ = Describe("Transport", func() {
+    Describe("TransportConfig", func() {
+        It("should report zero when nothing is configured", func() {
+            Expect(gcp.TransportConfig{}.IsZero()).To(BeTrue())
+            Expect(gcp.TransportConfig{CACertFile: "/ca.pem"}.IsZero()).To(BeFalse())
+        })
+    })
+
+    Describe("NewTransport", func() {
+        It("should apply timeouts", func() {
+            transport, err := gcp.NewTransport(gcp.TransportConfig{
+                DialTimeout:           5 * time.Second,
+                ResponseHeaderTimeout: 20 * time.Second,
+            })
+            Expect(err).NotTo(HaveOccurred())
+            Expect(transport.ResponseHeaderTimeout).To(Equal(20 * time.Second))
+            Expect(transport.DialContext).NotTo(BeNil())
+        })
+
+        It("should use an explicit proxy URL", func() {
+            transport, err := gcp.NewTransport(gcp.TransportConfig{ProxyURL: "http://proxy.internal:3128"})
+            Expect(err).NotTo(HaveOccurred())
+
+            req, _ := http.NewRequest(http.MethodGet, "https://compute.googleapis.com", nil)
+            proxyURL, err := transport.Proxy(req)
+            Expect(err).NotTo(HaveOccurred())
+            Expect(proxyURL.String()).To(Equal("http://proxy.internal:3128"))
+        })
+
+        It("should reject an invalid proxy URL", func() {
+            _, err := gcp.NewTransport(gcp.TransportConfig{ProxyURL: "://bad"})
+            Expect(err).To(MatchError(ContainSubstring("invalid proxy URL")))
+        })
+
+        Context("with a CA cert file", func() {
+            var server *httptest.Server
+
+            BeforeEach(func() {
+                server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+                    w.WriteHeader(http.StatusOK)
+                }))
+                DeferCleanup(server.Close)
+            })
+
+            It("should trust the extra CA", func() {
+                caFile := filepath.Join(GinkgoT().TempDir(), "ca.pem")
+                Expect(os.WriteFile(caFile, certPEM(server.TLS), 0600)).To(Succeed())
+
+                transport, err := gcp.NewTransport(gcp.TransportConfig{CACertFile: caFile})
+                Expect(err).NotTo(HaveOccurred())
+
+                resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+                Expect(err).NotTo(HaveOccurred())
+                resp.Body.Close()
+                Expect(resp.StatusCode).To(Equal(http.StatusOK))
+            })
+
+            It("should fail on a missing file", func() {
+                _, err := gcp.NewTransport(gcp.TransportConfig{CACertFile: "/does/not/exist.pem"})
+                Expect(err).To(MatchError(ContainSubstring("failed to read CA cert file")))
+            })
+
+            It("should fail on a file without certificates", func() {
+                caFile := filepath.Join(GinkgoT().TempDir(), "empty.pem")
+                Expect(os.WriteFile(caFile, []byte("not a cert"), 0600)).To(Succeed())
+
+                _, err := gcp.NewTransport(gcp.TransportConfig{CACertFile: caFile})
+                Expect(err).To(MatchError(ContainSubstring("no valid PEM certificates")))
+            })
+        })
+    })
+
+    Describe("WithHTTPTransport", func() {
+        It("should be accepted by NewClientFactory", func() {
+            transport, err := gcp.NewTransport(gcp.TransportConfig{DialTimeout: time.Second})
+            Expect(err).NotTo(HaveOccurred())
+
+            factory := gcp.NewClientFactory("test-project", slog.Default(), gcp.WithHTTPTransport(transport))
+            Expect(factory).NotTo(BeNil())
+        })
+    })
+})
+
+// certPEM returns the PEM-encoded leaf certificate served by an httptest TLS server
+func certPEM(cfg *tls.Config) []byte {
+    cert := cfg.Certificates[0].Certificate[0]
+    return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+}
@@ -0,0 +1,305 @@
+package output
+
+import (
+    "bufio"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io"
+    "log/slog"
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+    "time"
+)
+
+// FileWriter writes aggregated results to the canonical results file
+// and optionally keeps a rotating history of timestamped copies next to it
+type FileWriter struct {
+    Path    string           // Canonical results path (always holds the latest result)
+    History int              // Number of timestamped copies to keep; 0 disables history
+    Now     func() time.Time // Clock for history timestamps (overridable in tests)
+    // Create missing parent directories before writing; set for templated paths,
+    // whose directories may not exist until the placeholders are substituted
+    CreateDirs bool
+    // Receives the results when the canonical file cannot be written (e.g. a read-only
+    // volume), so they are not lost; the write still returns the error
+    Fallback io.Writer
+    // Write the SHA-256 of the canonical file to ChecksumPath(Path) in sha256sum format
+    Checksum bool
+    logger   *slog.Logger
+}
+
+// Placeholders substituted in a results path template by ExpandPath
+const (
+    PathPlaceholderProject   = "{project}"
+    PathPlaceholderTimestamp = "{timestamp}"
+    PathPlaceholderStatus    = "{status}"
+)
+
+// PathVars holds the values substituted into a results path template
+type PathVars struct {
+    Project string
+    Status  string
+    Time    time.Time
+}
+
+// HasPathPlaceholders reports whether path contains any results path placeholder
+func HasPathPlaceholders(path string) bool {
+    return strings.Contains(path, PathPlaceholderProject) ||
+        strings.Contains(path, PathPlaceholderTimestamp) ||
+        strings.Contains(path, PathPlaceholderStatus)
+}
+
+// ExpandPath substitutes the placeholders in a results path template, e.g.
+// /results/{project}-{timestamp}.json -> /results/my-project-2026-01-15T10:30:00Z.json
+// The timestamp uses the same UTC RFC3339 format as history files; paths without
+// placeholders are returned unchanged
+func ExpandPath(path string, vars PathVars) string {
+    if !HasPathPlaceholders(path) {
+        return path
+    }
+    return strings.NewReplacer(
+        PathPlaceholderProject, vars.Project,
+        PathPlaceholderTimestamp, vars.Time.UTC().Format(time.RFC3339),
+        PathPlaceholderStatus, vars.Status,
+    ).Replace(path)
+}
+
+// ChecksumPath returns the path of the checksum file written next to a results file
+func ChecksumPath(path string) string {
+    return path + ".sha256"
+}
+
+// Checksum returns the hex SHA-256 digest of data
+func Checksum(data []byte) string {
+    sum := sha256.Sum256(data)
+    return hex.EncodeToString(sum[:])
+}
+
+// NewFileWriter creates a FileWriter for the given path and history depth
+func NewFileWriter(path string, history int, logger *slog.Logger) *FileWriter {
+    return &FileWriter{
+        Path:    path,
+        History: history,
+        Now:     time.Now,
+        logger:  logger,
+    }
+}
+
+// Write writes data to the canonical path, then records and prunes history when enabled
+// History failures are logged but do not fail the write: the canonical file is what consumers read
+func (w *FileWriter) Write(data []byte) error {
+    // Note: In Kubernetes, the /results directory should be pre-created via volumeMounts
+    if err := w.ensureDir(); err != nil {
+        return w.fallback(err, func(out io.Writer) error {
+            _, err := out.Write(data)
+            return err
+        })
+    }
+    if err := os.WriteFile(w.Path, data, 0644); err != nil {
+        return w.fallback(fmt.Errorf("failed to write results to %s: %w", w.Path, err), func(out io.Writer) error {
+            _, err := out.Write(data)
+            return err
+        })
+    }
+    if err := w.writeChecksum(Checksum(data)); err != nil {
+        return err
+    }
+    w.recordHistory()
+    return nil
+}
+
+// WriteJSON streams v as indented JSON to the canonical path, then records and prunes history
+// Unlike json.MarshalIndent + Write, the serialized payload is never held in memory as a whole
+func (w *FileWriter) WriteJSON(v interface{}) error {
+    digest, err := w.writeJSONFile(v)
+    if err != nil {
+        return w.fallback(err, func(out io.Writer) error { return EncodeJSON(out, v) })
+    }
+    if err := w.writeChecksum(digest); err != nil {
+        return err
+    }
+    w.recordHistory()
+    return nil
+}
+
+// writeJSONFile streams v to the canonical path and returns the hex SHA-256 of the bytes written
+func (w *FileWriter) writeJSONFile(v interface{}) (string, error) {
+    if err := w.ensureDir(); err != nil {
+        return "", err
+    }
+    f, err := os.OpenFile(w.Path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+    if err != nil {
+        return "", fmt.Errorf("failed to write results to %s: %w", w.Path, err)
+    }
+    hash := sha256.New()
+    if err := EncodeJSON(io.MultiWriter(f, hash), v); err != nil {
+        _ = f.Close()
+        return "", fmt.Errorf("failed to write results to %s: %w", w.Path, err)
+    }
+    if err := f.Close(); err != nil {
+        return "", fmt.Errorf("failed to write results to %s: %w", w.Path, err)
+    }
+    return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// writeChecksum writes digest to ChecksumPath(Path) as "<digest>  <file name>", which sha256sum -c verifies
+// No-op unless Checksum is set. Unlike history, a failure fails the write: consumers rely on the checksum
+func (w *FileWriter) writeChecksum(digest string) error {
+    if !w.Checksum {
+        return nil
+    }
+    path := ChecksumPath(w.Path)
+    line := fmt.Sprintf("%s  %s\n", digest, filepath.Base(w.Path))
+    if err := os.WriteFile(path, []byte(line), 0644); err != nil {
+        return fmt.Errorf("failed to write results checksum to %s: %w", path, err)
+    }
+    return nil
+}
+
+// fallback emits the results with emit to Fallback after the canonical file failed with cause
+// cause is always returned, joined with the fallback's own error if that failed too
+func (w *FileWriter) fallback(cause error, emit func(io.Writer) error) error {
+    if w.Fallback == nil {
+        return cause
+    }
+    w.logger.Error("Results file could not be written, emitting results to the fallback output instead",
+        "path", w.Path,
+        "error", cause)
+    if err := emit(w.Fallback); err != nil {
+        return errors.Join(cause, fmt.Errorf("failed to emit results to the fallback output: %w", err))
+    }
+    return cause
+}
+
+// ensureDir creates the parent directory of the canonical path when CreateDirs is set
+func (w *FileWriter) ensureDir() error {
+    if !w.CreateDirs {
+        return nil
+    }
+    dir := filepath.Dir(w.Path)
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        return fmt.Errorf("failed to create results directory %s: %w", dir, err)
+    }
+    return nil
+}
+
+// EncodeJSON streams v to out using the same indented format as json.MarshalIndent(v, "", "  ")
+// The encoder adds a trailing newline after the document
+func EncodeJSON(out io.Writer, v interface{}) error {
+    buf := bufio.NewWriter(out)
+    enc := json.NewEncoder(buf)
+    enc.SetIndent("", "  ")
+    if err := enc.Encode(v); err != nil {
+        return err
+    }
+    return buf.Flush()
+}
+
+// recordHistory copies the canonical file to a timestamped history file and prunes old ones
+// No-op when history is disabled; failures are only logged
+func (w *FileWriter) recordHistory() {
+    if w.History <= 0 {
+        return
+    }
+
+    historyPath := w.historyPath(w.Now())
+    if err := copyFile(w.Path, historyPath); err != nil {
+        w.logger.Warn("Failed to write results history file", "path", historyPath, "error", err)
+        return
+    }
+    w.logger.Debug("Wrote results history file", "path", historyPath)
+
+    if err := w.prune(); err != nil {
+        w.logger.Warn("Failed to prune results history", "error", err)
+    }
+}
+
+// copyFile streams src to dst, creating or truncating dst
+func copyFile(src, dst string) error {
+    in, err := os.Open(src)
+    if err != nil {
+        return err
+    }
+    defer in.Close()
+
+    out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+    if err != nil {
+        return err
+    }
+    if _, err := io.Copy(out, in); err != nil {
+        _ = out.Close()
+        return err
+    }
+    return out.Close()
+}
+
+// historyPath returns the timestamped history file name, e.g. adapter-result-2026-01-15T10:30:00Z.json
+func (w *FileWriter) historyPath(t time.Time) string {
+    prefix, ext := w.historyPattern()
+    return prefix + t.UTC().Format(time.RFC3339) + ext
+}
+
+// historyPattern returns the path prefix and extension shared by all history files
+func (w *FileWriter) historyPattern() (string, string) {
+    ext := filepath.Ext(w.Path)
+    return strings.TrimSuffix(w.Path, ext) + "-", ext
+}
+
+// HistoryFiles returns existing history files, oldest first
+func (w *FileWriter) HistoryFiles() ([]string, error) {
+    prefix, ext := w.historyPattern()
+    entries, err := os.ReadDir(filepath.Dir(w.Path))
+    if err != nil {
+        return nil, err
+    }
+
+    type historyFile struct {
+        path string
+        ts   time.Time
+    }
+    var files []historyFile
+    for _, e := range entries {
+        if e.IsDir() {
+            continue
+        }
+        path := filepath.Join(filepath.Dir(w.Path), e.Name())
+        if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, ext) {
+            continue
+        }
+        // Only consider files whose suffix is a valid timestamp we wrote
+        ts, err := time.Parse(time.RFC3339, strings.TrimSuffix(strings.TrimPrefix(path, prefix), ext))
+        if err != nil {
+            continue
+        }
+        files = append(files, historyFile{path: path, ts: ts})
+    }
+
+    sort.Slice(files, func(i, j int) bool { return files[i].ts.Before(files[j].ts) })
+
+    paths := make([]string, len(files))
+    for i, f := range files {
+        paths[i] = f.path
+    }
+    return paths, nil
+}
+
+// prune removes the oldest history files beyond the configured depth
+func (w *FileWriter) prune() error {
+    files, err := w.HistoryFiles()
+    if err != nil {
+        return err
+    }
+    for len(files) > w.History {
+        if err := os.Remove(files[0]); err != nil && !os.IsNotExist(err) {
+            return fmt.Errorf("failed to remove %s: %w", files[0], err)
+        }
+        w.logger.Debug("Pruned results history file", "path", files[0])
+        files = files[1:]
+    }
+    return nil
+}
@@ -0,0 +1,66 @@
+package output_test
+
+import (
+    "encoding/json"
+    "fmt"
+    "io"
+    "log/slog"
+    "path/filepath"
+    "testing"
+
+    "validator/pkg/output"
+)
+
+// syntheticResults builds an aggregated-result-shaped payload with n validator entries,
+// roughly what multi-project runs with sub-results produce
+func syntheticResults(n int) map[string]interface{} {
+    validators := make([]map[string]interface{}, n)
+    for i := range validators {
+        validators[i] = map[string]interface{}{
+            "validator_name": fmt.Sprintf("project-%d/api-enabled", i),
+            "status":         "success",
+            "reason":         "AllAPIsEnabled",
+            "message":        "All 3 required APIs are enabled",
+            "details": map[string]interface{}{
+                "enabled_apis": []string{"compute.googleapis.com", "iam.googleapis.com", "cloudresourcemanager.googleapis.com"},
+                "project_id":   fmt.Sprintf("project-%d", i),
+            },
+        }
+    }
+    return map[string]interface{}{
+        "status":  "success",
+        "reason":  "ValidationPassed",
+        "message": "All GCP validation checks passed successfully",
+        "details": map[string]interface{}{"validators": validators},
+    }
+}
+
+// BenchmarkMarshalIndentWrite measures the previous path: marshal to a buffer, then write it
+func BenchmarkMarshalIndentWrite(b *testing.B) {
+    payload := syntheticResults(10000)
+    w := output.NewFileWriter(filepath.Join(b.TempDir(), "adapter-result.json"), 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        data, err := json.MarshalIndent(payload, "", "  ")
+        if err != nil {
+            b.Fatal(err)
+        }
+        if err := w.Write(data); err != nil {
+            b.Fatal(err)
+        }
+    }
+}
+
+// BenchmarkWriteJSON measures the streaming path
+func BenchmarkWriteJSON(b *testing.B) {
+    payload := syntheticResults(10000)
+    w := output.NewFileWriter(filepath.Join(b.TempDir(), "adapter-result.json"), 0, slog.New(slog.NewTextHandler(io.Discard, nil)))
+    b.ReportAllocs()
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        if err := w.WriteJSON(payload); err != nil {
+            b.Fatal(err)
+        }
+    }
+}
@@ -0,0 +1,253 @@
+package output_test
+
+import (
+    "bytes"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "log/slog"
+    "os"
+    "path/filepath"
+    "time"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/output"
+)
+
+var _ = Describe("FileWriter", func() {
+    var (
+        dir    string
+        path   string
+        logger *slog.Logger
+        clock  time.Time
+    )
+
+    BeforeEach(func() {
+        dir = GinkgoT().TempDir()
+        path = filepath.Join(dir, "adapter-result.json")
+        logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        clock = time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+    })
+
+    newWriter := func(history int) *output.FileWriter {
+        w := output.NewFileWriter(path, history, logger)
+        w.Now = func() time.Time {
+            clock = clock.Add(time.Minute)
+            return clock
+        }
+        return w
+    }
+
+    Context("with history disabled", func() {
+        It("should only write the canonical file", func() {
+            w := newWriter(0)
+            Expect(w.Write([]byte(`{"n":1}`))).To(Succeed())
+            Expect(w.Write([]byte(`{"n":2}`))).To(Succeed())
+
+            data, err := os.ReadFile(path)
+            Expect(err).NotTo(HaveOccurred())
+            Expect(string(data)).To(Equal(`{"n":2}`))
+
+            entries, err := os.ReadDir(dir)
+            Expect(err).NotTo(HaveOccurred())
+            Expect(entries).To(HaveLen(1))
+        })
+    })
+
+    Context("with history enabled", func() {
+        It("should write a timestamped copy alongside the canonical file", func() {
+            w := newWriter(3)
+            Expect(w.Write([]byte(`{"n":1}`))).To(Succeed())
+
+            Expect(filepath.Join(dir, "adapter-result-2026-01-15T10:31:00Z.json")).To(BeARegularFile())
+            Expect(path).To(BeARegularFile())
+        })
+
+        It("should keep only the last N results and the latest canonical file", func() {
+            w := newWriter(2)
+            for _, body := range []string{`{"n":1}`, `{"n":2}`, `{"n":3}`, `{"n":4}`} {
+                Expect(w.Write([]byte(body))).To(Succeed())
+            }
+
+            files, err := w.HistoryFiles()
+            Expect(err).NotTo(HaveOccurred())
+            Expect(files).To(Equal([]string{
+                filepath.Join(dir, "adapter-result-2026-01-15T10:33:00Z.json"),
+                filepath.Join(dir, "adapter-result-2026-01-15T10:34:00Z.json"),
+            }))
+
+            latest, err := os.ReadFile(path)
+            Expect(err).NotTo(HaveOccurred())
+            Expect(string(latest)).To(Equal(`{"n":4}`))
+        })
+
+        It("should ignore unrelated files in the results directory", func() {
+            other := filepath.Join(dir, "adapter-result-notes.json")
+            Expect(os.WriteFile(other, []byte("x"), 0644)).To(Succeed())
+
+            w := newWriter(1)
+            Expect(w.Write([]byte(`{"n":1}`))).To(Succeed())
+            Expect(w.Write([]byte(`{"n":2}`))).To(Succeed())
+
+            Expect(other).To(BeARegularFile())
+            files, err := w.HistoryFiles()
+            Expect(err).NotTo(HaveOccurred())
+            Expect(files).To(HaveLen(1))
+        })
+    })
+
+    It("should return an error when the canonical file cannot be written", func() {
+        w := output.NewFileWriter(filepath.Join(dir, "missing", "result.json"), 0, logger)
+        Expect(w.Write([]byte("{}"))).NotTo(Succeed())
+        Expect(w.WriteJSON(map[string]int{"n": 1})).NotTo(Succeed())
+    })
+
+    Context("with a fallback output", func() {
+        var fallback *bytes.Buffer
+
+        BeforeEach(func() {
+            fallback = &bytes.Buffer{}
+        })
+
+        It("should emit the results to the fallback when the file cannot be written", func() {
+            // A directory in place of the file cannot be opened for writing, even by root
+            target := filepath.Join(dir, "result.json")
+            Expect(os.Mkdir(target, 0755)).To(Succeed())
+
+            w := output.NewFileWriter(target, 0, logger)
+            w.Fallback = fallback
+
+            err := w.WriteJSON(map[string]int{"n": 1})
+            Expect(err).To(MatchError(ContainSubstring("failed to write results")))
+            Expect(fallback.String()).To(Equal("{\n  \"n\": 1\n}\n"))
+        })
+
+        It("should emit raw data to the fallback when the directory is missing", func() {
+            w := output.NewFileWriter(filepath.Join(dir, "missing", "result.json"), 0, logger)
+            w.Fallback = fallback
+
+            Expect(w.Write([]byte("{}"))).NotTo(Succeed())
+            Expect(fallback.String()).To(Equal("{}"))
+        })
+
+        It("should not use the fallback when the file is written", func() {
+            w := output.NewFileWriter(filepath.Join(dir, "result.json"), 0, logger)
+            w.Fallback = fallback
+
+            Expect(w.WriteJSON(map[string]int{"n": 1})).To(Succeed())
+            Expect(fallback.Len()).To(BeZero())
+        })
+    })
+
+    Context("with CreateDirs set", func() {
+        It("should create missing parent directories", func() {
+            target := filepath.Join(dir, "test-project", "failure", "result.json")
+            w := output.NewFileWriter(target, 0, logger)
+            w.CreateDirs = true
+
+            Expect(w.WriteJSON(map[string]int{"n": 1})).To(Succeed())
+            Expect(target).To(BeARegularFile())
+        })
+
+        It("should return an error when a parent is not a directory", func() {
+            Expect(os.WriteFile(filepath.Join(dir, "blocker"), nil, 0644)).To(Succeed())
+            w := output.NewFileWriter(filepath.Join(dir, "blocker", "result.json"), 0, logger)
+            w.CreateDirs = true
+
+            Expect(w.Write([]byte("{}"))).To(MatchError(ContainSubstring("failed to create results directory")))
+        })
+    })
+
+    Context("when streaming JSON", func() {
+        payload := map[string]interface{}{
+            "status": "success",
+            "details": map[string]interface{}{
+                "checks_run": 2,
+                "failed":     []string{"a", "b"},
+            },
+        }
+
+        It("should match the MarshalIndent format", func() {
+            w := newWriter(0)
+            Expect(w.WriteJSON(payload)).To(Succeed())
+
+            expected, err := json.MarshalIndent(payload, "", "  ")
+            Expect(err).NotTo(HaveOccurred())
+            data, err := os.ReadFile(path)
+            Expect(err).NotTo(HaveOccurred())
+            // The encoder terminates the document with a newline
+            Expect(string(data)).To(Equal(string(expected) + "\n"))
+        })
+
+        It("should record history copies of the streamed file", func() {
+            w := newWriter(2)
+            Expect(w.WriteJSON(payload)).To(Succeed())
+
+            files, err := w.HistoryFiles()
+            Expect(err).NotTo(HaveOccurred())
+            Expect(files).To(HaveLen(1))
+
+            latest, err := os.ReadFile(path)
+            Expect(err).NotTo(HaveOccurred())
+            history, err := os.ReadFile(files[0])
+            Expect(err).NotTo(HaveOccurred())
+            Expect(history).To(Equal(latest))
+        })
+    })
+
+    Context("with Checksum set", func() {
+        It("should write the SHA-256 of the streamed bytes next to the results file", func() {
+            w := newWriter(0)
+            w.Checksum = true
+            Expect(w.WriteJSON(map[string]string{"status": "success"})).To(Succeed())
+
+            data, err := os.ReadFile(path)
+            Expect(err).NotTo(HaveOccurred())
+            sum := sha256.Sum256(data)
+            checksum, err := os.ReadFile(output.ChecksumPath(path))
+            Expect(err).NotTo(HaveOccurred())
+            Expect(string(checksum)).To(Equal(hex.EncodeToString(sum[:]) + "  adapter-result.json\n"))
+        })
+
+        It("should checksum raw writes", func() {
+            w := newWriter(0)
+            w.Checksum = true
+            Expect(w.Write([]byte("raw results"))).To(Succeed())
+
+            checksum, err := os.ReadFile(output.ChecksumPath(path))
+            Expect(err).NotTo(HaveOccurred())
+            Expect(string(checksum)).To(HavePrefix(output.Checksum([]byte("raw results")) + "  "))
+        })
+
+        It("should not write a checksum file by default", func() {
+            Expect(newWriter(0).WriteJSON(map[string]string{})).To(Succeed())
+            Expect(output.ChecksumPath(path)).NotTo(BeAnExistingFile())
+        })
+    })
+})
+
+var _ = Describe("ExpandPath", func() {
+    vars := output.PathVars{
+        Project: "test-project",
+        Status:  "success",
+        Time:    time.Date(2026, 1, 15, 10, 30, 0, 0, time.FixedZone("CET", 3600)),
+    }
+
+    It("should substitute every placeholder", func() {
+        Expect(output.ExpandPath("/results/{project}/{status}-{timestamp}.json", vars)).
+            To(Equal("/results/test-project/success-2026-01-15T09:30:00Z.json"))
+    })
+
+    It("should return paths without placeholders unchanged", func() {
+        Expect(output.HasPathPlaceholders("/results/adapter-result.json")).To(BeFalse())
+        Expect(output.ExpandPath("/results/adapter-result.json", vars)).To(Equal("/results/adapter-result.json"))
+    })
+
+    It("should leave unknown placeholders alone", func() {
+        Expect(output.ExpandPath("/results/{project}-{region}.json", vars)).To(Equal("/results/test-project-{region}.json"))
+    })
+})
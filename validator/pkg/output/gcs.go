@@ -0,0 +1,72 @@
+package output
+
+import (
+    "bytes"
+    "context"
+    "fmt"
+    "log/slog"
+)
+
+// ObjectUploader creates or replaces a Cloud Storage object; gcp.StorageWriterAPI satisfies it
+type ObjectUploader interface {
+    UploadObject(ctx context.Context, bucket, name, contentType string, data []byte, metadata map[string]string) error
+}
+
+// ChecksumMetadataKey is the custom metadata key holding the hex SHA-256 of an uploaded results object
+const ChecksumMetadataKey = "sha256"
+
+// GCSSink uploads aggregated results as JSON to a Cloud Storage object
+type GCSSink struct {
+    Bucket   string
+    Object   string
+    Uploader ObjectUploader
+    // Set the ChecksumMetadataKey metadata of the object to the SHA-256 of the uploaded bytes
+    Checksum bool
+    // Retry runs each upload attempt; nil means a single attempt
+    Retry  func(ctx context.Context, operation func() error) error
+    logger *slog.Logger
+}
+
+// NewGCSSink creates a GCSSink writing gs://<bucket>/<object> through uploader
+func NewGCSSink(bucket, object string, uploader ObjectUploader, logger *slog.Logger) *GCSSink {
+    return &GCSSink{
+        Bucket:   bucket,
+        Object:   object,
+        Uploader: uploader,
+        logger:   logger,
+    }
+}
+
+// URI returns the gs:// URI of the results object
+func (s *GCSSink) URI() string {
+    return "gs://" + s.Bucket + "/" + s.Object
+}
+
+// UploadJSON encodes v like the results file and uploads it, replacing any previous results
+func (s *GCSSink) UploadJSON(ctx context.Context, v interface{}) error {
+    var body bytes.Buffer
+    if err := EncodeJSON(&body, v); err != nil {
+        return fmt.Errorf("failed to encode results for upload: %w", err)
+    }
+
+    var metadata map[string]string
+    if s.Checksum {
+        metadata = map[string]string{ChecksumMetadataKey: Checksum(body.Bytes())}
+    }
+
+    attempt := func() error {
+        s.logger.Debug("Uploading results", "uri", s.URI(), "bytes", body.Len())
+        return s.Uploader.UploadObject(ctx, s.Bucket, s.Object, "application/json", body.Bytes(), metadata)
+    }
+
+    var err error
+    if s.Retry != nil {
+        err = s.Retry(ctx, attempt)
+    } else {
+        err = attempt()
+    }
+    if err != nil {
+        return fmt.Errorf("failed to upload results to %s: %w", s.URI(), err)
+    }
+    return nil
+}
@@ -0,0 +1,87 @@
+package output_test
+
+import (
+    "context"
+    "errors"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/output"
+)
+
+// fakeUploader records uploads and fails the first failures attempts
+type fakeUploader struct {
+    calls       int
+    failures    int
+    bucket      string
+    name        string
+    contentType string
+    data        []byte
+    metadata    map[string]string
+}
+
+func (f *fakeUploader) UploadObject(ctx context.Context, bucket, name, contentType string, data []byte, metadata map[string]string) error {
+    f.calls++
+    if f.calls <= f.failures {
+        return errors.New("backend error")
+    }
+    f.bucket, f.name, f.contentType, f.data, f.metadata = bucket, name, contentType, data, metadata
+    return nil
+}
+
+var _ = Describe("GCSSink", func() {
+    var (
+        logger   *slog.Logger
+        uploader *fakeUploader
+        sink     *output.GCSSink
+    )
+
+    BeforeEach(func() {
+        logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        uploader = &fakeUploader{}
+        sink = output.NewGCSSink("results-bucket", "runs/latest.json", uploader, logger)
+    })
+
+    It("should upload the results as indented JSON", func() {
+        Expect(sink.UploadJSON(context.Background(), map[string]string{"status": "success"})).To(Succeed())
+        Expect(uploader.bucket).To(Equal("results-bucket"))
+        Expect(uploader.name).To(Equal("runs/latest.json"))
+        Expect(uploader.contentType).To(Equal("application/json"))
+        Expect(string(uploader.data)).To(Equal("{\n  \"status\": \"success\"\n}\n"))
+    })
+
+    It("should set the checksum metadata to the SHA-256 of the uploaded bytes", func() {
+        sink.Checksum = true
+        Expect(sink.UploadJSON(context.Background(), map[string]string{"status": "success"})).To(Succeed())
+        Expect(uploader.metadata).To(Equal(map[string]string{output.ChecksumMetadataKey: output.Checksum(uploader.data)}))
+    })
+
+    It("should retry through the configured helper", func() {
+        uploader.failures = 1
+        sink.Retry = func(ctx context.Context, operation func() error) error {
+            var err error
+            for i := 0; i < 3; i++ {
+                if err = operation(); err == nil {
+                    return nil
+                }
+            }
+            return err
+        }
+
+        Expect(sink.UploadJSON(context.Background(), map[string]string{})).To(Succeed())
+        Expect(uploader.calls).To(Equal(2))
+    })
+
+    It("should name the object in the error without a retry helper", func() {
+        uploader.failures = 1
+
+        err := sink.UploadJSON(context.Background(), map[string]string{})
+        Expect(err).To(MatchError(ContainSubstring("gs://results-bucket/runs/latest.json")))
+        Expect(uploader.calls).To(Equal(1))
+    })
+})
@@ -0,0 +1,52 @@
+package output
+
+import (
+    "bytes"
+    "fmt"
+    "io"
+    "os"
+    "strings"
+)
+
+// GitHub Actions annotation levels, the workflow command names
+const (
+    AnnotationError   = "error"
+    AnnotationWarning = "warning"
+)
+
+// Annotation is one result surfaced inline in a GitHub Actions check
+type Annotation struct {
+    Level   string // AnnotationError or AnnotationWarning
+    Title   string // e.g. the validator name
+    Message string
+}
+
+// InGitHubActions reports whether the process runs in a GitHub Actions job, which sets GITHUB_ACTIONS=true
+func InGitHubActions() bool {
+    return os.Getenv("GITHUB_ACTIONS") == "true"
+}
+
+// WriteGitHubAnnotations writes one workflow command line per annotation, e.g.
+// ::error title=quota-check::InsufficientQuota: CPUS has 4 available, 8 required
+// The lines are written in a single call so concurrent writers do not interleave them
+func WriteGitHubAnnotations(w io.Writer, annotations []Annotation) error {
+    if len(annotations) == 0 {
+        return nil
+    }
+    var buf bytes.Buffer
+    for _, a := range annotations {
+        fmt.Fprintf(&buf, "::%s title=%s::%s\n", a.Level, escapeProperty(a.Title), escapeData(a.Message))
+    }
+    _, err := w.Write(buf.Bytes())
+    return err
+}
+
+// escapeData escapes a workflow command message so multi-line text stays on one command line
+func escapeData(s string) string {
+    return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeProperty escapes a workflow command property value, which also cannot contain ':' or ','
+func escapeProperty(s string) string {
+    return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,46 @@
+package output_test
+
+import (
+    "bytes"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/output"
+)
+
+var _ = Describe("GitHub annotations", func() {
+    It("should write one workflow command per annotation", func() {
+        var buf bytes.Buffer
+        err := output.WriteGitHubAnnotations(&buf, []output.Annotation{
+            {Level: output.AnnotationError, Title: "quota-check", Message: "InsufficientQuota: CPUS has 4 available, 8 required"},
+            {Level: output.AnnotationWarning, Title: "api-baseline-check", Message: "UnexpectedAPIEnabled: 1 enabled API(s) are not in the baseline"},
+        })
+        Expect(err).NotTo(HaveOccurred())
+        Expect(buf.String()).To(Equal(
+            "::error title=quota-check::InsufficientQuota: CPUS has 4 available, 8 required\n" +
+                "::warning title=api-baseline-check::UnexpectedAPIEnabled: 1 enabled API(s) are not in the baseline\n"))
+    })
+
+    It("should escape newlines and percent signs in messages and separators in titles", func() {
+        var buf bytes.Buffer
+        err := output.WriteGitHubAnnotations(&buf, []output.Annotation{
+            {Level: output.AnnotationError, Title: "run: a,b", Message: "100% used\nsecond line"},
+        })
+        Expect(err).NotTo(HaveOccurred())
+        Expect(buf.String()).To(Equal("::error title=run%3A a%2Cb::100%25 used%0Asecond line\n"))
+    })
+
+    It("should write nothing without annotations", func() {
+        var buf bytes.Buffer
+        Expect(output.WriteGitHubAnnotations(&buf, nil)).To(Succeed())
+        Expect(buf.Len()).To(BeZero())
+    })
+
+    It("should detect GitHub Actions from GITHUB_ACTIONS", func() {
+        GinkgoT().Setenv("GITHUB_ACTIONS", "true")
+        Expect(output.InGitHubActions()).To(BeTrue())
+        GinkgoT().Setenv("GITHUB_ACTIONS", "")
+        Expect(output.InGitHubActions()).To(BeFalse())
+    })
+})
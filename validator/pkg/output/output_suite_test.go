@@ -0,0 +1,13 @@
+package output_test
+
+import (
+    "testing"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+)
+
+func TestOutput(t *testing.T) {
+    RegisterFailHandler(Fail)
+    RunSpecs(t, "Output Suite")
+}
@@ -0,0 +1,102 @@
+package output
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "fmt"
+    "io"
+    "log/slog"
+    "net/http"
+    "net/url"
+    "time"
+
+    "google.golang.org/api/googleapi"
+)
+
+// ChecksumHeader carries the hex SHA-256 of the POSTed results when WebhookSink.Checksum is set
+const ChecksumHeader = "X-Content-SHA256"
+
+// WebhookSink POSTs aggregated results as JSON to an HTTP endpoint
+type WebhookSink struct {
+    URL    string
+    Client *http.Client
+    // Send the hex SHA-256 of the body in the ChecksumHeader header
+    Checksum bool
+    // Retry runs each POST attempt; nil means a single attempt
+    // Non-2xx responses surface as *googleapi.Error so GCP retry policies apply unchanged
+    Retry  func(ctx context.Context, operation func() error) error
+    logger *slog.Logger
+}
+
+// NewWebhookSink creates a WebhookSink whose attempts are each bounded by timeout
+func NewWebhookSink(target string, timeout time.Duration, logger *slog.Logger) *WebhookSink {
+    return &WebhookSink{
+        URL:    target,
+        Client: &http.Client{Timeout: timeout},
+        logger: logger,
+    }
+}
+
+// PostJSON encodes v like the results file and POSTs it, returning the last HTTP status seen
+// The status is 0 when no response was received
+func (s *WebhookSink) PostJSON(ctx context.Context, v interface{}) (int, error) {
+    var body bytes.Buffer
+    if err := EncodeJSON(&body, v); err != nil {
+        return 0, fmt.Errorf("failed to encode results for webhook: %w", err)
+    }
+
+    status := 0
+    attempt := func() error {
+        req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body.Bytes()))
+        if err != nil {
+            return fmt.Errorf("invalid webhook URL %s", RedactURL(s.URL))
+        }
+        req.Header.Set("Content-Type", "application/json")
+        if s.Checksum {
+            req.Header.Set(ChecksumHeader, Checksum(body.Bytes()))
+        }
+
+        resp, err := s.Client.Do(req)
+        if err != nil {
+            // The *url.Error text includes the request URL, whose query string often carries a token
+            var urlErr *url.Error
+            if errors.As(err, &urlErr) {
+                urlErr.URL = RedactURL(urlErr.URL)
+            }
+            return err
+        }
+        defer resp.Body.Close()
+        status = resp.StatusCode
+        s.logger.Debug("Results webhook responded", "status", status)
+
+        if err := googleapi.CheckResponse(resp); err != nil {
+            return err
+        }
+        // Drain so the connection can be reused
+        _, _ = io.Copy(io.Discard, resp.Body)
+        return nil
+    }
+
+    var err error
+    if s.Retry != nil {
+        err = s.Retry(ctx, attempt)
+    } else {
+        err = attempt()
+    }
+    if err != nil {
+        return status, fmt.Errorf("failed to post results to webhook: %w", err)
+    }
+    return status, nil
+}
+
+// RedactURL strips credentials and the query string, which often carries tokens, before logging
+func RedactURL(raw string) string {
+    u, err := url.Parse(raw)
+    if err != nil {
+        return "<invalid url>"
+    }
+    u.User = nil
+    u.RawQuery = ""
+    return u.String()
+}
@@ -0,0 +1,118 @@
+package output_test
+
+import (
+    "context"
+    "errors"
+    "io"
+    "log/slog"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "sync/atomic"
+    "time"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/output"
+)
+
+var _ = Describe("WebhookSink", func() {
+    var (
+        logger   *slog.Logger
+        calls    atomic.Int32
+        statuses []int
+        received []byte
+        header   http.Header
+        server   *httptest.Server
+    )
+
+    BeforeEach(func() {
+        logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        calls.Store(0)
+        statuses = []int{http.StatusOK}
+        server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+            n := int(calls.Add(1))
+            received, _ = io.ReadAll(r.Body)
+            header = r.Header.Clone()
+            Expect(r.Method).To(Equal(http.MethodPost))
+            Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+            w.WriteHeader(statuses[min(n, len(statuses))-1])
+        }))
+        DeferCleanup(server.Close)
+    })
+
+    // retryAll retries every error up to three attempts, standing in for gcp.Retry
+    retryAll := func(ctx context.Context, operation func() error) error {
+        var err error
+        for i := 0; i < 3; i++ {
+            if err = operation(); err == nil {
+                return nil
+            }
+        }
+        return err
+    }
+
+    It("should post the results as indented JSON", func() {
+        sink := output.NewWebhookSink(server.URL, time.Second, logger)
+
+        status, err := sink.PostJSON(context.Background(), map[string]string{"status": "success"})
+        Expect(err).NotTo(HaveOccurred())
+        Expect(status).To(Equal(http.StatusOK))
+        Expect(string(received)).To(Equal("{\n  \"status\": \"success\"\n}\n"))
+    })
+
+    It("should send the SHA-256 of the body when Checksum is set", func() {
+        sink := output.NewWebhookSink(server.URL, time.Second, logger)
+        sink.Checksum = true
+
+        _, err := sink.PostJSON(context.Background(), map[string]string{"status": "success"})
+        Expect(err).NotTo(HaveOccurred())
+        Expect(header.Get(output.ChecksumHeader)).To(Equal(output.Checksum(received)))
+    })
+
+    It("should report a non-2xx status as a googleapi error", func() {
+        statuses = []int{http.StatusBadRequest}
+        sink := output.NewWebhookSink(server.URL, time.Second, logger)
+
+        status, err := sink.PostJSON(context.Background(), map[string]string{})
+        Expect(status).To(Equal(http.StatusBadRequest))
+        var apiErr *googleapi.Error
+        Expect(errors.As(err, &apiErr)).To(BeTrue())
+        Expect(apiErr.Code).To(Equal(http.StatusBadRequest))
+    })
+
+    It("should retry through the configured helper", func() {
+        statuses = []int{http.StatusServiceUnavailable, http.StatusAccepted}
+        sink := output.NewWebhookSink(server.URL, time.Second, logger)
+        sink.Retry = retryAll
+
+        status, err := sink.PostJSON(context.Background(), map[string]string{})
+        Expect(err).NotTo(HaveOccurred())
+        Expect(status).To(Equal(http.StatusAccepted))
+        Expect(calls.Load()).To(Equal(int32(2)))
+    })
+
+    It("should make a single attempt without a retry helper", func() {
+        statuses = []int{http.StatusServiceUnavailable, http.StatusOK}
+        sink := output.NewWebhookSink(server.URL, time.Second, logger)
+
+        _, err := sink.PostJSON(context.Background(), map[string]string{})
+        Expect(err).To(HaveOccurred())
+        Expect(calls.Load()).To(Equal(int32(1)))
+    })
+
+    It("should redact the webhook token from connection errors", func() {
+        closed := httptest.NewServer(http.NotFoundHandler())
+        closed.Close()
+        sink := output.NewWebhookSink(closed.URL+"/hook?token=s3cret", time.Second, logger)
+
+        _, err := sink.PostJSON(context.Background(), map[string]string{})
+        Expect(err).To(HaveOccurred())
+        Expect(err.Error()).To(ContainSubstring(closed.URL + "/hook"))
+        Expect(err.Error()).NotTo(ContainSubstring("s3cret"))
+    })
+})
@@ -0,0 +1,51 @@
+package validator
+
+import (
+    "fmt"
+    "strings"
+)
+
+// BatchRun is the outcome of one independent validation in a batch
+type BatchRun struct {
+    Name   string      `json:"name"`
+    Status Status      `json:"status"`
+    Error  string      `json:"error,omitempty"`  // Set when the run could not load its config or execute
+    Result interface{} `json:"result,omitempty"` // AggregatedResult, or SummaryResult for OUTPUT_FORMAT=summary
+    Panics int         `json:"panics,omitempty"` // Validators of the run that panicked
+}
+
+// BatchReport combines the runs of a batch into one report
+type BatchReport struct {
+    Status     Status     `json:"status"`
+    Reason     string     `json:"reason"`
+    Message    string     `json:"message"`
+    FailedRuns []string   `json:"failed_runs"`
+    PanicCount int        `json:"panic_count"` // Panicked validators across all runs
+    Runs       []BatchRun `json:"runs"`
+}
+
+// AggregateBatch combines batch runs; the batch fails when any run failed or errored
+// FailedRuns is always a list (empty on success) so consumers need no null check
+func AggregateBatch(runs []BatchRun) *BatchReport {
+    report := &BatchReport{
+        Status:     StatusSuccess,
+        Reason:     ReasonValidationPassed,
+        FailedRuns: []string{},
+        Runs:       runs,
+    }
+    for _, run := range runs {
+        report.PanicCount += run.Panics
+        if run.Status == StatusFailure {
+            report.FailedRuns = append(report.FailedRuns, run.Name)
+        }
+    }
+
+    if len(report.FailedRuns) > 0 {
+        report.Status = StatusFailure
+        report.Reason = ReasonValidationFailed
+        report.Message = fmt.Sprintf("%d of %d batch run(s) failed: %s", len(report.FailedRuns), len(runs), strings.Join(report.FailedRuns, ", "))
+        return report
+    }
+    report.Message = fmt.Sprintf("All %d batch run(s) passed", len(runs))
+    return report
+}
@@ -0,0 +1,43 @@
+package validator_test
+
+import (
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/validator"
+)
+
+var _ = Describe("AggregateBatch", func() {
+    It("should pass when every run passed", func() {
+        report := validator.AggregateBatch([]validator.BatchRun{
+            {Name: "prod", Status: validator.StatusSuccess},
+            {Name: "stage", Status: validator.StatusSuccess},
+        })
+        Expect(report.Status).To(Equal(validator.StatusSuccess))
+        Expect(report.Reason).To(Equal("ValidationPassed"))
+        Expect(report.FailedRuns).To(BeEmpty())
+        Expect(report.FailedRuns).NotTo(BeNil())
+        Expect(report.Runs).To(HaveLen(2))
+    })
+
+    It("should fail when any run failed or errored", func() {
+        report := validator.AggregateBatch([]validator.BatchRun{
+            {Name: "prod", Status: validator.StatusSuccess},
+            {Name: "stage", Status: validator.StatusFailure},
+            {Name: "dev", Status: validator.StatusFailure, Error: "configuration error: PROJECT_ID is required"},
+        })
+        Expect(report.Status).To(Equal(validator.StatusFailure))
+        Expect(report.Reason).To(Equal("ValidationFailed"))
+        Expect(report.FailedRuns).To(Equal([]string{"stage", "dev"}))
+        Expect(report.Message).To(Equal("2 of 3 batch run(s) failed: stage, dev"))
+    })
+
+    It("should add up the panics of every run", func() {
+        report := validator.AggregateBatch([]validator.BatchRun{
+            {Name: "prod", Status: validator.StatusFailure, Panics: 1},
+            {Name: "stage", Status: validator.StatusSuccess},
+            {Name: "dev", Status: validator.StatusFailure, Panics: 2},
+        })
+        Expect(report.PanicCount).To(Equal(3))
+    })
+})
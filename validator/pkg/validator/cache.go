@@ -0,0 +1,87 @@
+package validator
+
+import (
+    "sync"
+    "time"
+)
+
+// DefaultCacheTTL is how long a Cacheable validator's result is reused when it sets no CacheTTL
+const DefaultCacheTTL = 10 * time.Minute
+
+// resultCacheKey identifies a cached result: the same check against the same project with the same settings
+type resultCacheKey struct {
+    validator   string
+    project     string
+    fingerprint string // The validator's CacheKey, empty when it does not implement CacheKeyer
+}
+
+// resultCacheEntry is a cached result and when it stops being served
+type resultCacheEntry struct {
+    result  *Result
+    expires time.Time
+}
+
+// ResultCache keeps the results of Cacheable validators between runs, keyed by validator name, project
+// and the fingerprint of the settings the result depends on
+// It only helps when shared by the Contexts of several runs, e.g. the runs of a batch
+// Thread-safe: parallel validators read and fill it concurrently
+type ResultCache struct {
+    mu      sync.Mutex
+    clock   Clock
+    entries map[resultCacheKey]resultCacheEntry
+}
+
+// NewResultCache creates an empty cache on the real clock
+func NewResultCache() *ResultCache {
+    return &ResultCache{
+        clock:   realClock{},
+        entries: make(map[resultCacheKey]resultCacheEntry),
+    }
+}
+
+// SetClock replaces the clock that expires entries (for testing)
+func (c *ResultCache) SetClock(clock Clock) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    c.clock = clock
+}
+
+// Get returns a copy of the unexpired result of validator for project and fingerprint
+func (c *ResultCache) Get(validator, project, fingerprint string) (*Result, bool) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    key := resultCacheKey{validator: validator, project: project, fingerprint: fingerprint}
+    entry, ok := c.entries[key]
+    if !ok {
+        return nil, false
+    }
+    if !c.clock.Now().Before(entry.expires) {
+        delete(c.entries, key)
+        return nil, false
+    }
+    return copyResult(entry.result), true
+}
+
+// Put stores a copy of result for ttl, replacing any earlier result of validator for project and fingerprint
+func (c *ResultCache) Put(validator, project, fingerprint string, result *Result, ttl time.Duration) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    c.entries[resultCacheKey{validator: validator, project: project, fingerprint: fingerprint}] = resultCacheEntry{
+        result:  copyResult(result),
+        expires: c.clock.Now().Add(ttl),
+    }
+}
+
+// copyResult copies a result and its top-level Details so cached and served results stay independent
+func copyResult(r *Result) *Result {
+    clone := *r
+    if r.Details != nil {
+        clone.Details = make(map[string]interface{}, len(r.Details))
+        for k, v := range r.Details {
+            clone.Details[k] = v
+        }
+    }
+    return &clone
+}
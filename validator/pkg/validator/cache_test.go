@@ -0,0 +1,70 @@
+package validator_test
+
+import (
+    "time"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/validator"
+)
+
+var _ = Describe("ResultCache", func() {
+    var (
+        cache *validator.ResultCache
+        clock *stepClock
+    )
+
+    BeforeEach(func() {
+        clock = &stepClock{now: time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC), step: time.Minute}
+        cache = validator.NewResultCache()
+        cache.SetClock(clock)
+    })
+
+    It("should miss unknown entries", func() {
+        _, ok := cache.Get("org-hierarchy-check", "test-project", "")
+        Expect(ok).To(BeFalse())
+    })
+
+    It("should key entries by validator and project", func() {
+        cache.Put("org-hierarchy-check", "test-project", "", &validator.Result{Reason: "ParentMatches"}, time.Hour)
+
+        result, ok := cache.Get("org-hierarchy-check", "test-project", "")
+        Expect(ok).To(BeTrue())
+        Expect(result.Reason).To(Equal("ParentMatches"))
+
+        _, ok = cache.Get("org-hierarchy-check", "other-project", "")
+        Expect(ok).To(BeFalse())
+        _, ok = cache.Get("project-state", "test-project", "")
+        Expect(ok).To(BeFalse())
+    })
+
+    It("should key entries by the settings fingerprint", func() {
+        cache.Put("org-hierarchy-check", "test-project", "folders/1", &validator.Result{Reason: "ParentMatches"}, time.Hour)
+
+        _, ok := cache.Get("org-hierarchy-check", "test-project", "folders/1")
+        Expect(ok).To(BeTrue())
+        _, ok = cache.Get("org-hierarchy-check", "test-project", "folders/2")
+        Expect(ok).To(BeFalse())
+    })
+
+    It("should expire entries after their TTL", func() {
+        // Put reads the clock at 10:30; the Gets read it at 10:31 and 10:32
+        cache.Put("org-hierarchy-check", "test-project", "", &validator.Result{}, 2*time.Minute)
+
+        _, ok := cache.Get("org-hierarchy-check", "test-project", "")
+        Expect(ok).To(BeTrue())
+        _, ok = cache.Get("org-hierarchy-check", "test-project", "")
+        Expect(ok).To(BeFalse())
+    })
+
+    It("should hand out copies that do not change the cached result", func() {
+        cache.Put("org-hierarchy-check", "test-project", "", &validator.Result{Details: map[string]interface{}{"parent": "folders/1"}}, time.Hour)
+
+        result, _ := cache.Get("org-hierarchy-check", "test-project", "")
+        result.Details["parent"] = "folders/2"
+
+        result, _ = cache.Get("org-hierarchy-check", "test-project", "")
+        Expect(result.Details).To(HaveKeyWithValue("parent", "folders/1"))
+    })
+})
@@ -0,0 +1,42 @@
+package validator
+
+import (
+    "context"
+    "errors"
+)
+
+// Cancellation causes recorded on the root context (see context.WithCancelCause)
+// They let results distinguish "operator stopped the job" from "validation ran out of time"
+var (
+    ErrCancelledBySignal = errors.New("validation cancelled by signal")
+    ErrValidationTimeout = errors.New("validation timed out")
+    // ErrLevelStopped is the cause of a level context cancelled under STOP_LEVEL_ON_FAILURE
+    ErrLevelStopped = errors.New("level stopped after a validator failed")
+)
+
+// Result reasons for validators interrupted by root context cancellation
+const (
+    ReasonCancelledBySignal = "CancelledBySignal"
+    ReasonTimeout           = "Timeout"
+    ReasonLevelStopped      = "StoppedByLevelFailure" // Aborted because another validator in its level failed
+    ReasonCancelled         = "Cancelled"             // Cancelled without a recognised cause
+)
+
+// CancellationReason returns the result reason for a done context, or "" if ctx is still live
+// Plain deadlines without a recorded cause are reported as timeouts
+func CancellationReason(ctx context.Context) string {
+    if ctx.Err() == nil {
+        return ""
+    }
+    cause := context.Cause(ctx)
+    switch {
+    case errors.Is(cause, ErrCancelledBySignal):
+        return ReasonCancelledBySignal
+    case errors.Is(cause, ErrValidationTimeout), errors.Is(cause, context.DeadlineExceeded):
+        return ReasonTimeout
+    case errors.Is(cause, ErrLevelStopped):
+        return ReasonLevelStopped
+    default:
+        return ReasonCancelled
+    }
+}
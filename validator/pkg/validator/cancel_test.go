@@ -0,0 +1,43 @@
+package validator_test
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/validator"
+)
+
+var _ = Describe("CancellationReason", func() {
+    It("should be empty while the context is live", func() {
+        Expect(validator.CancellationReason(context.Background())).To(BeEmpty())
+    })
+
+    It("should map causes to reasons", func() {
+        sigCtx, cancelSig := context.WithCancelCause(context.Background())
+        cancelSig(validator.ErrCancelledBySignal)
+        Expect(validator.CancellationReason(sigCtx)).To(Equal(validator.ReasonCancelledBySignal))
+
+        timeoutCtx, cancelTimeout := context.WithCancelCause(context.Background())
+        cancelTimeout(validator.ErrValidationTimeout)
+        Expect(validator.CancellationReason(timeoutCtx)).To(Equal(validator.ReasonTimeout))
+
+        levelCtx, cancelLevel := context.WithCancelCause(context.Background())
+        cancelLevel(fmt.Errorf("%w: quota-check", validator.ErrLevelStopped))
+        Expect(validator.CancellationReason(levelCtx)).To(Equal(validator.ReasonLevelStopped))
+
+        otherCtx, cancelOther := context.WithCancelCause(context.Background())
+        cancelOther(errors.New("something else"))
+        Expect(validator.CancellationReason(otherCtx)).To(Equal(validator.ReasonCancelled))
+    })
+
+    It("should treat a plain deadline as a timeout", func() {
+        ctx, cancel := context.WithTimeout(context.Background(), 0)
+        defer cancel()
+        <-ctx.Done()
+        Expect(validator.CancellationReason(ctx)).To(Equal(validator.ReasonTimeout))
+    })
+})
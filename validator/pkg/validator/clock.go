@@ -0,0 +1,17 @@
+package validator
+
+import "time"
+
+// Clock tells the time for result timestamps and durations
+// Tests inject a fixed or stepping clock to assert exact values without sleeping
+type Clock interface {
+    Now() time.Time
+}
+
+// realClock is the default Clock backed by time.Now
+type realClock struct{}
+
+// Now returns the current time
+func (realClock) Now() time.Time {
+    return time.Now()
+}
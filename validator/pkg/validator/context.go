@@ -4,30 +4,68 @@ import (
     "context"
     "fmt"
     "log/slog"
+    "math"
+    "sort"
     "sync"
+    "time"
 
+    "google.golang.org/api/cloudkms/v1"
     "google.golang.org/api/cloudresourcemanager/v1"
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
     "google.golang.org/api/compute/v1"
+    "google.golang.org/api/dns/v1"
+    file "google.golang.org/api/file/v1"
     "google.golang.org/api/iam/v1"
+    iamv2 "google.golang.org/api/iam/v2"
+    "google.golang.org/api/iamcredentials/v1"
+    logging "google.golang.org/api/logging/v2"
     "google.golang.org/api/monitoring/v3"
+    "google.golang.org/api/servicenetworking/v1"
     "google.golang.org/api/serviceusage/v1"
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+    "google.golang.org/api/storage/v1"
 
     "validator/pkg/config"
     "validator/pkg/gcp"
 )
 
+// ClientFactoryInterface creates GCP service clients for the Context
+// Implemented by gcp.ClientFactory; tests can substitute a double to avoid Google's auth path
+type ClientFactoryInterface interface {
+    CreateComputeService(ctx context.Context) (*compute.Service, error)
+    CreateIAMService(ctx context.Context) (*iam.Service, error)
+    CreateCloudResourceManagerService(ctx context.Context) (*cloudresourcemanager.Service, error)
+    CreateServiceUsageService(ctx context.Context) (*serviceusage.Service, error)
+    CreateServiceUsageBetaService(ctx context.Context) (*serviceusagebeta.APIService, error)
+    CreateMonitoringService(ctx context.Context) (*monitoring.Service, error)
+    CreateStorageService(ctx context.Context) (*storage.Service, error)
+    CreateFilestoreService(ctx context.Context) (*file.Service, error)
+    CreateKMSService(ctx context.Context) (*cloudkms.Service, error)
+    CreateTagsService(ctx context.Context) (*crmv3.Service, error)
+    CreateIAMV2Service(ctx context.Context) (*iamv2.Service, error)
+    CreateIAMCredentialsService(ctx context.Context) (*iamcredentials.Service, error)
+    CreateServiceNetworkingService(ctx context.Context) (*servicenetworking.APIService, error)
+    CreateDNSService(ctx context.Context) (*dns.Service, error)
+    CreateLoggingService(ctx context.Context) (*logging.Service, error)
+    DefaultCredentialProjects(ctx context.Context) (*gcp.CredentialProjects, error)
+}
+
+// Ensure the real factory satisfies the interface
+var _ ClientFactoryInterface = (*gcp.ClientFactory)(nil)
+
 // Context provides shared resources and configuration to all validators
 // Implements least-privilege principle through lazy initialization:
 // - Services are only created when first requested by validators
 // - OAuth scopes are only requested for services that are actually used
 // - Disabled validators never trigger authentication for their services
-// Thread-safe: Uses sync.Once to ensure services are initialized exactly once
+// Thread-safe: Uses sync.Once to ensure services are initialized exactly once; a failed creation
+// is not retried and its error is returned to every caller
 type Context struct {
     // Configuration
     Config *config.Config
 
     // Client factory for creating GCP service clients
-    clientFactory *gcp.ClientFactory
+    clientFactory ClientFactoryInterface
 
     // GCP Clients (lazily initialized, shared across validators)
     // These are private to enforce use of getter methods
@@ -35,46 +73,157 @@ type Context struct {
     iamService              *iam.Service
     cloudResourceManagerSvc *cloudresourcemanager.Service
     serviceUsageService     *serviceusage.Service
+    serviceUsageBetaService *serviceusagebeta.APIService
     monitoringService       *monitoring.Service
+    storageService          *storage.Service
+    filestoreService        *file.Service
+    kmsService              *cloudkms.Service
+    tagsService             *crmv3.Service
+    iamV2Service            *iamv2.Service
+    iamCredentialsService   *iamcredentials.Service
+    serviceNetworkingSvc    *servicenetworking.APIService
+    dnsService              *dns.Service
+    loggingService          *logging.Service
+    adcProjects             *gcp.CredentialProjects // Projects of the Application Default Credentials
 
     // Thread-safe lazy initialization guards
     // Each sync.Once ensures its corresponding service is created exactly once,
     // even when called concurrently from multiple validators
-    computeOnce          sync.Once
-    iamOnce              sync.Once
-    cloudResourceMgrOnce sync.Once
-    serviceUsageOnce     sync.Once
-    monitoringOnce       sync.Once
+    computeOnce           sync.Once
+    iamOnce               sync.Once
+    cloudResourceMgrOnce  sync.Once
+    serviceUsageOnce      sync.Once
+    serviceUsageBetaOnce  sync.Once
+    monitoringOnce        sync.Once
+    storageOnce           sync.Once
+    filestoreOnce         sync.Once
+    kmsOnce               sync.Once
+    tagsOnce              sync.Once
+    iamV2Once             sync.Once
+    iamCredentialsOnce    sync.Once
+    serviceNetworkingOnce sync.Once
+    dnsOnce               sync.Once
+    loggingOnce           sync.Once
+    credentialOnce        sync.Once
+
+    // Creation error of each service, set inside its sync.Once and returned on every later call
+    // so a failed creation never hands out a nil client
+    computeErr           error
+    iamErr               error
+    cloudResourceMgrErr  error
+    serviceUsageErr      error
+    serviceUsageBetaErr  error
+    monitoringErr        error
+    storageErr           error
+    filestoreErr         error
+    kmsErr               error
+    tagsErr              error
+    iamV2Err             error
+    iamCredentialsErr    error
+    serviceNetworkingErr error
+    dnsErr               error
+    loggingErr           error
+    credentialErr        error
+
+    // First auth error from any getter; once set, every getter fails fast with it
+    // Spans services, unlike the per-service sync.Once guards, and is shared with isolated contexts
+    auth *authBreaker
+
+    // Optional API overrides (set via SetXXXAPI, typically with fakes in unit tests)
+    // When nil, the getters wrap the lazily created real clients
+    serviceUsageAPI      gcp.ServiceUsageAPI
+    serviceQuotaAPI      gcp.ServiceQuotaAPI
+    computeAPI           gcp.ComputeAPI
+    resourceManagerAPI   gcp.ResourceManagerAPI
+    iamAPI               gcp.IAMAPI
+    storageAPI           gcp.StorageAPI
+    filestoreAPI         gcp.FilestoreAPI
+    kmsAPI               gcp.KMSAPI
+    tagsAPI              gcp.TagsAPI
+    iamDenyAPI           gcp.IAMDenyAPI
+    iamCredentialsAPI    gcp.IAMCredentialsAPI
+    serviceNetworkingAPI gcp.ServiceNetworkingAPI
+    dnsAPI               gcp.DNSAPI
+    loggingAPI           gcp.LoggingAPI
+    metadataAPI          gcp.MetadataAPI
+    credentialProjects   *gcp.CredentialProjects
+
+    // Optional cache of Cacheable validators' results, shared between runs (nil disables caching)
+    resultCache *ResultCache
 
     // Shared state between validators
-    ProjectNumber int64
+    ProjectNumber   int64
+    projectNumberMu sync.Mutex // Guards lazy resolution in GetProjectNumber
 
     // Results from previous validators (for dependency checking)
+    // Keyed lookup only: map iteration order is random, use OrderedResults for stable iteration
     Results map[string]*Result
+
+    // Execution level of each validator from the resolved plan (set by the Executor)
+    levels map[string]int
+
+    // Validators of the resolved plan declaring each API through APIRequirer (set by the Executor)
+    plannedAPIs map[string][]string
+
+    // Wall-clock duration of each validator, recorded centrally for observability features
+    timings   map[string]time.Duration
+    timingsMu sync.Mutex // Guards timings, recorded concurrently by parallel validators
+
+    // OAuth scopes of the service clients actually created, for least-privilege auditing
+    scopesUsed map[string]bool
+    scopesMu   sync.Mutex // Guards scopesUsed, recorded from concurrent getters
 }
 
 // NewContext creates a new validation context with a client factory
 func NewContext(cfg *config.Config, logger *slog.Logger) *Context {
+    return NewContextWithFactory(cfg, gcp.NewClientFactory(cfg.ProjectID, logger, gcp.WithServiceScopes(cfg.ServiceScopes)))
+}
+
+// NewContextWithFactory creates a new validation context using the given client factory
+// Services are still created lazily, on first request through the getters
+func NewContextWithFactory(cfg *config.Config, factory ClientFactoryInterface) *Context {
     return &Context{
         Config:        cfg,
-        clientFactory: gcp.NewClientFactory(cfg.ProjectID, logger),
+        clientFactory: factory,
         Results:       make(map[string]*Result),
+        timings:       make(map[string]time.Duration),
+        scopesUsed:    make(map[string]bool),
+        auth:          &authBreaker{},
     }
 }
 
+// authBreaker holds the first auth error seen by a Context and the isolated contexts derived from it
+type authBreaker struct {
+    mu  sync.Mutex // Guards err, tripped from concurrent getters
+    err error
+}
+
+// clientContext detaches client creation from the requesting validator's deadline and cancellation
+// The client is shared by every validator and its token source keeps this context for later refreshes,
+// so the first caller's timeout must not decide whether it works; the factory's retry budget bounds creation
+func clientContext(ctx context.Context) context.Context {
+    return context.WithoutCancel(ctx)
+}
+
 // GetComputeService returns the Compute Engine service, creating it lazily on first use
 // Only requests compute.readonly scope when a validator actually needs it
 // Thread-safe: Uses sync.Once to ensure the service is created exactly once
 func (c *Context) GetComputeService(ctx context.Context) (*compute.Service, error) {
-    var err error
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create compute service: %w", authErr)
+    }
     c.computeOnce.Do(func() {
-        c.computeService, err = c.clientFactory.CreateComputeService(ctx)
+        var err error
+        c.computeService, err = c.clientFactory.CreateComputeService(clientContext(ctx))
         if err != nil {
-            err = fmt.Errorf("failed to create compute service: %w", err)
+            c.tripAuthBreaker(err)
+            c.computeErr = fmt.Errorf("failed to create compute service: %w", err)
+            return
         }
+        c.recordScopes(c.serviceScopes("compute.googleapis.com", gcp.ComputeScope))
     })
-    if err != nil {
-        return nil, err
+    if c.computeErr != nil {
+        return nil, c.computeErr
     }
     return c.computeService, nil
 }
@@ -83,15 +232,21 @@ func (c *Context) GetComputeService(ctx context.Context) (*compute.Service, erro
 // Only requests cloud-platform.read-only scope when a validator actually needs it
 // Thread-safe: Uses sync.Once to ensure the service is created exactly once
 func (c *Context) GetIAMService(ctx context.Context) (*iam.Service, error) {
-    var err error
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create IAM service: %w", authErr)
+    }
     c.iamOnce.Do(func() {
-        c.iamService, err = c.clientFactory.CreateIAMService(ctx)
+        var err error
+        c.iamService, err = c.clientFactory.CreateIAMService(clientContext(ctx))
         if err != nil {
-            err = fmt.Errorf("failed to create IAM service: %w", err)
+            c.tripAuthBreaker(err)
+            c.iamErr = fmt.Errorf("failed to create IAM service: %w", err)
+            return
         }
+        c.recordScopes(c.serviceScopes("iam.googleapis.com", gcp.IAMScope))
     })
-    if err != nil {
-        return nil, err
+    if c.iamErr != nil {
+        return nil, c.iamErr
     }
     return c.iamService, nil
 }
@@ -100,15 +255,21 @@ func (c *Context) GetIAMService(ctx context.Context) (*iam.Service, error) {
 // Only requests cloudresourcemanager.readonly scope when a validator actually needs it
 // Thread-safe: Uses sync.Once to ensure the service is created exactly once
 func (c *Context) GetCloudResourceManagerService(ctx context.Context) (*cloudresourcemanager.Service, error) {
-    var err error
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create cloud resource manager service: %w", authErr)
+    }
     c.cloudResourceMgrOnce.Do(func() {
-        c.cloudResourceManagerSvc, err = c.clientFactory.CreateCloudResourceManagerService(ctx)
+        var err error
+        c.cloudResourceManagerSvc, err = c.clientFactory.CreateCloudResourceManagerService(clientContext(ctx))
         if err != nil {
-            err = fmt.Errorf("failed to create cloud resource manager service: %w", err)
+            c.tripAuthBreaker(err)
+            c.cloudResourceMgrErr = fmt.Errorf("failed to create cloud resource manager service: %w", err)
+            return
         }
+        c.recordScopes(c.serviceScopes("cloudresourcemanager.googleapis.com", gcp.ResourceManagerScope))
     })
-    if err != nil {
-        return nil, err
+    if c.cloudResourceMgrErr != nil {
+        return nil, c.cloudResourceMgrErr
     }
     return c.cloudResourceManagerSvc, nil
 }
@@ -117,32 +278,817 @@ func (c *Context) GetCloudResourceManagerService(ctx context.Context) (*cloudres
 // Only requests serviceusage.readonly scope when a validator actually needs it
 // Thread-safe: Uses sync.Once to ensure the service is created exactly once
 func (c *Context) GetServiceUsageService(ctx context.Context) (*serviceusage.Service, error) {
-    var err error
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create service usage service: %w", authErr)
+    }
     c.serviceUsageOnce.Do(func() {
-        c.serviceUsageService, err = c.clientFactory.CreateServiceUsageService(ctx)
+        var err error
+        c.serviceUsageService, err = c.clientFactory.CreateServiceUsageService(clientContext(ctx))
         if err != nil {
-            err = fmt.Errorf("failed to create service usage service: %w", err)
+            c.tripAuthBreaker(err)
+            c.serviceUsageErr = fmt.Errorf("failed to create service usage service: %w", err)
+            return
         }
+        c.recordScopes(c.serviceScopes("serviceusage.googleapis.com", gcp.ServiceUsageScope))
     })
-    if err != nil {
-        return nil, err
+    if c.serviceUsageErr != nil {
+        return nil, c.serviceUsageErr
     }
     return c.serviceUsageService, nil
 }
 
+// GetServiceUsageBetaService returns the Service Usage v1beta1 service, creating it lazily on first use
+// Only validators reading consumer quotas need it, so most runs never create it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetServiceUsageBetaService(ctx context.Context) (*serviceusagebeta.APIService, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create service usage v1beta1 service: %w", authErr)
+    }
+    c.serviceUsageBetaOnce.Do(func() {
+        var err error
+        c.serviceUsageBetaService, err = c.clientFactory.CreateServiceUsageBetaService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.serviceUsageBetaErr = fmt.Errorf("failed to create service usage v1beta1 service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("serviceusage.googleapis.com", gcp.ServiceUsageBetaScope))
+    })
+    if c.serviceUsageBetaErr != nil {
+        return nil, c.serviceUsageBetaErr
+    }
+    return c.serviceUsageBetaService, nil
+}
+
 // GetMonitoringService returns the Monitoring service, creating it lazily on first use
 // Only requests monitoring.read scope when a validator actually needs it
 // Thread-safe: Uses sync.Once to ensure the service is created exactly once
 func (c *Context) GetMonitoringService(ctx context.Context) (*monitoring.Service, error) {
-    var err error
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create monitoring service: %w", authErr)
+    }
     c.monitoringOnce.Do(func() {
-        c.monitoringService, err = c.clientFactory.CreateMonitoringService(ctx)
+        var err error
+        c.monitoringService, err = c.clientFactory.CreateMonitoringService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.monitoringErr = fmt.Errorf("failed to create monitoring service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("monitoring.googleapis.com", gcp.MonitoringScope))
+    })
+    if c.monitoringErr != nil {
+        return nil, c.monitoringErr
+    }
+    return c.monitoringService, nil
+}
+
+// GetStorageService returns the Cloud Storage service, creating it lazily on first use
+// Only requests devstorage.read_only scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetStorageService(ctx context.Context) (*storage.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create storage service: %w", authErr)
+    }
+    c.storageOnce.Do(func() {
+        var err error
+        c.storageService, err = c.clientFactory.CreateStorageService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.storageErr = fmt.Errorf("failed to create storage service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("storage.googleapis.com", gcp.StorageScope))
+    })
+    if c.storageErr != nil {
+        return nil, c.storageErr
+    }
+    return c.storageService, nil
+}
+
+// GetFilestoreService returns the Filestore service, creating it lazily on first use
+// Only requests cloud-platform.read-only scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetFilestoreService(ctx context.Context) (*file.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create filestore service: %w", authErr)
+    }
+    c.filestoreOnce.Do(func() {
+        var err error
+        c.filestoreService, err = c.clientFactory.CreateFilestoreService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.filestoreErr = fmt.Errorf("failed to create filestore service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("file.googleapis.com", gcp.FilestoreScope))
+    })
+    if c.filestoreErr != nil {
+        return nil, c.filestoreErr
+    }
+    return c.filestoreService, nil
+}
+
+// GetKMSService returns the Cloud KMS service, creating it lazily on first use
+// Only requests the cloudkms scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetKMSService(ctx context.Context) (*cloudkms.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create kms service: %w", authErr)
+    }
+    c.kmsOnce.Do(func() {
+        var err error
+        c.kmsService, err = c.clientFactory.CreateKMSService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.kmsErr = fmt.Errorf("failed to create kms service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("cloudkms.googleapis.com", gcp.KMSScope))
+    })
+    if c.kmsErr != nil {
+        return nil, c.kmsErr
+    }
+    return c.kmsService, nil
+}
+
+// GetTagsService returns the Resource Manager v3 service used for tags, creating it lazily on first use
+// Only requests the cloud-platform.read-only scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetTagsService(ctx context.Context) (*crmv3.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create tags service: %w", authErr)
+    }
+    c.tagsOnce.Do(func() {
+        var err error
+        c.tagsService, err = c.clientFactory.CreateTagsService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.tagsErr = fmt.Errorf("failed to create tags service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("cloudresourcemanager.googleapis.com", gcp.TagsScope))
+    })
+    if c.tagsErr != nil {
+        return nil, c.tagsErr
+    }
+    return c.tagsService, nil
+}
+
+// GetIAMV2Service returns the IAM v2 service used for deny policies, creating it lazily on first use
+// Only requests the cloud-platform.read-only scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetIAMV2Service(ctx context.Context) (*iamv2.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create IAM v2 service: %w", authErr)
+    }
+    c.iamV2Once.Do(func() {
+        var err error
+        c.iamV2Service, err = c.clientFactory.CreateIAMV2Service(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.iamV2Err = fmt.Errorf("failed to create IAM v2 service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("iam.googleapis.com", gcp.IAMDenyScope))
+    })
+    if c.iamV2Err != nil {
+        return nil, c.iamV2Err
+    }
+    return c.iamV2Service, nil
+}
+
+// GetIAMCredentialsService returns the IAM Credentials service, creating it lazily on first use
+// Requests the cloud-platform scope, IAM Credentials' only one, so only impersonation-check uses it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetIAMCredentialsService(ctx context.Context) (*iamcredentials.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create IAM Credentials service: %w", authErr)
+    }
+    c.iamCredentialsOnce.Do(func() {
+        var err error
+        c.iamCredentialsService, err = c.clientFactory.CreateIAMCredentialsService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.iamCredentialsErr = fmt.Errorf("failed to create IAM Credentials service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("iamcredentials.googleapis.com", gcp.IAMCredentialsScope))
+    })
+    if c.iamCredentialsErr != nil {
+        return nil, c.iamCredentialsErr
+    }
+    return c.iamCredentialsService, nil
+}
+
+// GetServiceNetworkingService returns the Service Networking service, creating it lazily on first use
+// Only requests the cloud-platform.read-only scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetServiceNetworkingService(ctx context.Context) (*servicenetworking.APIService, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create Service Networking service: %w", authErr)
+    }
+    c.serviceNetworkingOnce.Do(func() {
+        var err error
+        c.serviceNetworkingSvc, err = c.clientFactory.CreateServiceNetworkingService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.serviceNetworkingErr = fmt.Errorf("failed to create Service Networking service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("servicenetworking.googleapis.com", gcp.ServiceNetworkingScope))
+    })
+    if c.serviceNetworkingErr != nil {
+        return nil, c.serviceNetworkingErr
+    }
+    return c.serviceNetworkingSvc, nil
+}
+
+// GetLoggingService returns the Cloud Logging service, creating it lazily on first use
+// Only requests the logging.read scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetLoggingService(ctx context.Context) (*logging.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create Cloud Logging service: %w", authErr)
+    }
+    c.loggingOnce.Do(func() {
+        var err error
+        c.loggingService, err = c.clientFactory.CreateLoggingService(clientContext(ctx))
         if err != nil {
-            err = fmt.Errorf("failed to create monitoring service: %w", err)
+            c.tripAuthBreaker(err)
+            c.loggingErr = fmt.Errorf("failed to create Cloud Logging service: %w", err)
+            return
         }
+        c.recordScopes(c.serviceScopes("logging.googleapis.com", gcp.LoggingScope))
     })
+    if c.loggingErr != nil {
+        return nil, c.loggingErr
+    }
+    return c.loggingService, nil
+}
+
+// GetDNSService returns the Cloud DNS service, creating it lazily on first use
+// Only requests the ndev.clouddns.readonly scope when a validator actually needs it
+// Thread-safe: Uses sync.Once to ensure the service is created exactly once
+func (c *Context) GetDNSService(ctx context.Context) (*dns.Service, error) {
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to create Cloud DNS service: %w", authErr)
+    }
+    c.dnsOnce.Do(func() {
+        var err error
+        c.dnsService, err = c.clientFactory.CreateDNSService(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.dnsErr = fmt.Errorf("failed to create Cloud DNS service: %w", err)
+            return
+        }
+        c.recordScopes(c.serviceScopes("dns.googleapis.com", gcp.DNSScope))
+    })
+    if c.dnsErr != nil {
+        return nil, c.dnsErr
+    }
+    return c.dnsService, nil
+}
+
+// GetServiceUsageAPI returns the Service Usage API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetServiceUsageAPI(ctx context.Context) (gcp.ServiceUsageAPI, error) {
+    if c.serviceUsageAPI != nil {
+        return c.serviceUsageAPI, nil
+    }
+    svc, err := c.GetServiceUsageService(ctx)
     if err != nil {
         return nil, err
     }
-    return c.monitoringService, nil
+    return gcp.NewServiceUsageAPI(svc), nil
+}
+
+// SetServiceUsageAPI overrides the Service Usage API returned by GetServiceUsageAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetServiceUsageAPI(api gcp.ServiceUsageAPI) {
+    c.serviceUsageAPI = api
+}
+
+// GetServiceQuotaAPI returns the consumer quota API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetServiceQuotaAPI(ctx context.Context) (gcp.ServiceQuotaAPI, error) {
+    if c.serviceQuotaAPI != nil {
+        return c.serviceQuotaAPI, nil
+    }
+    svc, err := c.GetServiceUsageBetaService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewServiceQuotaAPI(svc), nil
+}
+
+// SetServiceQuotaAPI overrides the consumer quota API returned by GetServiceQuotaAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetServiceQuotaAPI(api gcp.ServiceQuotaAPI) {
+    c.serviceQuotaAPI = api
+}
+
+// GetComputeAPI returns the Compute Engine API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetComputeAPI(ctx context.Context) (gcp.ComputeAPI, error) {
+    if c.computeAPI != nil {
+        return c.computeAPI, nil
+    }
+    svc, err := c.GetComputeService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewComputeAPI(svc), nil
+}
+
+// SetComputeAPI overrides the Compute Engine API returned by GetComputeAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetComputeAPI(api gcp.ComputeAPI) {
+    c.computeAPI = api
+}
+
+// GetResourceManagerAPI returns the Cloud Resource Manager API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetResourceManagerAPI(ctx context.Context) (gcp.ResourceManagerAPI, error) {
+    if c.resourceManagerAPI != nil {
+        return c.resourceManagerAPI, nil
+    }
+    svc, err := c.GetCloudResourceManagerService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewResourceManagerAPI(svc), nil
+}
+
+// SetResourceManagerAPI overrides the Cloud Resource Manager API returned by GetResourceManagerAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetResourceManagerAPI(api gcp.ResourceManagerAPI) {
+    c.resourceManagerAPI = api
+}
+
+// GetIAMAPI returns the IAM API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetIAMAPI(ctx context.Context) (gcp.IAMAPI, error) {
+    if c.iamAPI != nil {
+        return c.iamAPI, nil
+    }
+    svc, err := c.GetIAMService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewIAMAPI(svc), nil
+}
+
+// SetIAMAPI overrides the IAM API returned by GetIAMAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetIAMAPI(api gcp.IAMAPI) {
+    c.iamAPI = api
+}
+
+// GetStorageAPI returns the Cloud Storage API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetStorageAPI(ctx context.Context) (gcp.StorageAPI, error) {
+    if c.storageAPI != nil {
+        return c.storageAPI, nil
+    }
+    svc, err := c.GetStorageService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewStorageAPI(svc), nil
+}
+
+// SetStorageAPI overrides the Cloud Storage API returned by GetStorageAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetStorageAPI(api gcp.StorageAPI) {
+    c.storageAPI = api
+}
+
+// GetFilestoreAPI returns the Filestore API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetFilestoreAPI(ctx context.Context) (gcp.FilestoreAPI, error) {
+    if c.filestoreAPI != nil {
+        return c.filestoreAPI, nil
+    }
+    svc, err := c.GetFilestoreService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewFilestoreAPI(svc), nil
+}
+
+// SetFilestoreAPI overrides the Filestore API returned by GetFilestoreAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetFilestoreAPI(api gcp.FilestoreAPI) {
+    c.filestoreAPI = api
+}
+
+// GetKMSAPI returns the Cloud KMS API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetKMSAPI(ctx context.Context) (gcp.KMSAPI, error) {
+    if c.kmsAPI != nil {
+        return c.kmsAPI, nil
+    }
+    svc, err := c.GetKMSService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewKMSAPI(svc), nil
+}
+
+// SetKMSAPI overrides the Cloud KMS API returned by GetKMSAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetKMSAPI(api gcp.KMSAPI) {
+    c.kmsAPI = api
+}
+
+// SetResultCache shares a result cache with this Context; pass the same cache to the Context of each
+// run so Cacheable validators are served from it instead of calling GCP again
+func (c *Context) SetResultCache(cache *ResultCache) {
+    c.resultCache = cache
+}
+
+// ResultCache returns the result cache, or nil when caching is disabled
+func (c *Context) ResultCache() *ResultCache {
+    return c.resultCache
+}
+
+// GetTagsAPI returns the Tags API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetTagsAPI(ctx context.Context) (gcp.TagsAPI, error) {
+    if c.tagsAPI != nil {
+        return c.tagsAPI, nil
+    }
+    svc, err := c.GetTagsService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewTagsAPI(svc), nil
+}
+
+// SetTagsAPI overrides the Tags API returned by GetTagsAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetTagsAPI(api gcp.TagsAPI) {
+    c.tagsAPI = api
+}
+
+// GetIAMDenyAPI returns the IAM deny policy API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetIAMDenyAPI(ctx context.Context) (gcp.IAMDenyAPI, error) {
+    if c.iamDenyAPI != nil {
+        return c.iamDenyAPI, nil
+    }
+    svc, err := c.GetIAMV2Service(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewIAMDenyAPI(svc), nil
+}
+
+// SetIAMDenyAPI overrides the IAM deny policy API returned by GetIAMDenyAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetIAMDenyAPI(api gcp.IAMDenyAPI) {
+    c.iamDenyAPI = api
+}
+
+// GetServiceNetworkingAPI returns the Service Networking API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetServiceNetworkingAPI(ctx context.Context) (gcp.ServiceNetworkingAPI, error) {
+    if c.serviceNetworkingAPI != nil {
+        return c.serviceNetworkingAPI, nil
+    }
+    svc, err := c.GetServiceNetworkingService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewServiceNetworkingAPI(svc), nil
+}
+
+// SetServiceNetworkingAPI overrides the Service Networking API returned by GetServiceNetworkingAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetServiceNetworkingAPI(api gcp.ServiceNetworkingAPI) {
+    c.serviceNetworkingAPI = api
+}
+
+// GetLoggingAPI returns the Cloud Logging API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetLoggingAPI(ctx context.Context) (gcp.LoggingAPI, error) {
+    if c.loggingAPI != nil {
+        return c.loggingAPI, nil
+    }
+    svc, err := c.GetLoggingService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewLoggingAPI(svc), nil
+}
+
+// SetLoggingAPI overrides the Cloud Logging API returned by GetLoggingAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetLoggingAPI(api gcp.LoggingAPI) {
+    c.loggingAPI = api
+}
+
+// GetDNSAPI returns the Cloud DNS API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetDNSAPI(ctx context.Context) (gcp.DNSAPI, error) {
+    if c.dnsAPI != nil {
+        return c.dnsAPI, nil
+    }
+    svc, err := c.GetDNSService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewDNSAPI(svc), nil
+}
+
+// SetDNSAPI overrides the Cloud DNS API returned by GetDNSAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetDNSAPI(api gcp.DNSAPI) {
+    c.dnsAPI = api
+}
+
+// GetMetadataAPI returns the Compute Engine metadata server API used by validators
+// Returns the injected implementation if set, otherwise a client of the real metadata server
+func (c *Context) GetMetadataAPI() gcp.MetadataAPI {
+    if c.metadataAPI != nil {
+        return c.metadataAPI
+    }
+    return gcp.NewMetadataAPI()
+}
+
+// SetMetadataAPI overrides the metadata server API returned by GetMetadataAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetMetadataAPI(api gcp.MetadataAPI) {
+    c.metadataAPI = api
+}
+
+// GetIAMCredentialsAPI returns the IAM Credentials API used by validators
+// Returns the injected implementation if set, otherwise wraps the lazily created real client
+func (c *Context) GetIAMCredentialsAPI(ctx context.Context) (gcp.IAMCredentialsAPI, error) {
+    if c.iamCredentialsAPI != nil {
+        return c.iamCredentialsAPI, nil
+    }
+    svc, err := c.GetIAMCredentialsService(ctx)
+    if err != nil {
+        return nil, err
+    }
+    return gcp.NewIAMCredentialsAPI(svc), nil
+}
+
+// SetIAMCredentialsAPI overrides the IAM Credentials API returned by GetIAMCredentialsAPI
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetIAMCredentialsAPI(api gcp.IAMCredentialsAPI) {
+    c.iamCredentialsAPI = api
+}
+
+// GetCredentialProjects returns the projects tied to the Application Default Credentials, read on first use
+// Needs no OAuth scope; a failure trips the auth breaker like the service getters
+func (c *Context) GetCredentialProjects(ctx context.Context) (*gcp.CredentialProjects, error) {
+    if c.credentialProjects != nil {
+        return c.credentialProjects, nil
+    }
+    if authErr := c.authBreakerErr(); authErr != nil {
+        return nil, fmt.Errorf("failed to read credential projects: %w", authErr)
+    }
+    c.credentialOnce.Do(func() {
+        var err error
+        c.adcProjects, err = c.clientFactory.DefaultCredentialProjects(clientContext(ctx))
+        if err != nil {
+            c.tripAuthBreaker(err)
+            c.credentialErr = fmt.Errorf("failed to read credential projects: %w", err)
+        }
+    })
+    if c.credentialErr != nil {
+        return nil, c.credentialErr
+    }
+    return c.adcProjects, nil
+}
+
+// SetCredentialProjects overrides the projects returned by GetCredentialProjects
+// Must be called before validators run; intended for injecting fakes in tests
+func (c *Context) SetCredentialProjects(projects *gcp.CredentialProjects) {
+    c.credentialProjects = projects
+}
+
+// GetProjectNumber returns the numeric project number, resolving it via Cloud Resource Manager on first use
+// The result is cached in ProjectNumber so validators share a single lookup
+// Thread-safe: concurrent callers wait for the first lookup; failed lookups are retried on the next call
+func (c *Context) GetProjectNumber(ctx context.Context) (int64, error) {
+    c.projectNumberMu.Lock()
+    defer c.projectNumberMu.Unlock()
+
+    if c.ProjectNumber != 0 {
+        return c.ProjectNumber, nil
+    }
+
+    crm, err := c.GetResourceManagerAPI(ctx)
+    if err != nil {
+        return 0, err
+    }
+    project, err := crm.GetProject(ctx, c.Config.ProjectID)
+    if err != nil {
+        return 0, fmt.Errorf("failed to get project %s: %w", c.Config.ProjectID, err)
+    }
+    c.ProjectNumber = project.ProjectNumber
+    return c.ProjectNumber, nil
+}
+
+// SetProjectNumber records a project number already fetched by a validator so GetProjectNumber skips its lookup
+// Zero is ignored; thread-safe with GetProjectNumber
+func (c *Context) SetProjectNumber(n int64) {
+    if n == 0 {
+        return
+    }
+    c.projectNumberMu.Lock()
+    defer c.projectNumberMu.Unlock()
+    c.ProjectNumber = n
+}
+
+// HasConfig reports whether the setting named by its env var (e.g., "EXPECTED_PARENT") is configured
+// Validators use it in Enabled to drop out of the plan when their required configuration is absent
+func (c *Context) HasConfig(key string) bool {
+    return c.Config.IsSet(key)
+}
+
+// HasValidatorConfig reports whether key is configured for validatorName, either generically (e.g., "VPC_NAME")
+// or scoped to the validator (VALIDATOR_<NAME>_VPC_NAME); pair it with Config.ValidatorString
+func (c *Context) HasValidatorConfig(validatorName, key string) bool {
+    return c.HasConfig(key) || c.HasConfig(config.ValidatorEnvKey(validatorName, key))
+}
+
+// RecordTiming records how long a validator took to run
+// Thread-safe: validators in the same level record concurrently
+func (c *Context) RecordTiming(name string, d time.Duration) {
+    c.timingsMu.Lock()
+    defer c.timingsMu.Unlock()
+    c.timings[name] = d
+}
+
+// Timings returns a snapshot of the recorded validator durations keyed by validator name
+func (c *Context) Timings() map[string]time.Duration {
+    c.timingsMu.Lock()
+    defer c.timingsMu.Unlock()
+    timings := make(map[string]time.Duration, len(c.timings))
+    for name, d := range c.timings {
+        timings[name] = d
+    }
+    return timings
+}
+
+// tripAuthBreaker remembers the first auth error so later getters skip client creation
+// Only clear auth failures trip it; transient errors like a 503 leave it untouched
+func (c *Context) tripAuthBreaker(err error) {
+    if !gcp.IsAuthError(err) {
+        return
+    }
+    c.auth.mu.Lock()
+    defer c.auth.mu.Unlock()
+    if c.auth.err == nil {
+        c.auth.err = err
+    }
+}
+
+// authBreakerErr returns the auth error that tripped the breaker, or nil
+func (c *Context) authBreakerErr() error {
+    c.auth.mu.Lock()
+    defer c.auth.mu.Unlock()
+    return c.auth.err
+}
+
+// serviceScopes returns the scopes the client factory requests for api, SERVICE_SCOPES included
+func (c *Context) serviceScopes(api, defaultScope string) []string {
+    return gcp.ServiceScopes(c.Config.ServiceScopes, api, defaultScope)
+}
+
+// recordScopes notes that a service client requesting scopes was created
+func (c *Context) recordScopes(scopes []string) {
+    c.scopesMu.Lock()
+    defer c.scopesMu.Unlock()
+    for _, scope := range scopes {
+        c.scopesUsed[scope] = true
+    }
+}
+
+// ScopesUsed returns the sorted OAuth scopes requested by service clients created so far
+// Injected API overrides create no clients and therefore record no scopes
+func (c *Context) ScopesUsed() []string {
+    c.scopesMu.Lock()
+    defer c.scopesMu.Unlock()
+    scopes := make([]string, 0, len(c.scopesUsed))
+    for scope := range c.scopesUsed {
+        scopes = append(scopes, scope)
+    }
+    sort.Strings(scopes)
+    return scopes
+}
+
+// setExecutionPlan records the execution level of each validator so results can be ordered,
+// and the APIs the planned validators declare
+func (c *Context) setExecutionPlan(groups []ExecutionGroup) {
+    c.levels = make(map[string]int)
+    c.plannedAPIs = make(map[string][]string)
+    for _, group := range groups {
+        for _, v := range group.Validators {
+            name := v.Metadata().Name
+            c.levels[name] = group.Level
+            if r, ok := v.(APIRequirer); ok {
+                for _, api := range r.RequiredAPIs() {
+                    c.plannedAPIs[api] = append(c.plannedAPIs[api], name)
+                }
+            }
+        }
+    }
+}
+
+// isolated returns a Context for running a single validator under ISOLATE_CONTEXTS
+// It shares the configuration, client factory, injected APIs, result cache, resolved plan and auth
+// breaker, but creates its own service clients and starts from a copy of results
+func (c *Context) isolated(results map[string]*Result) *Context {
+    iso := NewContextWithFactory(c.Config, c.clientFactory)
+    iso.Results = results
+    iso.levels = c.levels
+    iso.plannedAPIs = c.plannedAPIs
+    iso.resultCache = c.resultCache
+    iso.auth = c.auth
+
+    iso.serviceUsageAPI = c.serviceUsageAPI
+    iso.serviceQuotaAPI = c.serviceQuotaAPI
+    iso.computeAPI = c.computeAPI
+    iso.resourceManagerAPI = c.resourceManagerAPI
+    iso.iamAPI = c.iamAPI
+    iso.storageAPI = c.storageAPI
+    iso.filestoreAPI = c.filestoreAPI
+    iso.kmsAPI = c.kmsAPI
+    iso.tagsAPI = c.tagsAPI
+    iso.iamDenyAPI = c.iamDenyAPI
+    iso.iamCredentialsAPI = c.iamCredentialsAPI
+    iso.serviceNetworkingAPI = c.serviceNetworkingAPI
+    iso.dnsAPI = c.dnsAPI
+    iso.loggingAPI = c.loggingAPI
+    iso.metadataAPI = c.metadataAPI
+    iso.credentialProjects = c.credentialProjects
+
+    c.projectNumberMu.Lock()
+    iso.ProjectNumber = c.ProjectNumber
+    c.projectNumberMu.Unlock()
+    return iso
+}
+
+// mergeIsolated folds what a finished isolated Context learned back into c: the results it added
+// to its starting snapshot, the scopes of the clients it created and the project number
+// A result already in c is never replaced; callers must hold the lock guarding c.Results
+func (c *Context) mergeIsolated(iso *Context, snapshot map[string]*Result) {
+    for name, r := range iso.Results {
+        if _, exists := c.Results[name]; exists || snapshot[name] == r {
+            continue
+        }
+        c.Results[name] = r
+    }
+    c.recordScopes(iso.ScopesUsed())
+    c.SetProjectNumber(iso.ProjectNumber)
+}
+
+// PlannedAPIs returns the APIs declared by the validators of the resolved plan, each mapped to
+// the sorted names of the validators needing it; empty before the Executor has resolved the plan
+func (c *Context) PlannedAPIs() map[string][]string {
+    apis := make(map[string][]string, len(c.plannedAPIs))
+    for api, names := range c.plannedAPIs {
+        sorted := append([]string(nil), names...)
+        sort.Strings(sorted)
+        apis[api] = sorted
+    }
+    return apis
+}
+
+// SetPlannedAPIs replaces the APIs declared by the planned validators, keyed by API (for testing)
+func (c *Context) SetPlannedAPIs(apis map[string][]string) {
+    c.plannedAPIs = apis
+}
+
+// OrderedResults returns the results sorted by execution level, then validator name
+// Results for validators outside the resolved plan (e.g., added manually) sort last, by name
+func (c *Context) OrderedResults() []*Result {
+    results := make([]*Result, 0, len(c.Results))
+    names := make(map[*Result]string, len(c.Results))
+    for name, r := range c.Results {
+        results = append(results, r)
+        names[r] = name
+    }
+
+    level := func(name string) int {
+        if l, ok := c.levels[name]; ok {
+            return l
+        }
+        return math.MaxInt
+    }
+
+    sort.SliceStable(results, func(i, j int) bool {
+        li, lj := level(names[results[i]]), level(names[results[j]])
+        if li != lj {
+            return li < lj
+        }
+        return names[results[i]] < names[results[j]]
+    })
+    return results
 }
@@ -2,14 +2,35 @@ package validator_test
 
 import (
     "context"
+    "errors"
+    "fmt"
     "log/slog"
     "os"
     "sync"
+    "sync/atomic"
+    "time"
 
     . "github.com/onsi/ginkgo/v2"
     . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudkms/v1"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/dns/v1"
+    file "google.golang.org/api/file/v1"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/iam/v1"
+    iamv2 "google.golang.org/api/iam/v2"
+    "google.golang.org/api/iamcredentials/v1"
+    logging "google.golang.org/api/logging/v2"
+    "google.golang.org/api/monitoring/v3"
+    "google.golang.org/api/servicenetworking/v1"
+    "google.golang.org/api/serviceusage/v1"
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+    "google.golang.org/api/storage/v1"
 
     "validator/pkg/config"
+    "validator/pkg/gcp"
     "validator/pkg/validator"
 )
 
@@ -149,6 +170,81 @@ var _ = Describe("Context", func() {
             })
         })
 
+        Context("GetStorageService", func() {
+            It("should handle missing credentials gracefully", func() {
+                ctx := context.Background()
+
+                svc, err := vctx.GetStorageService(ctx)
+
+                if err != nil {
+                    Expect(err).To(HaveOccurred())
+                    Expect(err.Error()).To(ContainSubstring("failed to create storage service"))
+                } else {
+                    Expect(svc).NotTo(BeNil())
+                }
+            })
+        })
+
+        Context("GetFilestoreService", func() {
+            It("should handle missing credentials gracefully", func() {
+                ctx := context.Background()
+
+                svc, err := vctx.GetFilestoreService(ctx)
+
+                if err != nil {
+                    Expect(err).To(HaveOccurred())
+                    Expect(err.Error()).To(ContainSubstring("failed to create filestore service"))
+                } else {
+                    Expect(svc).NotTo(BeNil())
+                }
+            })
+        })
+
+        Context("GetKMSService", func() {
+            It("should handle missing credentials gracefully", func() {
+                ctx := context.Background()
+
+                svc, err := vctx.GetKMSService(ctx)
+
+                if err != nil {
+                    Expect(err).To(HaveOccurred())
+                    Expect(err.Error()).To(ContainSubstring("failed to create kms service"))
+                } else {
+                    Expect(svc).NotTo(BeNil())
+                }
+            })
+        })
+
+        Context("GetTagsService", func() {
+            It("should handle missing credentials gracefully", func() {
+                ctx := context.Background()
+
+                svc, err := vctx.GetTagsService(ctx)
+
+                if err != nil {
+                    Expect(err).To(HaveOccurred())
+                    Expect(err.Error()).To(ContainSubstring("failed to create tags service"))
+                } else {
+                    Expect(svc).NotTo(BeNil())
+                }
+            })
+        })
+
+        Context("GetIAMV2Service", func() {
+            It("should handle missing credentials gracefully", func() {
+                ctx := context.Background()
+
+                svc, err := vctx.GetIAMV2Service(ctx)
+
+                if err != nil {
+                    Expect(err).To(HaveOccurred())
+                    Expect(err.Error()).To(ContainSubstring("failed to create IAM v2 service"))
+                } else {
+                    Expect(svc).NotTo(BeNil())
+                }
+            })
+        })
+
         Context("GetMonitoringService", func() {
             It("should handle missing credentials gracefully", func() {
                 ctx := context.Background()
@@ -165,11 +261,259 @@ var _ = Describe("Context", func() {
         })
     })
 
-    Describe("Context Cancellation", func() {
+    Describe("NewContextWithFactory", func() {
+        var factory *fakeClientFactory
+
+        BeforeEach(func() {
+            factory = &fakeClientFactory{}
+            vctx = validator.NewContextWithFactory(cfg, factory)
+        })
+
+        It("should not create any service at construction", func() {
+            Expect(vctx.Config).To(Equal(cfg))
+            Expect(vctx.Results).To(BeEmpty())
+            Expect(factory.calls.Load()).To(BeZero())
+        })
+
+        It("should create the service once and reuse it", func() {
+            ctx := context.Background()
+
+            svc1, err := vctx.GetComputeService(ctx)
+            Expect(err).NotTo(HaveOccurred())
+            svc2, err := vctx.GetComputeService(ctx)
+            Expect(err).NotTo(HaveOccurred())
+
+            Expect(svc1).To(BeIdenticalTo(svc2))
+            Expect(factory.calls.Load()).To(Equal(int32(1)))
+        })
+
+        It("should create the service once under concurrent access", func() {
+            ctx := context.Background()
+            var wg sync.WaitGroup
+            for i := 0; i < 20; i++ {
+                wg.Add(1)
+                go func() {
+                    defer GinkgoRecover()
+                    defer wg.Done()
+                    _, _ = vctx.GetServiceUsageService(ctx)
+                }()
+            }
+            wg.Wait()
+
+            Expect(factory.calls.Load()).To(Equal(int32(1)))
+        })
+
+        It("should record the scopes of created services only", func() {
+            Expect(vctx.ScopesUsed()).To(BeEmpty())
+
+            _, err := vctx.GetComputeService(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            _, err = vctx.GetComputeService(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            _, err = vctx.GetServiceUsageService(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+
+            Expect(vctx.ScopesUsed()).To(Equal([]string{gcp.ServiceUsageScope, gcp.ComputeScope}))
+        })
+
+        It("should record the SERVICE_SCOPES override instead of the default", func() {
+            vctx.Config.ServiceScopes = map[string][]string{
+                "compute.googleapis.com": {"https://www.googleapis.com/auth/cloud-platform"},
+            }
+
+            _, err := vctx.GetComputeService(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+
+            Expect(vctx.ScopesUsed()).To(Equal([]string{"https://www.googleapis.com/auth/cloud-platform"}))
+        })
+
+        It("should not record scopes for failed or injected services", func() {
+            factory.err = errors.New("boom")
+            _, _ = vctx.GetIAMService(context.Background())
+
+            vctx.SetComputeAPI(&stubCompute{})
+            _, err := vctx.GetComputeAPI(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+
+            Expect(vctx.ScopesUsed()).To(BeEmpty())
+        })
+
+        It("should wrap factory errors", func() {
+            factory.err = errors.New("boom")
+
+            _, err := vctx.GetIAMService(context.Background())
+            Expect(err).To(HaveOccurred())
+            Expect(err.Error()).To(ContainSubstring("failed to create IAM service"))
+            Expect(err.Error()).To(ContainSubstring("boom"))
+        })
+
+        It("should short-circuit every getter after an auth error", func() {
+            factory.err = fmt.Errorf("failed to create default client: %w", gcp.ErrCredentials)
+
+            _, err := vctx.GetComputeService(context.Background())
+            Expect(err).To(HaveOccurred())
+            Expect(factory.calls.Load()).To(Equal(int32(1)))
+
+            factory.err = nil
+            _, err = vctx.GetIAMService(context.Background())
+            Expect(errors.Is(err, gcp.ErrCredentials)).To(BeTrue())
+            Expect(err.Error()).To(ContainSubstring("failed to create IAM service"))
+            Expect(factory.calls.Load()).To(Equal(int32(1)), "Breaker should skip client creation")
+        })
+
+        It("should not trip the breaker on transient errors", func() {
+            factory.err = &googleapi.Error{Code: 503}
+
+            _, err := vctx.GetComputeService(context.Background())
+            Expect(err).To(HaveOccurred())
+
+            factory.err = nil
+            _, err = vctx.GetIAMService(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(factory.calls.Load()).To(Equal(int32(2)))
+        })
+
+        It("should return the creation error on every call instead of a nil client", func() {
+            factory.err = errors.New("transient: connection reset")
+
+            _, err := vctx.GetComputeService(context.Background())
+            Expect(err).To(MatchError(ContainSubstring("connection reset")))
+
+            factory.err = nil
+            svc, err := vctx.GetComputeService(context.Background())
+            Expect(err).To(MatchError(ContainSubstring("connection reset")))
+            Expect(svc).To(BeNil())
+            api, err := vctx.GetComputeAPI(context.Background())
+            Expect(err).To(MatchError(ContainSubstring("failed to create compute service")))
+            Expect(api).To(BeNil())
+            Expect(factory.calls.Load()).To(Equal(int32(1)))
+        })
+
+        It("should return the creation error to concurrent callers", func() {
+            factory.err = errors.New("transient: connection reset")
+            var wg sync.WaitGroup
+            var failures atomic.Int32
+            for i := 0; i < 20; i++ {
+                wg.Add(1)
+                go func() {
+                    defer GinkgoRecover()
+                    defer wg.Done()
+                    if _, err := vctx.GetComputeAPI(context.Background()); err != nil {
+                        failures.Add(1)
+                    }
+                }()
+            }
+            wg.Wait()
+
+            Expect(failures.Load()).To(Equal(int32(20)))
+        })
+
+        It("should not tie the shared client to the first caller's deadline", func() {
+            ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+            defer cancel()
+
+            _, err := vctx.GetComputeService(ctx)
+            Expect(err).NotTo(HaveOccurred())
+            <-ctx.Done()
+            Expect(factory.computeCtx.Err()).NotTo(HaveOccurred())
+            _, hasDeadline := factory.computeCtx.Deadline()
+            Expect(hasDeadline).To(BeFalse())
+        })
+
+        It("should read the credential projects once without recording a scope", func() {
+            projects, err := vctx.GetCredentialProjects(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(projects.ProjectID).To(Equal("adc-project"))
+            _, err = vctx.GetCredentialProjects(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+
+            Expect(factory.calls.Load()).To(Equal(int32(1)))
+            Expect(vctx.ScopesUsed()).To(BeEmpty())
+        })
+
+        It("should wrap real clients in the API getters", func() {
+            api, err := vctx.GetServiceUsageAPI(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(api).NotTo(BeNil())
+
+            quotaAPI, err := vctx.GetServiceQuotaAPI(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(quotaAPI).NotTo(BeNil())
+        })
+    })
+
+    Describe("API overrides", func() {
+        BeforeEach(func() {
+            vctx = validator.NewContext(cfg, logger)
+        })
+
+        It("should return the injected Service Usage API without creating a client", func() {
+            fake := &stubServiceUsage{}
+            vctx.SetServiceUsageAPI(fake)
+
+            api, err := vctx.GetServiceUsageAPI(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(api).To(BeIdenticalTo(fake))
+        })
+
+        It("should return the injected Compute API without creating a client", func() {
+            fake := &stubCompute{}
+            vctx.SetComputeAPI(fake)
+
+            api, err := vctx.GetComputeAPI(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(api).To(BeIdenticalTo(fake))
+        })
+
+        It("should return the injected credential projects without reading credentials", func() {
+            projects := &gcp.CredentialProjects{ProjectID: "other-project"}
+            vctx.SetCredentialProjects(projects)
+
+            got, err := vctx.GetCredentialProjects(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(got).To(BeIdenticalTo(projects))
+        })
+    })
+
+    Describe("GetProjectNumber", func() {
         BeforeEach(func() {
             vctx = validator.NewContext(cfg, logger)
         })
 
+        It("should return a pre-set project number without a lookup", func() {
+            vctx.ProjectNumber = 42
+            vctx.SetResourceManagerAPI(&stubResourceManager{err: errors.New("should not be called")})
+
+            n, err := vctx.GetProjectNumber(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(n).To(Equal(int64(42)))
+        })
+
+        It("should resolve and cache the project number", func() {
+            crm := &stubResourceManager{number: 987654321}
+            vctx.SetResourceManagerAPI(crm)
+
+            n, err := vctx.GetProjectNumber(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(n).To(Equal(int64(987654321)))
+
+            _, _ = vctx.GetProjectNumber(context.Background())
+            Expect(crm.calls).To(Equal(1))
+            Expect(vctx.ProjectNumber).To(Equal(int64(987654321)))
+        })
+
+        It("should return lookup errors", func() {
+            vctx.SetResourceManagerAPI(&stubResourceManager{err: errors.New("denied")})
+
+            _, err := vctx.GetProjectNumber(context.Background())
+            Expect(err).To(MatchError(ContainSubstring("denied")))
+        })
+    })
+
+    Describe("Context Cancellation", func() {
+        BeforeEach(func() {
+            vctx = validator.NewContext(cfg, logger)
+        })
 
         It("should not panic with cancelled context", func() {
             ctx, cancel := context.WithCancel(context.Background())
@@ -224,6 +568,11 @@ var _ = Describe("Context", func() {
                 func(ctx context.Context) (interface{}, error) { return vctx.GetServiceUsageService(ctx) },
                 func(ctx context.Context) (interface{}, error) { return vctx.GetMonitoringService(ctx) },
                 func(ctx context.Context) (interface{}, error) { return vctx.GetCloudResourceManagerService(ctx) },
+                func(ctx context.Context) (interface{}, error) { return vctx.GetStorageService(ctx) },
+                func(ctx context.Context) (interface{}, error) { return vctx.GetFilestoreService(ctx) },
+                func(ctx context.Context) (interface{}, error) { return vctx.GetKMSService(ctx) },
+                func(ctx context.Context) (interface{}, error) { return vctx.GetTagsService(ctx) },
+                func(ctx context.Context) (interface{}, error) { return vctx.GetIAMV2Service(ctx) },
             }
 
             // Launch multiple goroutines for each getter
@@ -264,5 +613,301 @@ var _ = Describe("Context", func() {
             Expect(vctx.Results).To(HaveLen(1))
             Expect(vctx.Results["validator-1"].Status).To(Equal(validator.StatusSuccess))
         })
+
+        It("should record timings concurrently", func() {
+            var wg sync.WaitGroup
+            for i := 0; i < 50; i++ {
+                wg.Add(1)
+                go func(i int) {
+                    defer wg.Done()
+                    vctx.RecordTiming(fmt.Sprintf("validator-%d", i), time.Duration(i)*time.Millisecond)
+                    _ = vctx.Timings()
+                }(i)
+            }
+            wg.Wait()
+
+            timings := vctx.Timings()
+            Expect(timings).To(HaveLen(50))
+            Expect(timings).To(HaveKeyWithValue("validator-7", 7*time.Millisecond))
+        })
+
+        It("should return a snapshot of timings", func() {
+            vctx.RecordTiming("validator-1", time.Second)
+            timings := vctx.Timings()
+            timings["validator-1"] = 0
+
+            Expect(vctx.Timings()).To(HaveKeyWithValue("validator-1", time.Second))
+        })
     })
 })
+
+// stubServiceUsage is a no-op gcp.ServiceUsageAPI used to verify injection
+type stubServiceUsage struct{}
+
+func (s *stubServiceUsage) GetService(ctx context.Context, name string) (*serviceusage.GoogleApiServiceusageV1Service, error) {
+    return &serviceusage.GoogleApiServiceusageV1Service{Name: name, State: "ENABLED"}, nil
+}
+
+func (s *stubServiceUsage) ListEnabledServices(ctx context.Context, parent string) ([]*serviceusage.GoogleApiServiceusageV1Service, error) {
+    return nil, nil
+}
+
+// stubCompute is a no-op gcp.ComputeAPI used to verify injection
+type stubCompute struct{}
+
+func (s *stubCompute) GetProject(ctx context.Context, project string) (*compute.Project, error) {
+    return &compute.Project{Name: project}, nil
+}
+
+func (s *stubCompute) GetRegion(ctx context.Context, project, region string) (*compute.Region, error) {
+    return &compute.Region{Name: region}, nil
+}
+
+func (s *stubCompute) GetZone(ctx context.Context, project, zone string) (*compute.Zone, error) {
+    return &compute.Zone{Name: zone}, nil
+}
+
+func (s *stubCompute) GetSslCertificate(ctx context.Context, project, name string) (*compute.SslCertificate, error) {
+    return &compute.SslCertificate{Name: name}, nil
+}
+
+func (s *stubCompute) GetAcceleratorType(ctx context.Context, project, zone, name string) (*compute.AcceleratorType, error) {
+    return &compute.AcceleratorType{Name: name, Zone: zone}, nil
+}
+
+func (s *stubCompute) GetNetwork(ctx context.Context, project, name string) (*compute.Network, error) {
+    return &compute.Network{Name: name}, nil
+}
+
+func (s *stubCompute) GetAddress(ctx context.Context, project, region, name string) (*compute.Address, error) {
+    return &compute.Address{Name: name}, nil
+}
+
+func (s *stubCompute) ListAddresses(ctx context.Context, project, region string) ([]*compute.Address, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) GetRouter(ctx context.Context, project, region, name string) (*compute.Router, error) {
+    return &compute.Router{Name: name}, nil
+}
+
+func (s *stubCompute) GetRouterStatus(ctx context.Context, project, region, name string) (*compute.RouterStatusResponse, error) {
+    return &compute.RouterStatusResponse{Result: &compute.RouterStatus{}}, nil
+}
+
+func (s *stubCompute) ListVpnTunnels(ctx context.Context, project string) ([]*compute.VpnTunnel, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListInterconnectAttachments(ctx context.Context, project string) ([]*compute.InterconnectAttachment, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListInstances(ctx context.Context, project string) ([]*compute.Instance, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListDisks(ctx context.Context, project string) ([]*compute.Disk, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListNetworks(ctx context.Context, project string) ([]*compute.Network, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListGlobalAddresses(ctx context.Context, project string) ([]*compute.Address, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListSubnetworks(ctx context.Context, project string) ([]*compute.Subnetwork, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListRoutes(ctx context.Context, project string) ([]*compute.Route, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) ListFirewalls(ctx context.Context, project string) ([]*compute.Firewall, error) {
+    return nil, nil
+}
+
+func (s *stubCompute) GetInstanceTemplate(ctx context.Context, project, name string) (*compute.InstanceTemplate, error) {
+    return &compute.InstanceTemplate{Name: name}, nil
+}
+
+func (s *stubCompute) GetMachineType(ctx context.Context, project, zone, name string) (*compute.MachineType, error) {
+    return &compute.MachineType{Name: name, Zone: zone}, nil
+}
+
+func (s *stubCompute) GetImage(ctx context.Context, project, name string) (*compute.Image, error) {
+    return &compute.Image{Name: name}, nil
+}
+
+func (s *stubCompute) GetImageFromFamily(ctx context.Context, project, family string) (*compute.Image, error) {
+    return &compute.Image{Family: family}, nil
+}
+
+// fakeClientFactory implements validator.ClientFactoryInterface without touching GCP auth
+// It returns zero-value services and counts how many were created
+type fakeClientFactory struct {
+    calls atomic.Int32
+    err   error
+    // computeCtx is the context CreateComputeService was last called with
+    computeCtx context.Context
+}
+
+func (f *fakeClientFactory) CreateComputeService(ctx context.Context) (*compute.Service, error) {
+    f.calls.Add(1)
+    f.computeCtx = ctx
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &compute.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateIAMService(ctx context.Context) (*iam.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &iam.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateCloudResourceManagerService(ctx context.Context) (*cloudresourcemanager.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &cloudresourcemanager.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateServiceUsageService(ctx context.Context) (*serviceusage.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &serviceusage.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateServiceUsageBetaService(ctx context.Context) (*serviceusagebeta.APIService, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &serviceusagebeta.APIService{}, nil
+}
+
+func (f *fakeClientFactory) CreateMonitoringService(ctx context.Context) (*monitoring.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &monitoring.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateStorageService(ctx context.Context) (*storage.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &storage.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateFilestoreService(ctx context.Context) (*file.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &file.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateKMSService(ctx context.Context) (*cloudkms.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &cloudkms.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateTagsService(ctx context.Context) (*crmv3.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &crmv3.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateIAMV2Service(ctx context.Context) (*iamv2.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &iamv2.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateIAMCredentialsService(ctx context.Context) (*iamcredentials.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &iamcredentials.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateServiceNetworkingService(ctx context.Context) (*servicenetworking.APIService, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &servicenetworking.APIService{}, nil
+}
+
+func (f *fakeClientFactory) CreateLoggingService(ctx context.Context) (*logging.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &logging.Service{}, nil
+}
+
+func (f *fakeClientFactory) CreateDNSService(ctx context.Context) (*dns.Service, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &dns.Service{}, nil
+}
+
+func (f *fakeClientFactory) DefaultCredentialProjects(ctx context.Context) (*gcp.CredentialProjects, error) {
+    f.calls.Add(1)
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &gcp.CredentialProjects{ProjectID: "adc-project"}, nil
+}
+
+// stubResourceManager is a gcp.ResourceManagerAPI returning a fixed project number
+type stubResourceManager struct {
+    number int64
+    err    error
+    calls  int
+}
+
+func (s *stubResourceManager) GetProject(ctx context.Context, projectID string) (*cloudresourcemanager.Project, error) {
+    s.calls++
+    if s.err != nil {
+        return nil, s.err
+    }
+    return &cloudresourcemanager.Project{ProjectId: projectID, ProjectNumber: s.number}, nil
+}
+
+func (s *stubResourceManager) GetIamPolicy(ctx context.Context, projectID string) (*cloudresourcemanager.Policy, error) {
+    return &cloudresourcemanager.Policy{}, nil
+}
+
+func (s *stubResourceManager) GetAncestry(ctx context.Context, projectID string) ([]*cloudresourcemanager.Ancestor, error) {
+    return nil, nil
+}
+
+func (s *stubResourceManager) GetEffectiveOrgPolicy(ctx context.Context, projectID, constraint string) (*cloudresourcemanager.OrgPolicy, error) {
+    return &cloudresourcemanager.OrgPolicy{}, nil
+}
@@ -4,40 +4,167 @@ import (
     "context"
     "fmt"
     "log/slog"
+    "maps"
+    "math"
     "runtime/debug"
+    "sort"
+    "strings"
     "sync"
     "time"
 )
 
+// Capacity of the ResultsChan buffer; results that do not fit are dropped, not waited on
+const resultsChanBuffer = 64
+
 // Executor orchestrates validator execution
 type Executor struct {
     ctx    *Context
     logger *slog.Logger
+    clock  Clock      // Source of result timestamps and durations
     mu     sync.Mutex // Protects results map during parallel execution
+
+    // Start times of validators currently executing, for progress logging
+    runningMu sync.Mutex
+    running   map[string]time.Time
+
+    // Validators still running when the soft timeout passed, guarded by runningMu
+    pastSoftTimeout map[string]bool
+
+    // Optional stream of results as they are recorded, created by ResultsChan
+    streamMu sync.Mutex
+    stream   chan *Result
+
+    // Parallelism of each level executed by the last ExecuteAll, in execution order
+    levelStats []LevelStats
+}
+
+// LevelStats reports how much one execution level gained from running its validators in parallel
+type LevelStats struct {
+    Level      int           `json:"level"`
+    Validators int           `json:"validators"`
+    Wall       time.Duration `json:"wall_duration_ns"`
+    Sum        time.Duration `json:"sum_duration_ns"`
+    // Efficiency is Sum/Wall: close to Validators when the level parallelized well,
+    // close to 1 when a single slow validator dominated it
+    Efficiency      float64       `json:"parallel_efficiency"`
+    Slowest         string        `json:"slowest_validator,omitempty"`
+    SlowestDuration time.Duration `json:"slowest_duration_ns"`
+}
+
+// newLevelStats summarizes a level from its validators' results
+// The wall-clock duration spans the earliest start (Timestamp - Duration) to the latest Timestamp
+func newLevelStats(level int, results []*Result) LevelStats {
+    stats := LevelStats{Level: level, Validators: len(results)}
+    var first, last time.Time
+    for _, r := range results {
+        if r == nil {
+            continue
+        }
+        start := r.Timestamp.Add(-r.Duration)
+        if first.IsZero() || start.Before(first) {
+            first = start
+        }
+        if r.Timestamp.After(last) {
+            last = r.Timestamp
+        }
+        stats.Sum += r.Duration
+        if stats.Slowest == "" || r.Duration > stats.SlowestDuration {
+            stats.Slowest = r.ValidatorName
+            stats.SlowestDuration = r.Duration
+        }
+    }
+    stats.Wall = last.Sub(first)
+    if stats.Wall > 0 {
+        stats.Efficiency = math.Round(float64(stats.Sum)/float64(stats.Wall)*100) / 100
+    }
+    return stats
 }
 
 // NewExecutor creates a new executor
 func NewExecutor(ctx *Context, logger *slog.Logger) *Executor {
     return &Executor{
-        ctx:    ctx,
-        logger: logger,
+        ctx:     ctx,
+        logger:  logger,
+        clock:   realClock{},
+        running: make(map[string]time.Time),
     }
 }
 
+// SetClock replaces the clock used for result timestamps, durations and progress logs (for testing)
+// Call it before ExecuteAll
+func (e *Executor) SetClock(clock Clock) {
+    e.clock = clock
+}
+
+// ResultsChan returns a channel that receives each validator's result as soon as it is recorded
+// Call it before ExecuteAll; the channel is closed when ExecuteAll returns
+// It is buffered and never blocks the executor: results a slow reader leaves behind are dropped
+// with a warning, while the final results returned by ExecuteAll are always complete
+func (e *Executor) ResultsChan() <-chan *Result {
+    e.streamMu.Lock()
+    defer e.streamMu.Unlock()
+    if e.stream == nil {
+        e.stream = make(chan *Result, resultsChanBuffer)
+    }
+    return e.stream
+}
+
+// publish offers a recorded result to the ResultsChan stream, if one was requested
+func (e *Executor) publish(result *Result) {
+    e.streamMu.Lock()
+    defer e.streamMu.Unlock()
+    if e.stream == nil {
+        return
+    }
+    select {
+    case e.stream <- result:
+    default:
+        e.logger.Warn("Results stream is full, dropping streamed result",
+            "validator", result.ValidatorName)
+    }
+}
+
+// closeStream closes the ResultsChan stream, if one was requested, so a later run needs a new one
+func (e *Executor) closeStream() {
+    e.streamMu.Lock()
+    defer e.streamMu.Unlock()
+    if e.stream != nil {
+        close(e.stream)
+        e.stream = nil
+    }
+}
+
+// LevelStats returns the parallelism of each level executed by the last ExecuteAll, in execution order
+// Pass it to Aggregate with WithLevelStats
+func (e *Executor) LevelStats() []LevelStats {
+    return e.levelStats
+}
+
 // ExecuteAll runs validators with dependency resolution and parallel execution
 func (e *Executor) ExecuteAll(ctx context.Context) ([]*Result, error) {
+    defer e.closeStream()
+    e.levelStats = nil
+    e.pastSoftTimeout = make(map[string]bool)
+
     // 1. Get all registered validators
     allValidators := GetAll()
 
     // 2. Filter enabled validators using config
-    enabledValidators := []Validator{}
-    for _, v := range allValidators {
-        meta := v.Metadata()
-        if e.ctx.Config.IsValidatorEnabled(meta.Name) {
-            enabledValidators = append(enabledValidators, v)
-        } else {
-            e.logger.Info("Validator disabled, skipping", "validator", meta.Name)
+    enabledValidators := FilterEnabled(e.ctx, e.logger, allValidators)
+
+    // Narrow to a single validator and its dependencies when debugging with --only / ONLY_VALIDATOR
+    if only := e.ctx.Config.OnlyValidator; only != "" {
+        if _, exists := Get(only); !exists {
+            return nil, fmt.Errorf("unknown validator %q", only)
+        }
+        var err error
+        enabledValidators, err = SelectWithDependencies(enabledValidators, only)
+        if err != nil {
+            return nil, err
         }
+        e.logger.Info("Running only the selected validator and its dependencies",
+            "validator", only,
+            "count", len(enabledValidators))
     }
 
     if len(enabledValidators) == 0 {
@@ -45,6 +172,8 @@ func (e *Executor) ExecuteAll(ctx context.Context) ([]*Result, error) {
     }
 
     e.logger.Info("Found enabled validators", "count", len(enabledValidators))
+    e.checkInterfaceVersions(enabledValidators)
+    e.checkDroppedDependencies(enabledValidators)
 
     // 3. Resolve dependencies and build execution plan
     resolver := NewDependencyResolver(enabledValidators)
@@ -54,6 +183,7 @@ func (e *Executor) ExecuteAll(ctx context.Context) ([]*Result, error) {
     }
 
     e.logger.Info("Execution plan created", "groups", len(groups))
+    e.ctx.setExecutionPlan(groups)
 
     // Log dependency graphs
     e.logger.Debug("Validator dependency graph (raw dependencies):\n" + resolver.ToMermaid())
@@ -66,6 +196,40 @@ func (e *Executor) ExecuteAll(ctx context.Context) ([]*Result, error) {
             "mode", "parallel")
     }
 
+    // Periodically log which validators are still running so long runs don't look hung
+    if interval := time.Duration(e.ctx.Config.ProgressIntervalSeconds) * time.Second; interval > 0 {
+        stop := make(chan struct{})
+        var progressWg sync.WaitGroup
+        progressWg.Add(1)
+        go func() {
+            defer progressWg.Done()
+            e.reportProgress(ctx, interval, stop)
+        }()
+        defer func() {
+            close(stop)
+            progressWg.Wait()
+        }()
+    }
+
+    // Warn once the run crosses SOFT_TIMEOUT_SECONDS; validators keep running until the hard deadline
+    if soft := time.Duration(e.ctx.Config.SoftTimeoutSeconds) * time.Second; soft > 0 {
+        stop := make(chan struct{})
+        var softWg sync.WaitGroup
+        softWg.Add(1)
+        go func() {
+            defer softWg.Done()
+            e.watchSoftTimeout(ctx, soft, stop)
+        }()
+        defer func() {
+            close(stop)
+            softWg.Wait()
+        }()
+    }
+
+    if e.ctx.Config.IsolateContexts {
+        e.logger.Info("Running each validator with an isolated context; service clients are not shared")
+    }
+
     // 4. Execute validators group by group
     allResults := []*Result{}
     for _, group := range groups {
@@ -75,9 +239,17 @@ func (e *Executor) ExecuteAll(ctx context.Context) ([]*Result, error) {
 
         groupResults := e.executeGroup(ctx, group)
         allResults = append(allResults, groupResults...)
+        stats := newLevelStats(group.Level, groupResults)
+        e.levelStats = append(e.levelStats, stats)
+        e.logger.Debug("Level completed",
+            "level", group.Level,
+            "wall", stats.Wall,
+            "sum", stats.Sum,
+            "parallel_efficiency", stats.Efficiency,
+            "slowest", stats.Slowest)
 
-        // Check stop on failure
-        if e.ctx.Config.StopOnFirstFailure {
+        // Check stop on failure (STOP_LEVEL_ON_FAILURE also stops at the level boundary)
+        if e.ctx.Config.StopOnFirstFailure || e.ctx.Config.StopLevelOnFailure {
             for _, result := range groupResults {
                 if result.Status == StatusFailure {
                     e.logger.Warn("Stopping due to failure", "validator", result.ValidatorName)
@@ -90,16 +262,47 @@ func (e *Executor) ExecuteAll(ctx context.Context) ([]*Result, error) {
     return allResults, nil
 }
 
+// storeResult records a validator's result and timing, merging back its isolated context, and publishes it
+// With STOP_LEVEL_ON_FAILURE, a failure cancels levelCtx for the rest of the level
+func (e *Executor) storeResult(levelCtx context.Context, cancelLevel context.CancelCauseFunc, level int, vctx *Context, snapshot map[string]*Result, result *Result) {
+    // Thread-safe result storage
+    e.mu.Lock()
+    if vctx != e.ctx {
+        e.ctx.mergeIsolated(vctx, snapshot)
+    }
+    e.ctx.Results[result.ValidatorName] = result
+    e.mu.Unlock()
+    e.ctx.RecordTiming(result.ValidatorName, result.Duration)
+    e.publish(result)
+
+    if result.Status == StatusFailure && e.ctx.Config.StopLevelOnFailure && levelCtx.Err() == nil {
+        e.logger.Warn("Cancelling remaining validators in level due to failure",
+            "validator", result.ValidatorName,
+            "level", level)
+        cancelLevel(fmt.Errorf("%w: %s", ErrLevelStopped, result.ValidatorName))
+    }
+}
+
 // executeGroup runs all validators in a group in parallel
+// With STOP_LEVEL_ON_FAILURE, the first failure cancels the context of the rest of the level
 func (e *Executor) executeGroup(ctx context.Context, group ExecutionGroup) []*Result {
     var wg sync.WaitGroup
     results := make([]*Result, len(group.Validators))
 
+    ctx, cancelLevel := context.WithCancelCause(ctx)
+    defer cancelLevel(nil)
+
     for i, v := range group.Validators {
         wg.Add(1)
         go func(index int, validator Validator) {
             defer wg.Done()
 
+            // Under ISOLATE_CONTEXTS the validator gets its own clients and a copy of the results so far;
+            // declared before the recovery so the clients of a panicking validator are merged back too
+            vctx := e.ctx
+            var snapshot map[string]*Result
+            start := e.clock.Now()
+
             // Add panic recovery to prevent one validator from crashing all validators
             defer func() {
                 if r := recover(); r != nil {
@@ -111,33 +314,63 @@ func (e *Executor) executeGroup(ctx context.Context, group ExecutionGroup) []*Re
                         "stack", stack)
 
                     // Create failure result for panicked validator
+                    end := e.clock.Now()
                     panicResult := &Result{
                         ValidatorName: meta.Name,
                         Status:        StatusFailure,
-                        Reason:        "ValidatorPanic",
+                        Reason:        ReasonValidatorPanic,
                         Message:       fmt.Sprintf("Validator crashed: %v", r),
                         Details: map[string]interface{}{
                             "panic":      fmt.Sprint(r),
                             "panic_type": fmt.Sprintf("%T", r),
                             "stack":      stack,
                         },
-                        Duration:  0,
-                        Timestamp: time.Now().UTC(),
+                        Duration:  end.Sub(start),
+                        Timestamp: end.UTC(),
                     }
+                    if meta.Experimental {
+                        markExperimental(panicResult)
+                    }
+                    markWeight(panicResult, meta.Weight)
 
-                    // Thread-safe result storage
-                    e.mu.Lock()
-                    e.ctx.Results[meta.Name] = panicResult
+                    e.storeResult(ctx, cancelLevel, group.Level, vctx, snapshot, panicResult)
                     results[index] = panicResult
-                    e.mu.Unlock()
                 }
             }()
 
             meta := validator.Metadata()
             e.logger.Info("Running validator", "validator", meta.Name)
+            if meta.Experimental {
+                e.logger.Warn("Running EXPERIMENTAL validator: results may be unreliable",
+                    "validator", meta.Name,
+                    "blocking", e.ctx.Config.TreatExperimentalAsBlocking,
+                    "hint", "Its failures do not fail the run unless TREAT_EXPERIMENTAL_AS_BLOCKING=true")
+            }
 
-            start := time.Now()
-            result := validator.Validate(ctx, e.ctx)
+            e.markRunning(meta.Name, start)
+            defer e.markDone(meta.Name)
+
+            runCtx, cancelRun := e.validatorContext(ctx, meta)
+            defer cancelRun()
+
+            if e.ctx.Config.IsolateContexts {
+                e.mu.Lock()
+                snapshot = maps.Clone(e.ctx.Results)
+                e.mu.Unlock()
+                vctx = e.ctx.isolated(maps.Clone(snapshot))
+            }
+
+            // Cacheable validators are served from the result cache while their last result is fresh;
+            // validators whose required predecessors did not pass are skipped without running
+            result, cached := e.cachedResult(validator, meta)
+            blocked := false
+            if !cached {
+                result, blocked = e.prerequisiteResult(meta)
+            }
+            if !cached && !blocked {
+                result = validator.Validate(runCtx, vctx)
+            }
+            end := e.clock.Now()
 
             // Defensive nil check - validator.Validate should never return nil,
             // but handle it to prevent nil pointer panics
@@ -147,21 +380,40 @@ func (e *Executor) executeGroup(ctx context.Context, group ExecutionGroup) []*Re
                 result = &Result{
                     ValidatorName: meta.Name,
                     Status:        StatusFailure,
-                    Reason:        "NilResult",
+                    Reason:        ReasonNilResult,
                     Message:       "Validator returned nil result (this is a validator implementation bug)",
-                    Duration:      time.Since(start),
-                    Timestamp:     time.Now().UTC(),
+                    Duration:      end.Sub(start),
+                    Timestamp:     end.UTC(),
                 }
             } else {
-                result.Duration = time.Since(start)
-                result.Timestamp = time.Now().UTC()
+                result.Duration = end.Sub(start)
+                result.Timestamp = end.UTC()
                 result.ValidatorName = meta.Name
             }
 
-            // Thread-safe result storage
-            e.mu.Lock()
-            e.ctx.Results[meta.Name] = result
-            e.mu.Unlock()
+            if meta.Experimental {
+                markExperimental(result)
+            }
+            markWeight(result, meta.Weight)
+            if e.ranPastSoftTimeout(meta.Name) {
+                if result.Details == nil {
+                    result.Details = map[string]interface{}{}
+                }
+                result.Details["soft_timeout_exceeded"] = true
+            }
+
+            // Failures after the root context or the validator's own timeout ended are almost always caused by it;
+            // surface why it ended instead of a generic "context canceled"
+            if result.Status == StatusFailure {
+                if reason := CancellationReason(runCtx); reason != "" {
+                    annotateCancellation(result, reason, context.Cause(runCtx))
+                }
+            }
+
+            e.storeResult(ctx, cancelLevel, group.Level, vctx, snapshot, result)
+            if !cached && !blocked {
+                e.cacheResult(validator, meta, result)
+            }
 
             results[index] = result
 
@@ -178,6 +430,14 @@ func (e *Executor) executeGroup(ctx context.Context, group ExecutionGroup) []*Re
                     "reason", result.Reason,
                     "message", result.Message)
                 e.logger.Warn("Validator completed with failure", logAttrs...)
+            case StatusWarning:
+                logAttrs = append(logAttrs,
+                    "reason", result.Reason,
+                    "message", result.Message)
+                e.logger.Warn("Validator completed with warning", logAttrs...)
+            case StatusSkipped:
+                logAttrs = append(logAttrs, "reason", result.Reason)
+                e.logger.Info("Validator skipped", logAttrs...)
             default:
                 e.logger.Info("Validator completed", logAttrs...)
             }
@@ -187,3 +447,279 @@ func (e *Executor) executeGroup(ctx context.Context, group ExecutionGroup) []*Re
     wg.Wait() // Wait for all validators in this group
     return results
 }
+
+// cachedResult returns the cached result of a Cacheable validator for the configured project and settings,
+// marked with Details["cached"] and the time it was produced in Details["cached_at"]
+func (e *Executor) cachedResult(v Validator, meta ValidatorMetadata) (*Result, bool) {
+    cache := e.ctx.ResultCache()
+    if !meta.Cacheable || cache == nil {
+        return nil, false
+    }
+    result, ok := cache.Get(meta.Name, e.ctx.Config.ProjectID, cacheKeyOf(v, e.ctx))
+    if !ok {
+        return nil, false
+    }
+    if result.Details == nil {
+        result.Details = map[string]interface{}{}
+    }
+    result.Details["cached"] = true
+    result.Details["cached_at"] = result.Timestamp.Format(time.RFC3339)
+    e.logger.Info("Using cached result", "validator", meta.Name, "cached_at", result.Details["cached_at"])
+    return result, true
+}
+
+// cacheResult stores the result of a Cacheable validator for its TTL
+// Failures are not cached so that a fix is picked up by the next run
+func (e *Executor) cacheResult(v Validator, meta ValidatorMetadata, result *Result) {
+    cache := e.ctx.ResultCache()
+    if !meta.Cacheable || cache == nil || result.Status == StatusFailure || result.Status == StatusSkipped {
+        return
+    }
+    ttl := meta.CacheTTL
+    if ttl <= 0 {
+        ttl = DefaultCacheTTL
+    }
+    cache.Put(meta.Name, e.ctx.Config.ProjectID, cacheKeyOf(v, e.ctx), result, ttl)
+}
+
+// markExperimental tags a result as coming from an experimental validator
+func markExperimental(result *Result) {
+    if result.Details == nil {
+        result.Details = map[string]interface{}{}
+    }
+    result.Details[experimentalDetail] = true
+}
+
+// markWeight records a non-default readiness score weight on a result so Aggregate can apply it
+func markWeight(result *Result, weight int) {
+    if weight <= 0 || weight == DefaultWeight {
+        return
+    }
+    if result.Details == nil {
+        result.Details = map[string]interface{}{}
+    }
+    result.Details[weightDetail] = weight
+}
+
+// annotateCancellation rewrites a failure's reason to the cancellation reason, keeping the original in details
+func annotateCancellation(result *Result, reason string, cause error) {
+    if result.Details == nil {
+        result.Details = map[string]interface{}{}
+    }
+    result.Details["original_reason"] = result.Reason
+    if cause != nil {
+        result.Details["cancel_cause"] = cause.Error()
+    }
+    result.Reason = reason
+}
+
+// FilterEnabled returns the validators that would run in vctx, logging each one left out
+func FilterEnabled(vctx *Context, logger *slog.Logger, validators []Validator) []Validator {
+    cfg := vctx.Config
+    enabled := []Validator{}
+    for _, v := range validators {
+        meta := v.Metadata()
+        if !cfg.IsValidatorEnabled(meta.Name) {
+            logger.Info("Validator disabled, skipping", "validator", meta.Name)
+            continue
+        }
+        if tag, ok := cfg.DisabledTag(meta.Tags); ok {
+            logger.Info("Validator has a disabled tag, skipping", "validator", meta.Name, "tag", tag)
+            continue
+        }
+        // Validators whose required configuration is absent drop out of the plan entirely
+        if c, ok := v.(Conditional); ok && !c.Enabled(vctx) {
+            logger.Info("Validator not enabled (required configuration missing), skipping", "validator", meta.Name)
+            continue
+        }
+        // Validation is read-only by design; mutating validators must be opted into explicitly
+        if meta.HasTag(TagDestructive) && !cfg.AllowDestructive {
+            logger.Warn("Refusing to run destructive validator, skipping",
+                "validator", meta.Name,
+                "hint", "Set ALLOW_DESTRUCTIVE=true to run validators that create or delete resources")
+            continue
+        }
+        enabled = append(enabled, v)
+    }
+    return enabled
+}
+
+// validatorContext bounds one validator run by its time limit, which is the operator's
+// VALIDATOR_<NAME>_TIMEOUT_SECONDS, else Metadata.DefaultTimeout, else VALIDATOR_TIMEOUT_SECONDS
+// Without any of these only the overall MAX_WAIT_TIME_SECONDS deadline applies
+func (e *Executor) validatorContext(ctx context.Context, meta ValidatorMetadata) (context.Context, context.CancelFunc) {
+    timeout := e.ctx.Config.ValidatorTimeout(meta.Name)
+    if timeout == 0 {
+        timeout = meta.DefaultTimeout
+    }
+    if timeout == 0 {
+        timeout = time.Duration(e.ctx.Config.ValidatorTimeoutSeconds) * time.Second
+    }
+    if timeout <= 0 {
+        return context.WithCancel(ctx)
+    }
+    return context.WithTimeoutCause(ctx, timeout,
+        fmt.Errorf("%w: %s exceeded its %s timeout", context.DeadlineExceeded, meta.Name, timeout))
+}
+
+// checkInterfaceVersions warns about validators written against a different Validator contract
+// Informational only: the validators still run, but may not use features added since their version
+func (e *Executor) checkInterfaceVersions(validators []Validator) {
+    for _, v := range validators {
+        version := VersionOf(v)
+        switch {
+        case version < InterfaceVersion:
+            e.logger.Warn("Validator implements an older interface version",
+                "validator", v.Metadata().Name,
+                "version", version,
+                "current_version", InterfaceVersion)
+        case version > InterfaceVersion:
+            e.logger.Warn("Validator implements a newer interface version than this executor supports",
+                "validator", v.Metadata().Name,
+                "version", version,
+                "current_version", InterfaceVersion)
+        }
+    }
+}
+
+// prerequisiteResult returns a skipped result when a RequireSuccess predecessor failed, was
+// skipped or did not run; earlier levels have finished, so their results are final
+func (e *Executor) prerequisiteResult(meta ValidatorMetadata) (*Result, bool) {
+    e.mu.Lock()
+    defer e.mu.Unlock()
+
+    var failed []string
+    prerequisites := map[string]string{}
+    for _, name := range meta.RequireSuccess {
+        r, ok := e.ctx.Results[name]
+        switch {
+        case !ok:
+            prerequisites[name] = "not run"
+        case r.Status == StatusSuccess || r.Status == StatusWarning:
+            continue
+        default:
+            prerequisites[name] = fmt.Sprintf("%s (%s)", r.Status, r.Reason)
+        }
+        failed = append(failed, name)
+    }
+    if len(failed) == 0 {
+        return nil, false
+    }
+
+    e.logger.Warn("Skipping validator because a required predecessor did not pass",
+        "validator", meta.Name,
+        "prerequisites", failed)
+    return &Result{
+        Status:  StatusSkipped,
+        Reason:  ReasonPrerequisiteFailed,
+        Message: fmt.Sprintf("Skipped: required predecessor(s) did not pass: %s", strings.Join(failed, ", ")),
+        Details: map[string]interface{}{
+            "failed_prerequisites": prerequisites,
+        },
+    }, true
+}
+
+// checkDroppedDependencies warns when a validator's RunAfter or RequireSuccess names a registered validator that
+// is not running; the resolver ignores that dependency, which can move the validator to an earlier level
+// Informational only: the validator still runs
+func (e *Executor) checkDroppedDependencies(validators []Validator) {
+    running := make(map[string]bool, len(validators))
+    for _, v := range validators {
+        running[v.Metadata().Name] = true
+    }
+    for _, v := range validators {
+        meta := v.Metadata()
+        for _, dep := range meta.Dependencies() {
+            if _, registered := Get(dep); registered && !running[dep] {
+                e.logger.Warn("Validator dependency is disabled and will be ignored, so the validator may run earlier than usual",
+                    "validator", meta.Name,
+                    "dependency", dep)
+            }
+        }
+    }
+}
+
+// markRunning records that a validator started executing
+func (e *Executor) markRunning(name string, start time.Time) {
+    e.runningMu.Lock()
+    defer e.runningMu.Unlock()
+    e.running[name] = start
+}
+
+// markDone records that a validator finished executing
+func (e *Executor) markDone(name string) {
+    e.runningMu.Lock()
+    defer e.runningMu.Unlock()
+    delete(e.running, name)
+}
+
+// watchSoftTimeout logs a warning naming the running validators once soft has elapsed, and marks them
+// so their results carry details.soft_timeout_exceeded
+// Returns when stop is closed, ctx is cancelled or the warning was logged
+func (e *Executor) watchSoftTimeout(ctx context.Context, soft time.Duration, stop <-chan struct{}) {
+    timer := time.NewTimer(soft)
+    defer timer.Stop()
+
+    select {
+    case <-stop:
+        return
+    case <-ctx.Done():
+        return
+    case <-timer.C:
+    }
+
+    e.runningMu.Lock()
+    names := make([]string, 0, len(e.running))
+    for name := range e.running {
+        names = append(names, name)
+        e.pastSoftTimeout[name] = true
+    }
+    e.runningMu.Unlock()
+    sort.Strings(names)
+
+    e.logger.Warn("Validation is approaching its timeout: SOFT_TIMEOUT_SECONDS exceeded",
+        "soft_timeout", soft,
+        "hard_timeout", time.Duration(e.ctx.Config.MaxWaitTimeSeconds)*time.Second,
+        "running", names)
+}
+
+// ranPastSoftTimeout reports whether the validator was running when the soft timeout passed
+func (e *Executor) ranPastSoftTimeout(name string) bool {
+    e.runningMu.Lock()
+    defer e.runningMu.Unlock()
+    return e.pastSoftTimeout[name]
+}
+
+// reportProgress logs the running validators and their elapsed time every interval
+// Returns when stop is closed or ctx is cancelled
+func (e *Executor) reportProgress(ctx context.Context, interval time.Duration, stop <-chan struct{}) {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+
+    runStart := e.clock.Now()
+    for {
+        select {
+        case <-stop:
+            return
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            e.runningMu.Lock()
+            names := make([]string, 0, len(e.running))
+            for name := range e.running {
+                names = append(names, name)
+            }
+            sort.Strings(names)
+            running := make([]string, 0, len(names))
+            for _, name := range names {
+                elapsed := e.clock.Now().Sub(e.running[name]).Round(time.Second)
+                running = append(running, fmt.Sprintf("%s (%s)", name, elapsed))
+            }
+            e.runningMu.Unlock()
+
+            e.logger.Info("Validation in progress",
+                "elapsed", e.clock.Now().Sub(runStart).Round(time.Second),
+                "running", running)
+        }
+    }
+}
@@ -1,7 +1,10 @@
 package validator_test
 
 import (
+    "bytes"
     "context"
+    "errors"
+    "fmt"
     "log/slog"
     "os"
     "sync"
@@ -11,6 +14,7 @@ import (
     . "github.com/onsi/gomega"
 
     "validator/pkg/config"
+    "validator/pkg/gcp"
     "validator/pkg/validator"
 )
 
@@ -93,6 +97,41 @@ var _ = Describe("Executor", func() {
                 Expect(results[0].Timestamp).NotTo(BeZero())
                 Expect(results[0].Duration).To(BeNumerically(">", 0))
             })
+
+            It("should take timestamp and duration from the injected clock", func() {
+                // Progress logging would read the clock from its own goroutine
+                vctx.Config.ProgressIntervalSeconds = 0
+                start := time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC)
+
+                executor = validator.NewExecutor(vctx, logger)
+                executor.SetClock(&stepClock{now: start, step: 1500 * time.Millisecond})
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Timestamp).To(Equal(start.Add(1500 * time.Millisecond)))
+                Expect(results[0].Duration).To(Equal(1500 * time.Millisecond))
+            })
+        })
+
+        Context("with a panicking validator", func() {
+            BeforeEach(func() {
+                validator.Register(&MockValidator{
+                    name: "crashing",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        time.Sleep(10 * time.Millisecond)
+                        panic("boom")
+                    },
+                })
+            })
+
+            It("should record its duration and timing", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].Reason).To(Equal(validator.ReasonValidatorPanic))
+                Expect(results[0].Duration).To(BeNumerically(">=", 10*time.Millisecond))
+                Expect(vctx.Timings()).To(HaveKeyWithValue("crashing", results[0].Duration))
+            })
         })
 
         Context("with disabled validator", func() {
@@ -153,6 +192,78 @@ var _ = Describe("Executor", func() {
                 Expect(err).NotTo(HaveOccurred())
                 Expect(vctx.Results).To(HaveLen(3))
             })
+
+            It("should record the level's parallel efficiency", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                stats := executor.LevelStats()
+                Expect(stats).To(HaveLen(1))
+                Expect(stats[0].Level).To(Equal(0))
+                Expect(stats[0].Validators).To(Equal(3))
+
+                var sum time.Duration
+                for _, r := range results {
+                    sum += r.Duration
+                }
+                Expect(stats[0].Sum).To(Equal(sum))
+                Expect(stats[0].Wall).To(BeNumerically(">", 0))
+                Expect(stats[0].Wall).To(BeNumerically("<", sum))
+                Expect(stats[0].Efficiency).To(BeNumerically(">", 1))
+                Expect(stats[0].Slowest).NotTo(BeEmpty())
+            })
+        })
+
+        Context("with a results stream", func() {
+            register := func(count int) {
+                for i := 0; i < count; i++ {
+                    n := fmt.Sprintf("validator-%02d", i)
+                    validator.Register(&MockValidator{
+                        name: n,
+                        validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                            return &validator.Result{Status: validator.StatusSuccess}
+                        },
+                    })
+                }
+            }
+
+            It("should stream every result and close when the run ends", func() {
+                register(3)
+                executor = validator.NewExecutor(vctx, logger)
+                stream := executor.ResultsChan()
+
+                var streamed []string
+                done := make(chan struct{})
+                go func() {
+                    defer close(done)
+                    for r := range stream {
+                        streamed = append(streamed, r.ValidatorName)
+                    }
+                }()
+
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Eventually(done).Should(BeClosed())
+                Expect(streamed).To(ConsistOf("validator-00", "validator-01", "validator-02"))
+                Expect(results).To(HaveLen(3))
+            })
+
+            It("should drop results instead of blocking when no one reads", func() {
+                register(80)
+                executor = validator.NewExecutor(vctx, logger)
+                stream := executor.ResultsChan()
+
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(80))
+
+                buffered := 0
+                for range stream {
+                    buffered++
+                }
+                Expect(buffered).To(BeNumerically("<", 80))
+            })
         })
 
         Context("with dependent validators", func() {
@@ -207,6 +318,47 @@ var _ = Describe("Executor", func() {
                 Expect(executionOrder[1:]).To(ConsistOf("validator-b", "validator-c"))
             })
 
+            It("should record stats for each level in execution order", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                stats := executor.LevelStats()
+                Expect(stats).To(HaveLen(2))
+                Expect(stats[0].Level).To(Equal(0))
+                Expect(stats[0].Validators).To(Equal(1))
+                Expect(stats[0].Slowest).To(Equal("validator-a"))
+                Expect(stats[1].Level).To(Equal(1))
+                Expect(stats[1].Validators).To(Equal(2))
+            })
+
+            It("should record a timing for each validator", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                timings := vctx.Timings()
+                Expect(timings).To(HaveLen(3))
+                for _, r := range results {
+                    Expect(timings).To(HaveKeyWithValue(r.ValidatorName, r.Duration))
+                }
+            })
+
+            It("should expose results ordered by level then name", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                // Results for validators outside the plan sort last
+                vctx.Results["manual"] = &validator.Result{ValidatorName: "manual"}
+
+                var names []string
+                for _, r := range vctx.OrderedResults() {
+                    names = append(names, r.ValidatorName)
+                }
+                Expect(names).To(Equal([]string{"validator-a", "validator-b", "validator-c", "manual"}))
+            })
+
         It("should handle out-of-order registration (dependencies registered before dependents)", func() {
             // Clear previous validators and reset execution order
             validator.ClearRegistry()
@@ -257,69 +409,1054 @@ var _ = Describe("Executor", func() {
         })
         })
 
-        Context("with StopOnFirstFailure enabled", func() {
+        Context("with progress logging enabled", func() {
+            var logs *syncBuffer
+
             BeforeEach(func() {
-                vctx.Config.StopOnFirstFailure = true
+                logs = &syncBuffer{}
+                logger = slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelInfo}))
+                vctx.Config.ProgressIntervalSeconds = 1
 
-                // First validator fails
                 validator.Register(&MockValidator{
-                    name:    "failing-validator",
+                    name: "slow-validator",
                     validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
-                        return &validator.Result{
-                            ValidatorName: "failing-validator",
-                            Status:        validator.StatusFailure,
-                            Reason:        "TestFailure",
-                            Message:       "Intentional failure",
-                        }
+                        time.Sleep(1500 * time.Millisecond)
+                        return &validator.Result{Status: validator.StatusSuccess}
                     },
                 })
+            })
 
-                // Second validator should not run
+            It("should log the validators still running", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                Expect(logs.String()).To(ContainSubstring("Validation in progress"))
+                Expect(logs.String()).To(ContainSubstring("slow-validator"))
+            })
+
+            It("should stop logging once the run finishes", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                after := logs.String()
+                Consistently(logs.String, 1500*time.Millisecond, 250*time.Millisecond).Should(Equal(after))
+            })
+        })
+
+        Context("with isolated contexts", func() {
+            var (
+                factory *fakeClientFactory
+                mu      sync.Mutex
+                seen    map[string]*validator.Context
+            )
+
+            record := func(name string) func(context.Context, *validator.Context) *validator.Result {
+                return func(ctx context.Context, got *validator.Context) *validator.Result {
+                    mu.Lock()
+                    seen[name] = got
+                    mu.Unlock()
+                    _, err := got.GetComputeService(ctx)
+                    Expect(err).NotTo(HaveOccurred())
+                    return &validator.Result{Status: validator.StatusSuccess}
+                }
+            }
+
+            BeforeEach(func() {
+                factory = &fakeClientFactory{}
+                seen = map[string]*validator.Context{}
+                vctx = validator.NewContextWithFactory(vctx.Config, factory)
+                vctx.Config.IsolateContexts = true
+            })
+
+            It("should give each validator its own context and service clients", func() {
+                validator.Register(&MockValidator{name: "first", validateFunc: record("first")})
+                validator.Register(&MockValidator{name: "second", validateFunc: record("second")})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(2))
+
+                Expect(seen["first"]).NotTo(BeIdenticalTo(vctx))
+                Expect(seen["second"]).NotTo(BeIdenticalTo(vctx))
+                Expect(seen["first"]).NotTo(BeIdenticalTo(seen["second"]))
+                Expect(seen["first"].Config).To(BeIdenticalTo(vctx.Config))
+                Expect(factory.calls.Load()).To(Equal(int32(2)))
+                Expect(vctx.ScopesUsed()).To(Equal([]string{gcp.ComputeScope}))
+            })
+
+            It("should share the auth breaker between isolated contexts", func() {
+                factory.err = fmt.Errorf("failed to create default client: %w", gcp.ErrCredentials)
+                var secondErr error
                 validator.Register(&MockValidator{
-                    name:     "should-not-run",
-                    runAfter: []string{"failing-validator"},
+                    name: "first",
+                    validateFunc: func(ctx context.Context, got *validator.Context) *validator.Result {
+                        _, err := got.GetComputeService(ctx)
+                        Expect(err).To(HaveOccurred())
+                        return &validator.Result{Status: validator.StatusFailure}
+                    },
+                })
+                validator.Register(&MockValidator{
+                    name:     "second",
+                    runAfter: []string{"first"},
+                    validateFunc: func(ctx context.Context, got *validator.Context) *validator.Result {
+                        _, secondErr = got.GetIAMService(ctx)
+                        return &validator.Result{Status: validator.StatusFailure}
+                    },
+                })
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(errors.Is(secondErr, gcp.ErrCredentials)).To(BeTrue())
+                Expect(factory.calls.Load()).To(Equal(int32(1)), "Breaker should skip client creation")
+
+                _, err = vctx.GetStorageService(ctx)
+                Expect(errors.Is(err, gcp.ErrCredentials)).To(BeTrue())
+            })
+
+            It("should merge the scopes of a validator that panics", func() {
+                validator.Register(&MockValidator{
+                    name: "crashing",
+                    validateFunc: func(ctx context.Context, got *validator.Context) *validator.Result {
+                        _, err := got.GetComputeService(ctx)
+                        Expect(err).NotTo(HaveOccurred())
+                        panic("boom")
+                    },
+                })
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].Reason).To(Equal(validator.ReasonValidatorPanic))
+                Expect(vctx.ScopesUsed()).To(Equal([]string{gcp.ComputeScope}))
+            })
+
+            It("should share one context when off", func() {
+                vctx.Config.IsolateContexts = false
+                validator.Register(&MockValidator{name: "first", validateFunc: record("first")})
+                validator.Register(&MockValidator{name: "second", validateFunc: record("second")})
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(seen["first"]).To(BeIdenticalTo(vctx))
+                Expect(factory.calls.Load()).To(Equal(int32(1)))
+            })
+
+            It("should merge added results back without replacing recorded ones", func() {
+                var sawFirst bool
+                validator.Register(&MockValidator{
+                    name: "first",
+                    validateFunc: func(ctx context.Context, got *validator.Context) *validator.Result {
+                        got.Results["first/extra"] = &validator.Result{ValidatorName: "first/extra", Status: validator.StatusWarning}
+                        return &validator.Result{Status: validator.StatusSuccess}
+                    },
+                })
+                validator.Register(&MockValidator{
+                    name:     "second",
+                    runAfter: []string{"first"},
+                    validateFunc: func(ctx context.Context, got *validator.Context) *validator.Result {
+                        _, sawFirst = got.Results["first"]
+                        got.Results["first"] = &validator.Result{ValidatorName: "first", Status: validator.StatusFailure}
+                        return &validator.Result{Status: validator.StatusSuccess}
+                    },
+                })
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(sawFirst).To(BeTrue())
+                Expect(vctx.Results).To(HaveKey("first/extra"))
+                Expect(vctx.Results["first"].Status).To(Equal(validator.StatusSuccess))
+                Expect(vctx.Results["second"].Status).To(Equal(validator.StatusSuccess))
+            })
+        })
+
+        Context("with a destructive validator", func() {
+            var destructiveRan bool
+
+            BeforeEach(func() {
+                destructiveRan = false
+                validator.Register(&MockValidator{name: "read-only"})
+                validator.Register(&MockValidator{
+                    name: "creates-resources",
+                    tags: []string{validator.TagDestructive},
                     validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
-                        Fail("This validator should not execute")
-                        return nil
+                        destructiveRan = true
+                        return &validator.Result{Status: validator.StatusSuccess}
                     },
                 })
             })
 
-            It("should stop execution after first failure", func() {
+            It("should skip it by default", func() {
                 executor = validator.NewExecutor(vctx, logger)
                 results, err := executor.ExecuteAll(ctx)
                 Expect(err).NotTo(HaveOccurred())
                 Expect(results).To(HaveLen(1))
-                Expect(results[0].Status).To(Equal(validator.StatusFailure))
+                Expect(results[0].ValidatorName).To(Equal("read-only"))
+                Expect(destructiveRan).To(BeFalse())
+            })
+
+            It("should run it when ALLOW_DESTRUCTIVE is set", func() {
+                vctx.Config.AllowDestructive = true
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(2))
+                Expect(destructiveRan).To(BeTrue())
             })
         })
 
-        Context("with validator that returns failure", func() {
+        Context("with disabled tags", func() {
+            BeforeEach(func() {
+                validator.Register(&MockValidator{name: "untagged"})
+                validator.Register(&MockValidator{name: "network-probe", tags: []string{"network"}})
+                validator.Register(&MockValidator{name: "slow-probe", tags: []string{"post-mvp", "slow"}})
+            })
+
+            It("should skip validators carrying any disabled tag", func() {
+                vctx.Config.DisabledTags = []string{"slow", "network"}
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].ValidatorName).To(Equal("untagged"))
+            })
+        })
+
+        Context("with a soft timeout", func() {
+            var logs *syncBuffer
+
             BeforeEach(func() {
+                logs = &syncBuffer{}
+                logger = slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+                vctx.Config.SoftTimeoutSeconds = 1
+
                 validator.Register(&MockValidator{
-                    name:    "failing-validator",
+                    name: "slow-validator",
                     validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
-                        return &validator.Result{
-                            ValidatorName: "failing-validator",
-                            Status:        validator.StatusFailure,
-                            Reason:        "ValidationFailed",
-                            Message:       "Validation check failed",
-                            Details: map[string]interface{}{
-                                "error": "Test error",
-                            },
-                        }
+                        time.Sleep(1500 * time.Millisecond)
+                        return &validator.Result{Status: validator.StatusSuccess}
                     },
                 })
+                validator.Register(&MockValidator{name: "fast-validator"})
             })
 
-            It("should return the failure result", func() {
+            It("should warn and annotate validators still running when it passes", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(2))
+
+                Expect(logs.String()).To(ContainSubstring("SOFT_TIMEOUT_SECONDS exceeded"))
+                Expect(logs.String()).To(ContainSubstring("slow-validator"))
+                Expect(vctx.Results["slow-validator"].Status).To(Equal(validator.StatusSuccess))
+                Expect(vctx.Results["slow-validator"].Details).To(HaveKeyWithValue("soft_timeout_exceeded", true))
+                Expect(vctx.Results["fast-validator"].Details).NotTo(HaveKey("soft_timeout_exceeded"))
+            })
+
+            It("should not warn when the run finishes in time", func() {
+                vctx.Config.SoftTimeoutSeconds = 5
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(logs.String()).NotTo(ContainSubstring("SOFT_TIMEOUT_SECONDS"))
+            })
+        })
+
+        Context("with a dependency on a disabled validator", func() {
+            var logs *syncBuffer
+
+            BeforeEach(func() {
+                logs = &syncBuffer{}
+                logger = slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+                validator.Register(&MockValidator{name: "api-enabled"})
+                validator.Register(&MockValidator{name: "quota-check", runAfter: []string{"api-enabled", "not-registered"}})
+            })
+
+            It("should warn with both names and still run the dependent", func() {
+                vctx.Config.DisabledValidators = []string{"api-enabled"}
+
                 executor = validator.NewExecutor(vctx, logger)
                 results, err := executor.ExecuteAll(ctx)
                 Expect(err).NotTo(HaveOccurred())
                 Expect(results).To(HaveLen(1))
-                Expect(results[0].Status).To(Equal(validator.StatusFailure))
-                Expect(results[0].Reason).To(Equal("ValidationFailed"))
+                Expect(logs.String()).To(ContainSubstring("Validator dependency is disabled"))
+                Expect(logs.String()).To(ContainSubstring("validator=quota-check dependency=api-enabled"))
+                Expect(logs.String()).NotTo(ContainSubstring("dependency=not-registered"))
+            })
+
+            It("should not warn when the dependency runs", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(logs.String()).NotTo(ContainSubstring("Validator dependency is disabled"))
             })
         })
-    })
-})
+
+        Context("with versioned validators", func() {
+            var logs *syncBuffer
+
+            BeforeEach(func() {
+                logs = &syncBuffer{}
+                logger = slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+            })
+
+            It("should treat validators without Version as the current version", func() {
+                validator.Register(&MockValidator{name: "unversioned"})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(logs.String()).NotTo(ContainSubstring("interface version"))
+            })
+
+            It("should warn about an older contract but still run the validator", func() {
+                validator.Register(&versionedValidator{MockValidator: MockValidator{name: "legacy"}, version: 0})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(logs.String()).To(ContainSubstring("Validator implements an older interface version"))
+                Expect(logs.String()).To(ContainSubstring("validator=legacy"))
+            })
+
+            It("should warn about a newer contract", func() {
+                validator.Register(&versionedValidator{
+                    MockValidator: MockValidator{name: "future"},
+                    version:       validator.InterfaceVersion + 1,
+                })
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(logs.String()).To(ContainSubstring("newer interface version"))
+            })
+        })
+
+        Context("with an experimental validator", func() {
+            var logs *syncBuffer
+
+            BeforeEach(func() {
+                logs = &syncBuffer{}
+                logger = slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+                validator.Register(&experimentalValidator{MockValidator{
+                    name: "beta",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        return &validator.Result{Status: validator.StatusFailure, Reason: "BetaFailed"}
+                    },
+                }})
+            })
+
+            It("should warn when it runs and tag its result", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].Details).To(HaveKeyWithValue("experimental", true))
+                Expect(results[0].IsExperimental()).To(BeTrue())
+                Expect(logs.String()).To(ContainSubstring("Running EXPERIMENTAL validator"))
+                Expect(logs.String()).To(ContainSubstring("validator=beta"))
+            })
+        })
+
+        Context("with weighted validators", func() {
+            BeforeEach(func() {
+                validator.Register(&weightedValidator{MockValidator: MockValidator{name: "critical"}, weight: 3})
+                validator.Register(&weightedValidator{MockValidator: MockValidator{name: "plain"}, weight: validator.DefaultWeight})
+            })
+
+            It("should record non-default weights on the results", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(vctx.Results["critical"].Details).To(HaveKeyWithValue("weight", 3))
+                Expect(vctx.Results["critical"].Weight()).To(Equal(3))
+                Expect(vctx.Results["plain"].Details).NotTo(HaveKey("weight"))
+                Expect(results).To(HaveLen(2))
+            })
+        })
+
+        Context("with a conditional validator", func() {
+            BeforeEach(func() {
+                validator.Register(&MockValidator{name: "always"})
+                validator.Register(&conditionalValidator{
+                    MockValidator: MockValidator{name: "needs-parent"},
+                    key:           "EXPECTED_PARENT",
+                })
+            })
+
+            It("should leave it out of the plan when its config is missing", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].ValidatorName).To(Equal("always"))
+                Expect(vctx.Results).NotTo(HaveKey("needs-parent"))
+            })
+
+            It("should run it when its config is present", func() {
+                vctx.Config.ExpectedParent = "folders/123"
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(2))
+            })
+        })
+
+        Context("with validators declaring required APIs", func() {
+            BeforeEach(func() {
+                validator.Register(&apiRequiringValidator{
+                    MockValidator: MockValidator{name: "compute-a"},
+                    apis:          []string{"compute.googleapis.com"},
+                })
+                validator.Register(&apiRequiringValidator{
+                    MockValidator: MockValidator{name: "compute-b"},
+                    apis:          []string{"compute.googleapis.com", "file.googleapis.com"},
+                })
+                validator.Register(&apiRequiringValidator{
+                    MockValidator: MockValidator{name: "disabled-kms"},
+                    apis:          []string{"cloudkms.googleapis.com"},
+                })
+                vctx.Config.DisabledValidators = []string{"disabled-kms"}
+            })
+
+            It("should record the APIs of the planned validators only", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(vctx.PlannedAPIs()).To(Equal(map[string][]string{
+                    "compute.googleapis.com": {"compute-a", "compute-b"},
+                    "file.googleapis.com":    {"compute-b"},
+                }))
+            })
+        })
+
+        Context("with OnlyValidator set", func() {
+            BeforeEach(func() {
+                validator.Register(&MockValidator{name: "root"})
+                validator.Register(&MockValidator{name: "middle", runAfter: []string{"root"}})
+                validator.Register(&MockValidator{name: "target", runAfter: []string{"middle"}})
+                validator.Register(&MockValidator{name: "unrelated", runAfter: []string{"root"}})
+            })
+
+            It("should run only the validator and its transitive dependencies", func() {
+                vctx.Config.OnlyValidator = "target"
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(3))
+                Expect(vctx.Results).To(HaveKey("root"))
+                Expect(vctx.Results).To(HaveKey("middle"))
+                Expect(vctx.Results).To(HaveKey("target"))
+                Expect(vctx.Results).NotTo(HaveKey("unrelated"))
+            })
+
+            It("should fail for an unknown validator", func() {
+                vctx.Config.OnlyValidator = "does-not-exist"
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).To(MatchError(ContainSubstring(`unknown validator "does-not-exist"`)))
+            })
+
+            It("should fail when the validator is disabled", func() {
+                vctx.Config.OnlyValidator = "target"
+                vctx.Config.DisabledValidators = []string{"target"}
+
+                executor = validator.NewExecutor(vctx, logger)
+                _, err := executor.ExecuteAll(ctx)
+                Expect(err).To(MatchError(ContainSubstring("not enabled")))
+            })
+        })
+
+        Context("when the root context is cancelled", func() {
+            BeforeEach(func() {
+                validator.Register(&MockValidator{
+                    name: "waits-for-cancel",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        <-ctx.Done()
+                        return &validator.Result{
+                            Status:  validator.StatusFailure,
+                            Reason:  "APICheckFailed",
+                            Message: ctx.Err().Error(),
+                        }
+                    },
+                })
+            })
+
+            It("should report a signal as CancelledBySignal", func() {
+                sigCtx, cancel := context.WithCancelCause(ctx)
+                cancel(fmt.Errorf("%w: terminated", validator.ErrCancelledBySignal))
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(sigCtx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Reason).To(Equal(validator.ReasonCancelledBySignal))
+                Expect(results[0].Details).To(HaveKeyWithValue("original_reason", "APICheckFailed"))
+                Expect(results[0].Details).To(HaveKeyWithValue("cancel_cause", ContainSubstring("terminated")))
+            })
+
+            It("should report the overall deadline as Timeout", func() {
+                timeoutCtx, cancel := context.WithTimeoutCause(ctx, 10*time.Millisecond, validator.ErrValidationTimeout)
+                defer cancel()
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(timeoutCtx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Reason).To(Equal(validator.ReasonTimeout))
+            })
+
+            It("should leave failures alone while the context is live", func() {
+                validator.ClearRegistry()
+                validator.Register(&MockValidator{
+                    name: "fails",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        return &validator.Result{Status: validator.StatusFailure, Reason: "Broken"}
+                    },
+                })
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Reason).To(Equal("Broken"))
+                Expect(results[0].Details).NotTo(HaveKey("original_reason"))
+            })
+        })
+
+        Context("with per-validator timeouts", func() {
+            // remaining reports how long the validator was given, or 0 without a deadline
+            remaining := func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                left := time.Duration(0)
+                if deadline, ok := ctx.Deadline(); ok {
+                    left = time.Until(deadline)
+                }
+                return &validator.Result{
+                    Status:  validator.StatusSuccess,
+                    Reason:  "Done",
+                    Details: map[string]interface{}{"remaining": left},
+                }
+            }
+
+            It("should fail a validator that outlives its DefaultTimeout with Timeout", func() {
+                validator.Register(&timedValidator{MockValidator{
+                    name: "slow",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        <-ctx.Done()
+                        return &validator.Result{Status: validator.StatusFailure, Reason: "APICheckFailed"}
+                    },
+                }, 10 * time.Millisecond})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Reason).To(Equal(validator.ReasonTimeout))
+                Expect(results[0].Details).To(HaveKeyWithValue("original_reason", "APICheckFailed"))
+                Expect(results[0].Details).To(HaveKeyWithValue("cancel_cause", ContainSubstring("slow exceeded its 10ms timeout")))
+            })
+
+            It("should let VALIDATOR_<NAME>_TIMEOUT_SECONDS override DefaultTimeout", func() {
+                GinkgoT().Setenv("VALIDATOR_SLOW_TIMEOUT_SECONDS", "5")
+                validator.Register(&timedValidator{MockValidator{name: "slow", validateFunc: remaining}, time.Hour})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Details["remaining"]).To(BeNumerically("~", 5*time.Second, time.Second))
+            })
+
+            It("should fall back to VALIDATOR_TIMEOUT_SECONDS without a DefaultTimeout", func() {
+                vctx.Config.ValidatorTimeoutSeconds = 5
+                validator.Register(&MockValidator{name: "plain", validateFunc: remaining})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Details["remaining"]).To(BeNumerically("~", 5*time.Second, time.Second))
+            })
+
+            It("should prefer DefaultTimeout over VALIDATOR_TIMEOUT_SECONDS", func() {
+                vctx.Config.ValidatorTimeoutSeconds = 5
+                validator.Register(&timedValidator{MockValidator{name: "slow", validateFunc: remaining}, time.Hour})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Details["remaining"]).To(BeNumerically(">", 5*time.Minute))
+            })
+
+            It("should add no deadline when nothing is configured", func() {
+                validator.Register(&MockValidator{name: "plain", validateFunc: remaining})
+
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results[0].Details).To(HaveKeyWithValue("remaining", time.Duration(0)))
+            })
+        })
+
+        Context("with StopOnFirstFailure enabled", func() {
+            BeforeEach(func() {
+                vctx.Config.StopOnFirstFailure = true
+
+                // First validator fails
+                validator.Register(&MockValidator{
+                    name:    "failing-validator",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        return &validator.Result{
+                            ValidatorName: "failing-validator",
+                            Status:        validator.StatusFailure,
+                            Reason:        "TestFailure",
+                            Message:       "Intentional failure",
+                        }
+                    },
+                })
+
+                // Second validator should not run
+                validator.Register(&MockValidator{
+                    name:     "should-not-run",
+                    runAfter: []string{"failing-validator"},
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        Fail("This validator should not execute")
+                        return nil
+                    },
+                })
+            })
+
+            It("should stop execution after first failure", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].Status).To(Equal(validator.StatusFailure))
+            })
+        })
+
+        Context("with StopLevelOnFailure enabled", func() {
+            var panics bool
+
+            BeforeEach(func() {
+                vctx.Config.StopLevelOnFailure = true
+                panics = false
+
+                validator.Register(&MockValidator{
+                    name: "failing-validator",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        if panics {
+                            panic("boom")
+                        }
+                        return &validator.Result{Status: validator.StatusFailure, Reason: "TestFailure"}
+                    },
+                })
+
+                // Runs in the same level and blocks until its context is cancelled
+                validator.Register(&MockValidator{
+                    name: "slow-validator",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        select {
+                        case <-ctx.Done():
+                            return &validator.Result{Status: validator.StatusFailure, Reason: "Aborted"}
+                        case <-time.After(10 * time.Second):
+                            return &validator.Result{Status: validator.StatusSuccess, Reason: "TestSuccess"}
+                        }
+                    },
+                })
+
+                validator.Register(&MockValidator{
+                    name:     "should-not-run",
+                    runAfter: []string{"failing-validator"},
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        Fail("This validator should not execute")
+                        return nil
+                    },
+                })
+            })
+
+            It("should cancel the rest of the level and stop", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                start := time.Now()
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+                Expect(results).To(HaveLen(2))
+
+                byName := map[string]*validator.Result{}
+                for _, r := range results {
+                    byName[r.ValidatorName] = r
+                }
+                Expect(byName["failing-validator"].Reason).To(Equal("TestFailure"))
+                Expect(byName["slow-validator"].Reason).To(Equal(validator.ReasonLevelStopped))
+                Expect(byName["slow-validator"].Details).To(HaveKeyWithValue("original_reason", "Aborted"))
+            })
+
+            It("should also cancel the rest of the level when a validator panics", func() {
+                panics = true
+
+                executor = validator.NewExecutor(vctx, logger)
+                start := time.Now()
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+
+                byName := map[string]*validator.Result{}
+                for _, r := range results {
+                    byName[r.ValidatorName] = r
+                }
+                Expect(byName["failing-validator"].Reason).To(Equal(validator.ReasonValidatorPanic))
+                Expect(byName["slow-validator"].Reason).To(Equal(validator.ReasonLevelStopped))
+            })
+        })
+
+        Context("with cacheable validator", func() {
+            var calls int
+            var status validator.Status
+
+            BeforeEach(func() {
+                calls = 0
+                status = validator.StatusSuccess
+                validator.Register(&MockValidator{
+                    name:      "cacheable-validator",
+                    cacheable: true,
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        calls++
+                        return &validator.Result{
+                            ValidatorName: "cacheable-validator",
+                            Status:        status,
+                            Reason:        "TestResult",
+                            Details:       map[string]interface{}{"parent": "folders/123"},
+                        }
+                    },
+                })
+            })
+
+            It("should not cache without a result cache", func() {
+                for i := 0; i < 2; i++ {
+                    _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                    Expect(err).NotTo(HaveOccurred())
+                }
+                Expect(calls).To(Equal(2))
+            })
+
+            It("should serve the next run for the same project from the cache", func() {
+                cache := validator.NewResultCache()
+                vctx.SetResultCache(cache)
+                first, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(first[0].Details).NotTo(HaveKey("cached"))
+
+                next := validator.NewContext(vctx.Config, logger)
+                next.SetResultCache(cache)
+                results, err := validator.NewExecutor(next, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(calls).To(Equal(1))
+                Expect(results[0].Reason).To(Equal("TestResult"))
+                Expect(results[0].Details).To(HaveKeyWithValue("cached", true))
+                Expect(results[0].Details).To(HaveKeyWithValue("parent", "folders/123"))
+                Expect(results[0].Details).To(HaveKey("cached_at"))
+                Expect(next.Results).To(HaveKey("cacheable-validator"))
+            })
+
+            It("should not reuse the result for another project", func() {
+                cache := validator.NewResultCache()
+                vctx.SetResultCache(cache)
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                other := *vctx.Config
+                other.ProjectID = "other-project"
+                next := validator.NewContext(&other, logger)
+                next.SetResultCache(cache)
+                _, err = validator.NewExecutor(next, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(calls).To(Equal(2))
+            })
+
+            It("should not reuse the result for other settings of a CacheKeyer", func() {
+                validator.ClearRegistry()
+                validator.Register(&cacheKeyedValidator{MockValidator{
+                    name:      "cacheable-validator",
+                    cacheable: true,
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        calls++
+                        return &validator.Result{Status: validator.StatusSuccess, Reason: "ParentMatches"}
+                    },
+                }})
+                cache := validator.NewResultCache()
+                vctx.Config.ExpectedParent = "folders/1"
+                vctx.SetResultCache(cache)
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                other := *vctx.Config
+                other.ExpectedParent = "folders/2"
+                next := validator.NewContext(&other, logger)
+                next.SetResultCache(cache)
+                _, err = validator.NewExecutor(next, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(calls).To(Equal(2))
+
+                same := validator.NewContext(vctx.Config, logger)
+                same.SetResultCache(cache)
+                _, err = validator.NewExecutor(same, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(calls).To(Equal(2))
+            })
+
+            It("should not cache failures", func() {
+                status = validator.StatusFailure
+                cache := validator.NewResultCache()
+                vctx.SetResultCache(cache)
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+
+                _, cached := cache.Get("cacheable-validator", "test-project", "")
+                Expect(cached).To(BeFalse())
+            })
+        })
+
+        Context("with a RequireSuccess prerequisite", func() {
+            var prerequisiteStatus validator.Status
+            var dependentCalls int
+
+            BeforeEach(func() {
+                prerequisiteStatus = validator.StatusSuccess
+                dependentCalls = 0
+                validator.Register(&MockValidator{
+                    name: "prerequisite",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        return &validator.Result{Status: prerequisiteStatus, Reason: "PrerequisiteResult"}
+                    },
+                })
+                validator.Register(&MockValidator{
+                    name:           "dependent",
+                    requireSuccess: []string{"prerequisite"},
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        dependentCalls++
+                        return &validator.Result{Status: validator.StatusSuccess, Reason: "TestSuccess"}
+                    },
+                })
+            })
+
+            It("should run the validator after its prerequisite passed", func() {
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(dependentCalls).To(Equal(1))
+                Expect(vctx.Results["dependent"].Status).To(Equal(validator.StatusSuccess))
+            })
+
+            It("should treat a warning as passed", func() {
+                prerequisiteStatus = validator.StatusWarning
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(dependentCalls).To(Equal(1))
+            })
+
+            It("should skip the validator without running it when the prerequisite failed", func() {
+                prerequisiteStatus = validator.StatusFailure
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(dependentCalls).To(BeZero())
+
+                result := vctx.Results["dependent"]
+                Expect(result.ValidatorName).To(Equal("dependent"))
+                Expect(result.Status).To(Equal(validator.StatusSkipped))
+                Expect(result.Reason).To(Equal(validator.ReasonPrerequisiteFailed))
+                Expect(result.Details["failed_prerequisites"]).To(HaveKeyWithValue("prerequisite", "failure (PrerequisiteResult)"))
+            })
+
+            It("should skip the validator when the prerequisite is disabled", func() {
+                vctx.Config.DisabledValidators = []string{"prerequisite"}
+                _, err := validator.NewExecutor(vctx, logger).ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(dependentCalls).To(BeZero())
+                Expect(vctx.Results["dependent"].Details["failed_prerequisites"]).To(HaveKeyWithValue("prerequisite", "not run"))
+            })
+        })
+
+        Context("with validator that returns failure", func() {
+            BeforeEach(func() {
+                validator.Register(&MockValidator{
+                    name:    "failing-validator",
+                    validateFunc: func(ctx context.Context, vctx *validator.Context) *validator.Result {
+                        return &validator.Result{
+                            ValidatorName: "failing-validator",
+                            Status:        validator.StatusFailure,
+                            Reason:        "ValidationFailed",
+                            Message:       "Validation check failed",
+                            Details: map[string]interface{}{
+                                "error": "Test error",
+                            },
+                        }
+                    },
+                })
+            })
+
+            It("should return the failure result", func() {
+                executor = validator.NewExecutor(vctx, logger)
+                results, err := executor.ExecuteAll(ctx)
+                Expect(err).NotTo(HaveOccurred())
+                Expect(results).To(HaveLen(1))
+                Expect(results[0].Status).To(Equal(validator.StatusFailure))
+                Expect(results[0].Reason).To(Equal("ValidationFailed"))
+            })
+        })
+    })
+
+    Describe("DescribePlan", func() {
+        It("should list each level with config-aware descriptions, falling back to Metadata", func() {
+            apis := &apiRequiringValidator{
+                MockValidator: MockValidator{name: "api-enabled", description: "Verify APIs"},
+                apis:          []string{"compute.googleapis.com"},
+            }
+            quota := &describedValidator{
+                MockValidator: MockValidator{name: "quota-check", description: "Verify quota", runAfter: []string{"api-enabled"}},
+            }
+            groups, err := validator.NewDependencyResolver([]validator.Validator{apis, quota}).ResolveExecutionGroups()
+            Expect(err).NotTo(HaveOccurred())
+
+            Expect(validator.DescribePlan(vctx, groups)).To(Equal(
+                "Level 0:\n" +
+                    "  api-enabled: Verify APIs\n" +
+                    "Level 1:\n" +
+                    "  quota-check: will check project test-project\n"))
+            Expect(vctx.PlannedAPIs()).To(HaveKey("compute.googleapis.com"))
+        })
+
+        It("should fall back to Metadata when Describe returns nothing", func() {
+            quota := &describedValidator{MockValidator: MockValidator{name: "quota-check", description: "Verify quota"}}
+            vctx.Config.ProjectID = ""
+
+            Expect(validator.DescribeValidator(quota, vctx)).To(Equal("Verify quota"))
+        })
+    })
+})
+
+// stepClock is a validator.Clock that advances by step on every reading
+type stepClock struct {
+    mu   sync.Mutex
+    now  time.Time
+    step time.Duration
+}
+
+func (c *stepClock) Now() time.Time {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+    now := c.now
+    c.now = c.now.Add(c.step)
+    return now
+}
+
+// syncBuffer is a goroutine-safe bytes.Buffer for capturing log output
+type syncBuffer struct {
+    mu  sync.Mutex
+    buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return b.buf.String()
+}
+
+// conditionalValidator is a MockValidator that is only enabled when a config key is set
+type conditionalValidator struct {
+    MockValidator
+    key string
+}
+
+func (c *conditionalValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig(c.key)
+}
+
+// versionedValidator is a MockValidator that declares a Validator contract version
+type versionedValidator struct {
+    MockValidator
+    version int
+}
+
+func (v *versionedValidator) Version() int {
+    return v.version
+}
+
+// apiRequiringValidator is a MockValidator that declares the APIs it calls
+type apiRequiringValidator struct {
+    MockValidator
+    apis []string
+}
+
+func (v *apiRequiringValidator) RequiredAPIs() []string {
+    return v.apis
+}
+
+// describedValidator is a MockValidator that describes itself from the project in the config
+type describedValidator struct {
+    MockValidator
+}
+
+func (v *describedValidator) Describe(vctx *validator.Context) string {
+    if vctx.Config.ProjectID == "" {
+        return ""
+    }
+    return "will check project " + vctx.Config.ProjectID
+}
+
+// experimentalValidator is a MockValidator marked Experimental
+type experimentalValidator struct {
+    MockValidator
+}
+
+func (v *experimentalValidator) Metadata() validator.ValidatorMetadata {
+    meta := v.MockValidator.Metadata()
+    meta.Experimental = true
+    return meta
+}
+
+// cacheKeyedValidator is a MockValidator whose cached result depends on EXPECTED_PARENT
+type cacheKeyedValidator struct {
+    MockValidator
+}
+
+func (v *cacheKeyedValidator) CacheKey(vctx *validator.Context) string {
+    return vctx.Config.ExpectedParent
+}
+
+// weightedValidator is a MockValidator with a readiness score Weight
+type weightedValidator struct {
+    MockValidator
+    weight int
+}
+
+func (v *weightedValidator) Metadata() validator.ValidatorMetadata {
+    meta := v.MockValidator.Metadata()
+    meta.Weight = v.weight
+    return meta
+}
+
+// timedValidator is a MockValidator that declares a DefaultTimeout
+type timedValidator struct {
+    MockValidator
+    timeout time.Duration
+}
+
+func (v *timedValidator) Metadata() validator.ValidatorMetadata {
+    meta := v.MockValidator.Metadata()
+    meta.DefaultTimeout = v.timeout
+    return meta
+}
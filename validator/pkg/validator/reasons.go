@@ -0,0 +1,437 @@
+package validator
+
+import (
+    "strconv"
+    "strings"
+)
+
+// Failure categories returned by ReasonCategory, stable values consumers can route alerts on
+const (
+    CategoryAuth      = "auth"      // Credentials or permissions are missing
+    CategoryConfig    = "config"    // The project or the validator inputs need changing
+    CategoryQuota     = "quota"     // Quota or capacity is insufficient
+    CategoryNetwork   = "network"   // VPC setup or connectivity is wrong
+    CategoryTransient = "transient" // Retrying later may succeed
+    CategoryUnknown   = "unknown"   // Reason is outside the taxonomy
+)
+
+// Aggregate result reasons
+const (
+    ReasonValidationPassed = "ValidationPassed"
+    ReasonValidationFailed = "ValidationFailed"
+)
+
+// Executor result reasons
+const (
+    ReasonValidatorPanic = "ValidatorPanic"
+    ReasonNilResult      = "NilResult"
+    // A RequireSuccess predecessor failed, was skipped or did not run
+    ReasonPrerequisiteFailed = "PrerequisiteFailed"
+)
+
+// Reasons shared by validators: client creation and lookup failures, used as the fallback
+// when an error carries no GCP reason
+const (
+    ReasonComputeClientError           = "ComputeClientError"
+    ReasonIAMClientError               = "IAMClientError"
+    ReasonResourceManagerClientError   = "ResourceManagerClientError"
+    ReasonServiceUsageClientError      = "ServiceUsageClientError"
+    ReasonStorageClientError           = "StorageClientError"
+    ReasonFilestoreClientError         = "FilestoreClientError"
+    ReasonKMSClientError               = "KMSClientError"
+    ReasonTagsClientError              = "TagsClientError"
+    ReasonServiceNetworkingClientError = "ServiceNetworkingClientError"
+    ReasonDNSClientError               = "DNSClientError"
+    ReasonLoggingClientError           = "LoggingClientError"
+    ReasonProjectLookupFailed          = "ProjectLookupFailed"
+    ReasonProjectNumberLookupFailed    = "ProjectNumberLookupFailed"
+    ReasonIAMPolicyLookupFailed        = "IAMPolicyLookupFailed"
+    ReasonRegionNotConfigured          = "RegionNotConfigured"
+)
+
+// project-match-check reasons
+const (
+    ReasonCredentialLookupFailed   = "CredentialLookupFailed"
+    ReasonProjectMismatch          = "ProjectMismatch"
+    ReasonCredentialProjectUnknown = "CredentialProjectUnknown"
+    ReasonProjectMatches           = "ProjectMatches"
+)
+
+// metadata-server-check reasons
+const (
+    ReasonMetadataServerUnreachable = "MetadataServerUnreachable"
+    ReasonMetadataProjectMismatch   = "MetadataProjectMismatch"
+    ReasonMetadataProjectMatches    = "MetadataProjectMatches"
+)
+
+// api-enabled reasons
+const (
+    ReasonAPICheckFailed         = "APICheckFailed"
+    ReasonNoAPIsConfigured       = "NoAPIsConfigured"
+    ReasonRequiredAPIsDisabled   = "RequiredAPIsDisabled"
+    ReasonAllAPIsEnabled         = "AllAPIsEnabled"
+    ReasonAPIEnabled             = "APIEnabled"
+    ReasonAPIDisabled            = "APIDisabled"
+    ReasonAPIStateAccepted       = "APIStateAccepted"
+    ReasonAPIPrerequisiteMissing = "APIPrerequisiteMissing"
+)
+
+// api-propagation reasons
+const (
+    ReasonAPIProbeFailed              = "APIProbeFailed"
+    ReasonAPIPropagationPending       = "APIPropagationPending"
+    ReasonAPIPropagationCheckDisabled = "APIPropagationCheckDisabled"
+    ReasonAPIsPropagated              = "APIsPropagated"
+    ReasonAPIPropagated               = "APIPropagated"
+)
+
+// api-baseline-check reasons
+const (
+    ReasonAPIBaselineCheckFailed = "APIBaselineCheckFailed"
+    ReasonUnexpectedAPIEnabled   = "UnexpectedAPIEnabled"
+    ReasonAPIBaselineMatched     = "APIBaselineMatched"
+)
+
+// api-quota reasons
+const (
+    ReasonAPIQuotaCheckFailed   = "APIQuotaCheckFailed"
+    ReasonAPIQuotaZero          = "APIQuotaZero"
+    ReasonAPIQuotaCheckDisabled = "APIQuotaCheckDisabled"
+    ReasonAPIQuotasAvailable    = "APIQuotasAvailable"
+    ReasonAPIQuotaAvailable     = "APIQuotaAvailable"
+)
+
+// project-state and org-hierarchy-check reasons
+const (
+    ReasonProjectNotActive            = "ProjectNotActive"
+    ReasonProjectActive               = "ProjectActive"
+    ReasonExpectedParentNotConfigured = "ExpectedParentNotConfigured"
+    ReasonWrongParent                 = "WrongParent"
+    ReasonParentMatches               = "ParentMatches"
+)
+
+// compute-sa-enabled and service-agent-check reasons
+const (
+    ReasonComputeSACheckFailed     = "ComputeSACheckFailed"
+    ReasonComputeSANotFound        = "ComputeSANotFound"
+    ReasonComputeSADisabled        = "ComputeSADisabled"
+    ReasonComputeSAEnabled         = "ComputeSAEnabled"
+    ReasonServiceAgentMissingRole  = "ServiceAgentMissingRole"
+    ReasonServiceAgentRolesGranted = "ServiceAgentRolesGranted"
+    ReasonServiceAgentsConfigured  = "ServiceAgentsConfigured"
+)
+
+// audit-logging-check reasons
+const (
+    ReasonAuditLoggingNotConfigured = "AuditLoggingNotConfigured"
+    ReasonAuditLoggingConfigured    = "AuditLoggingConfigured"
+)
+
+// log-sink-check reasons
+const (
+    ReasonLogSinkCheckFailed      = "LogSinkCheckFailed"
+    ReasonLogSinkMissing          = "LogSinkMissing"
+    ReasonLogSinkWrongDestination = "LogSinkWrongDestination"
+    ReasonLogSinkDisabled         = "LogSinkDisabled"
+    ReasonLogSinkValid            = "LogSinkValid"
+)
+
+// region-check, quota-check, accelerator-check and ip-address-check reasons
+const (
+    ReasonRegionCheckFailed            = "RegionCheckFailed"
+    ReasonInvalidRegion                = "InvalidRegion"
+    ReasonRegionDown                   = "RegionDown"
+    ReasonZoneDown                     = "ZoneDown"
+    ReasonRegionUp                     = "RegionUp"
+    ReasonZoneUp                       = "ZoneUp"
+    ReasonQuotaCheckFailed             = "QuotaCheckFailed"
+    ReasonInsufficientQuota            = "InsufficientQuota"
+    ReasonNoQuotaRequirements          = "NoQuotaRequirements"
+    ReasonQuotaSufficient              = "QuotaSufficient"
+    ReasonMIGCapacityCheckFailed       = "MIGCapacityCheckFailed"
+    ReasonMIGCapacityTight             = "MIGCapacityTight"
+    ReasonMIGCapacitySufficient        = "MIGCapacitySufficient"
+    ReasonAcceleratorCheckFailed       = "AcceleratorCheckFailed"
+    ReasonAcceleratorUnavailable       = "AcceleratorUnavailable"
+    ReasonInsufficientAcceleratorQuota = "InsufficientAcceleratorQuota"
+    ReasonAcceleratorAvailable         = "AcceleratorAvailable"
+    ReasonIPAddressCheckFailed         = "IPAddressCheckFailed"
+    ReasonAddressNotAvailable          = "AddressNotAvailable"
+    ReasonAddressesAvailable           = "AddressesAvailable"
+)
+
+// ssl-cert-check, bucket-iam-check, bucket-policy-check, conflict-check and filestore-check reasons
+const (
+    ReasonSSLCertCheckFailed              = "SSLCertCheckFailed"
+    ReasonSSLCertNotConfigured            = "SSLCertNotConfigured"
+    ReasonSSLCertNotFound                 = "SSLCertNotFound"
+    ReasonSSLCertExpired                  = "SSLCertExpired"
+    ReasonSSLCertValid                    = "SSLCertValid"
+    ReasonBucketIAMCheckFailed            = "BucketIAMCheckFailed"
+    ReasonBucketIAMPrincipalNotConfigured = "BucketIAMPrincipalNotConfigured"
+    ReasonBucketNotFound                  = "BucketNotFound"
+    ReasonBucketIAMInsufficient           = "BucketIAMInsufficient"
+    ReasonBucketIAMSufficient             = "BucketIAMSufficient"
+    ReasonBucketPolicyCheckFailed         = "BucketPolicyCheckFailed"
+    ReasonBucketPolicyNoncompliant        = "BucketPolicyNoncompliant"
+    ReasonBucketPolicyCompliant           = "BucketPolicyCompliant"
+    ReasonConflictCheckFailed             = "ConflictCheckFailed"
+    ReasonExistingResourcesFound          = "ExistingResourcesFound"
+    ReasonNoConflictingResources          = "NoConflictingResources"
+    ReasonFilestoreCheckFailed            = "FilestoreCheckFailed"
+    ReasonFilestoreNotFound               = "FilestoreNotFound"
+    ReasonFilestoreNotReady               = "FilestoreNotReady"
+    ReasonFilestoreReady                  = "FilestoreReady"
+)
+
+// instance-template-check reasons
+const (
+    ReasonInstanceTemplateCheckFailed = "InstanceTemplateCheckFailed"
+    ReasonInstanceTemplateNotFound    = "InstanceTemplateNotFound"
+    ReasonInstanceTemplateInvalid     = "InstanceTemplateInvalid"
+    ReasonInstanceTemplateValid       = "InstanceTemplateValid"
+)
+
+// image-check reasons
+const (
+    ReasonImageCheckFailed = "ImageCheckFailed"
+    ReasonImageNotFound    = "ImageNotFound"
+    ReasonImageDeprecated  = "ImageDeprecated"
+    ReasonImageAvailable   = "ImageAvailable"
+)
+
+// impersonation-check reasons
+const (
+    ReasonImpersonationCheckFailed    = "ImpersonationCheckFailed"
+    ReasonImpersonationDenied         = "ImpersonationDenied"
+    ReasonImpersonationTargetNotFound = "ImpersonationTargetNotFound"
+    ReasonImpersonationAllowed        = "ImpersonationAllowed"
+)
+
+// shielded-vm-check reasons
+const (
+    ReasonShieldedVMCheckFailed  = "ShieldedVMCheckFailed"
+    ReasonShieldedVMIncompatible = "ShieldedVMIncompatible"
+    ReasonShieldedVMNotRequired  = "ShieldedVMNotRequired"
+    ReasonShieldedVMCompatible   = "ShieldedVMCompatible"
+)
+
+// kms-key-check reasons
+const (
+    ReasonKMSKeyCheckFailed = "KMSKeyCheckFailed"
+    ReasonKMSKeyNotFound    = "KMSKeyNotFound"
+    ReasonKMSKeyDisabled    = "KMSKeyDisabled"
+    ReasonKMSKeyIAMMissing  = "KMSKeyIAMMissing"
+    ReasonKMSKeyReady       = "KMSKeyReady"
+)
+
+// resource-tags-check reasons
+const (
+    ReasonTagBindingCheckFailed = "TagBindingCheckFailed"
+    ReasonMissingTagBinding     = "MissingTagBinding"
+    ReasonTagBindingsPresent    = "TagBindingsPresent"
+)
+
+// iam-deny-check reasons
+const (
+    ReasonIAMDenyCheckFailed = "IAMDenyCheckFailed"
+    ReasonPotentialIAMDeny   = "PotentialIAMDeny"
+    ReasonNoIAMDeny          = "NoIAMDeny"
+)
+
+// Network validator reasons
+const (
+    ReasonConnectivityFailed              = "ConnectivityFailed"
+    ReasonConnectivityOK                  = "ConnectivityOK"
+    ReasonHybridConnectivityCheckFailed   = "HybridConnectivityCheckFailed"
+    ReasonNoHybridConnectivity            = "NoHybridConnectivity"
+    ReasonHybridConnectivityAvailable     = "HybridConnectivityAvailable"
+    ReasonRouterBGPCheckFailed            = "RouterBGPCheckFailed"
+    ReasonRouterNotFound                  = "RouterNotFound"
+    ReasonRouterASNMismatch               = "RouterASNMismatch"
+    ReasonBGPSessionDown                  = "BGPSessionDown"
+    ReasonBGPSessionsEstablished          = "BGPSessionsEstablished"
+    ReasonMTUCheckFailed                  = "MTUCheckFailed"
+    ReasonNetworkNotFound                 = "NetworkNotFound"
+    ReasonMTUMismatch                     = "MTUMismatch"
+    ReasonMTUSufficient                   = "MTUSufficient"
+    ReasonRestrictedVIPCheckFailed        = "RestrictedVIPCheckFailed"
+    ReasonMissingRestrictedVIPRoute       = "MissingRestrictedVIPRoute"
+    ReasonRestrictedVIPRouteFound         = "RestrictedVIPRouteFound"
+    ReasonPrivateServiceAccessCheckFailed = "PrivateServiceAccessCheckFailed"
+    ReasonNoPrivateServiceAccess          = "NoPrivateServiceAccess"
+    ReasonPrivateServiceAccessConfigured  = "PrivateServiceAccessConfigured"
+    ReasonCIDROverlapCheckFailed          = "CIDROverlapCheckFailed"
+    ReasonCIDROverlap                     = "CIDROverlap"
+    ReasonNoCIDROverlap                   = "NoCIDROverlap"
+    ReasonFirewallCheckFailed             = "FirewallCheckFailed"
+    ReasonTrafficBlocked                  = "TrafficBlocked"
+    ReasonTrafficAllowed                  = "TrafficAllowed"
+    ReasonDNSPolicyCheckFailed            = "DNSPolicyCheckFailed"
+    ReasonDNSPolicyNotFound               = "DNSPolicyNotFound"
+    ReasonDNSPolicyMisconfigured          = "DNSPolicyMisconfigured"
+    ReasonDNSPolicyValid                  = "DNSPolicyValid"
+)
+
+// reasonCategories maps the failure and warning reasons validators report to their category
+// Besides the constants above it covers the GCP error reasons that extractErrorReason passes through
+// Success reasons are deliberately absent and fall into CategoryUnknown
+var reasonCategories = map[string]string{
+    // Credentials and permissions
+    ReasonComputeClientError:           CategoryAuth,
+    ReasonIAMClientError:               CategoryAuth,
+    ReasonResourceManagerClientError:   CategoryAuth,
+    ReasonServiceUsageClientError:      CategoryAuth,
+    ReasonStorageClientError:           CategoryAuth,
+    ReasonFilestoreClientError:         CategoryAuth,
+    ReasonKMSClientError:               CategoryAuth,
+    ReasonTagsClientError:              CategoryAuth,
+    ReasonServiceNetworkingClientError: CategoryAuth,
+    ReasonDNSClientError:               CategoryAuth,
+    ReasonLoggingClientError:           CategoryAuth,
+    ReasonKMSKeyIAMMissing:             CategoryAuth,
+    ReasonPotentialIAMDeny:             CategoryAuth,
+    ReasonImpersonationDenied:          CategoryAuth,
+    ReasonCredentialLookupFailed:       CategoryAuth,
+    ReasonServiceAgentMissingRole:      CategoryAuth,
+    ReasonBucketIAMInsufficient:        CategoryAuth,
+    "forbidden":                        CategoryAuth,
+    "authError":                        CategoryAuth,
+    "insufficientPermissions":          CategoryAuth,
+    "IAM_PERMISSION_DENIED":            CategoryAuth,
+    "PERMISSION_DENIED":                CategoryAuth,
+    "UNAUTHENTICATED":                  CategoryAuth,
+
+    // Project setup and validator inputs
+    ReasonRegionNotConfigured:             CategoryConfig,
+    ReasonNoAPIsConfigured:                CategoryConfig,
+    ReasonRequiredAPIsDisabled:            CategoryConfig,
+    ReasonAPIDisabled:                     CategoryConfig,
+    ReasonAPIPrerequisiteMissing:          CategoryConfig,
+    ReasonUnexpectedAPIEnabled:            CategoryConfig,
+    ReasonProjectNotActive:                CategoryConfig,
+    ReasonExpectedParentNotConfigured:     CategoryConfig,
+    ReasonWrongParent:                     CategoryConfig,
+    ReasonComputeSANotFound:               CategoryConfig,
+    ReasonComputeSADisabled:               CategoryConfig,
+    ReasonInvalidRegion:                   CategoryConfig,
+    ReasonSSLCertNotConfigured:            CategoryConfig,
+    ReasonSSLCertNotFound:                 CategoryConfig,
+    ReasonSSLCertExpired:                  CategoryConfig,
+    ReasonBucketIAMPrincipalNotConfigured: CategoryConfig,
+    ReasonBucketNotFound:                  CategoryConfig,
+    ReasonBucketPolicyNoncompliant:        CategoryConfig,
+    ReasonExistingResourcesFound:          CategoryConfig,
+    ReasonAddressNotAvailable:             CategoryConfig,
+    ReasonFilestoreNotFound:               CategoryConfig,
+    ReasonFilestoreNotReady:               CategoryConfig,
+    ReasonAuditLoggingNotConfigured:       CategoryConfig,
+    ReasonLogSinkMissing:                  CategoryConfig,
+    ReasonLogSinkWrongDestination:         CategoryConfig,
+    ReasonLogSinkDisabled:                 CategoryConfig,
+    ReasonProjectMismatch:                 CategoryConfig,
+    ReasonMetadataProjectMismatch:         CategoryConfig,
+    ReasonInstanceTemplateNotFound:        CategoryConfig,
+    ReasonInstanceTemplateInvalid:         CategoryConfig,
+    ReasonImageNotFound:                   CategoryConfig,
+    ReasonImageDeprecated:                 CategoryConfig,
+    ReasonShieldedVMIncompatible:          CategoryConfig,
+    ReasonImpersonationTargetNotFound:     CategoryConfig,
+    ReasonKMSKeyNotFound:                  CategoryConfig,
+    ReasonKMSKeyDisabled:                  CategoryConfig,
+    ReasonMissingTagBinding:               CategoryConfig,
+    "accessNotConfigured":                 CategoryConfig,
+    "SERVICE_DISABLED":                    CategoryConfig,
+    "notFound":                            CategoryConfig,
+    "invalid":                             CategoryConfig,
+
+    // Quota and capacity
+    ReasonInsufficientQuota:            CategoryQuota,
+    ReasonInsufficientAcceleratorQuota: CategoryQuota,
+    ReasonMIGCapacityTight:             CategoryQuota,
+    ReasonAcceleratorUnavailable:       CategoryQuota,
+    ReasonAPIQuotaZero:                 CategoryQuota,
+    "quotaExceeded":                    CategoryQuota,
+
+    // VPC setup and connectivity
+    ReasonConnectivityFailed:        CategoryNetwork,
+    ReasonNoHybridConnectivity:      CategoryNetwork,
+    ReasonRouterNotFound:            CategoryNetwork,
+    ReasonRouterASNMismatch:         CategoryNetwork,
+    ReasonBGPSessionDown:            CategoryNetwork,
+    ReasonNetworkNotFound:           CategoryNetwork,
+    ReasonMTUMismatch:               CategoryNetwork,
+    ReasonMissingRestrictedVIPRoute: CategoryNetwork,
+    ReasonNoPrivateServiceAccess:    CategoryNetwork,
+    ReasonCIDROverlap:               CategoryNetwork,
+    ReasonTrafficBlocked:            CategoryNetwork,
+    ReasonDNSPolicyNotFound:         CategoryNetwork,
+    ReasonDNSPolicyMisconfigured:    CategoryNetwork,
+
+    // Worth retrying: interruptions, outages and lookups that failed without a GCP reason
+    ReasonCancelledBySignal:               CategoryTransient,
+    ReasonTimeout:                         CategoryTransient,
+    ReasonLevelStopped:                    CategoryTransient,
+    ReasonCancelled:                       CategoryTransient,
+    ReasonAPIPropagationPending:           CategoryTransient,
+    ReasonRegionDown:                      CategoryTransient,
+    ReasonZoneDown:                        CategoryTransient,
+    ReasonProjectLookupFailed:             CategoryTransient,
+    ReasonProjectNumberLookupFailed:       CategoryTransient,
+    ReasonIAMPolicyLookupFailed:           CategoryTransient,
+    ReasonAPICheckFailed:                  CategoryTransient,
+    ReasonAPIProbeFailed:                  CategoryTransient,
+    ReasonAPIBaselineCheckFailed:          CategoryTransient,
+    ReasonAPIQuotaCheckFailed:             CategoryTransient,
+    ReasonComputeSACheckFailed:            CategoryTransient,
+    ReasonRegionCheckFailed:               CategoryTransient,
+    ReasonQuotaCheckFailed:                CategoryTransient,
+    ReasonMIGCapacityCheckFailed:          CategoryTransient,
+    ReasonAcceleratorCheckFailed:          CategoryTransient,
+    ReasonIPAddressCheckFailed:            CategoryTransient,
+    ReasonFilestoreCheckFailed:            CategoryTransient,
+    ReasonInstanceTemplateCheckFailed:     CategoryTransient,
+    ReasonImageCheckFailed:                CategoryTransient,
+    ReasonShieldedVMCheckFailed:           CategoryTransient,
+    ReasonPrivateServiceAccessCheckFailed: CategoryTransient,
+    ReasonCIDROverlapCheckFailed:          CategoryTransient,
+    ReasonImpersonationCheckFailed:        CategoryTransient,
+    ReasonKMSKeyCheckFailed:               CategoryTransient,
+    ReasonTagBindingCheckFailed:           CategoryTransient,
+    ReasonIAMDenyCheckFailed:              CategoryTransient,
+    ReasonSSLCertCheckFailed:              CategoryTransient,
+    ReasonBucketIAMCheckFailed:            CategoryTransient,
+    ReasonBucketPolicyCheckFailed:         CategoryTransient,
+    ReasonConflictCheckFailed:             CategoryTransient,
+    ReasonHybridConnectivityCheckFailed:   CategoryTransient,
+    ReasonRouterBGPCheckFailed:            CategoryTransient,
+    ReasonMTUCheckFailed:                  CategoryTransient,
+    ReasonRestrictedVIPCheckFailed:        CategoryTransient,
+    ReasonFirewallCheckFailed:             CategoryTransient,
+    ReasonDNSPolicyCheckFailed:            CategoryTransient,
+    ReasonLogSinkCheckFailed:              CategoryTransient,
+    "rateLimitExceeded":                   CategoryTransient,
+    "userRateLimitExceeded":               CategoryTransient,
+    "backendError":                        CategoryTransient,
+    "internalError":                       CategoryTransient,
+}
+
+// ReasonCategory maps a result reason to one of the Category constants
+// Raw "HTTP_<code>" reasons are categorized by status code; anything else unlisted is CategoryUnknown
+func ReasonCategory(reason string) string {
+    if category, ok := reasonCategories[reason]; ok {
+        return category
+    }
+
+    if code, err := strconv.Atoi(strings.TrimPrefix(reason, "HTTP_")); err == nil && strings.HasPrefix(reason, "HTTP_") {
+        switch {
+        case code == 401 || code == 403:
+            return CategoryAuth
+        case code == 429 || code >= 500:
+            return CategoryTransient
+        case code == 400 || code == 404:
+            return CategoryConfig
+        }
+    }
+    return CategoryUnknown
+}
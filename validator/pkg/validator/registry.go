@@ -42,6 +42,15 @@ func (r *Registry) GetAll() []Validator {
     return validators
 }
 
+// CheckCount returns an error when fewer than expected validators are registered
+// A short registry means init() functions never ran, usually because an import was dropped
+func (r *Registry) CheckCount(expected int) error {
+    if n := len(r.GetAll()); n < expected {
+        return fmt.Errorf("validators not registered - check imports: %d registered, EXPECTED_VALIDATOR_COUNT is %d", n, expected)
+    }
+    return nil
+}
+
 // Get retrieves a validator by name
 func (r *Registry) Get(name string) (Validator, bool) {
     r.mu.RLock()
@@ -70,6 +79,11 @@ func GetAll() []Validator {
     return globalRegistry.GetAll()
 }
 
+// CheckRegistered returns an error when the global registry holds fewer than expected validators
+func CheckRegistered(expected int) error {
+    return globalRegistry.CheckCount(expected)
+}
+
 // Get retrieves a validator by name from global registry
 func Get(name string) (Validator, bool) {
     return globalRegistry.Get(name)
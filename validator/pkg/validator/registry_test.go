@@ -11,19 +11,23 @@ import (
 
 // Mock validator for testing
 type MockValidator struct {
-    name         string
-    description  string
-    runAfter     []string
-    tags         []string
-    validateFunc func(ctx context.Context, vctx *validator.Context) *validator.Result
+    name           string
+    description    string
+    runAfter       []string
+    tags           []string
+    cacheable      bool
+    requireSuccess []string // Predecessors that must pass for the mock to run
+    validateFunc   func(ctx context.Context, vctx *validator.Context) *validator.Result
 }
 
 func (m *MockValidator) Metadata() validator.ValidatorMetadata {
     return validator.ValidatorMetadata{
-        Name:        m.name,
-        Description: m.description,
-        RunAfter:    m.runAfter,
-        Tags:        m.tags,
+        Name:           m.name,
+        Description:    m.description,
+        RunAfter:       m.runAfter,
+        Tags:           m.tags,
+        Cacheable:      m.cacheable,
+        RequireSuccess: m.requireSuccess,
     }
 }
 
@@ -114,6 +118,24 @@ var _ = Describe("Registry", func() {
         })
     })
 
+    Describe("CheckCount", func() {
+        BeforeEach(func() {
+            testRegistry.Register(mockValidator1)
+            testRegistry.Register(mockValidator2)
+        })
+
+        It("should pass when at least the expected count is registered", func() {
+            Expect(testRegistry.CheckCount(0)).To(Succeed())
+            Expect(testRegistry.CheckCount(2)).To(Succeed())
+        })
+
+        It("should fail with a wiring hint when validators are missing", func() {
+            err := testRegistry.CheckCount(3)
+            Expect(err).To(MatchError(ContainSubstring("validators not registered - check imports")))
+            Expect(err).To(MatchError(ContainSubstring("2 registered")))
+        })
+    })
+
     Describe("Get", func() {
         BeforeEach(func() {
             testRegistry.Register(mockValidator1)
@@ -1,6 +1,7 @@
 package validator
 
 import (
+    "encoding/json"
     "fmt"
     "sort"
 )
@@ -65,6 +66,41 @@ func (r *DependencyResolver) ResolveExecutionGroups() ([]ExecutionGroup, error)
     return groups, nil
 }
 
+// SelectWithDependencies returns the named validator plus its transitive RunAfter and RequireSuccess dependencies
+// Dependencies absent from validators are ignored, matching how levels are assigned
+func SelectWithDependencies(validators []Validator, name string) ([]Validator, error) {
+    byName := make(map[string]Validator, len(validators))
+    for _, v := range validators {
+        byName[v.Metadata().Name] = v
+    }
+    if _, ok := byName[name]; !ok {
+        return nil, fmt.Errorf("validator %q is not enabled (disabled or missing required configuration)", name)
+    }
+
+    selected := make(map[string]bool)
+    var visit func(n string)
+    visit = func(n string) {
+        v, ok := byName[n]
+        if !ok || selected[n] {
+            return
+        }
+        selected[n] = true
+        for _, dep := range v.Metadata().Dependencies() {
+            visit(dep)
+        }
+    }
+    visit(name)
+
+    // Preserve the input order so the subset is as deterministic as the full plan
+    subset := make([]Validator, 0, len(selected))
+    for _, v := range validators {
+        if selected[v.Metadata().Name] {
+            subset = append(subset, v)
+        }
+    }
+    return subset, nil
+}
+
 // assignLevels performs topological sort and assigns execution levels
 func (r *DependencyResolver) assignLevels() map[string]int {
     levels := make(map[string]int)
@@ -81,7 +117,7 @@ func (r *DependencyResolver) assignLevels() map[string]int {
 
         maxDepLevel := -1
         // Check dependencies from metadata
-        for _, dep := range meta.RunAfter {
+        for _, dep := range meta.Dependencies() {
             if depValidator, exists := r.validators[dep]; exists {
                 depLevel := calcLevel(depValidator.Metadata().Name)
                 if depLevel > maxDepLevel {
@@ -117,7 +153,7 @@ func (r *DependencyResolver) detectCycles() error {
         meta := v.Metadata()
 
         // Check all dependencies from metadata
-        for _, dep := range meta.RunAfter {
+        for _, dep := range meta.Dependencies() {
             // Skip dependencies that don't exist (will be ignored in level assignment)
             if _, exists := r.validators[dep]; !exists {
                 continue
@@ -148,7 +184,7 @@ func (r *DependencyResolver) detectCycles() error {
 }
 
 // ToMermaid generates a Mermaid flowchart showing raw dependency relationships
-// This visualization shows which validators depend on others based on their RunAfter declarations
+// This visualization shows which validators depend on others based on their RunAfter and RequireSuccess declarations
 func (r *DependencyResolver) ToMermaid() string {
     var result string
     result += "flowchart TD\n"
@@ -165,7 +201,7 @@ func (r *DependencyResolver) ToMermaid() string {
     // Add edges for all dependencies
     for name, v := range r.validators {
         meta := v.Metadata()
-        for _, dep := range meta.RunAfter {
+        for _, dep := range meta.Dependencies() {
             // Only show edge if dependency exists in our validator set
             if _, exists := r.validators[dep]; exists {
                 result += fmt.Sprintf("    %s --> %s\n", name, dep)
@@ -205,15 +241,116 @@ func (r *DependencyResolver) ToMermaidWithLevels(groups []ExecutionGroup) string
         result += "    end\n\n"
     }
 
-    // Add dependency edges
-    for _, v := range r.validators {
-        meta := v.Metadata()
-        for _, dep := range meta.RunAfter {
-            if _, exists := r.validators[dep]; exists {
-                result += fmt.Sprintf("    %s --> %s\n", meta.Name, dep)
+    // Add dependency edges in plan order so the output is stable
+    for _, group := range groups {
+        for _, v := range group.Validators {
+            meta := v.Metadata()
+            for _, dep := range meta.Dependencies() {
+                if _, exists := r.validators[dep]; exists {
+                    result += fmt.Sprintf("    %s --> %s\n", meta.Name, dep)
+                }
+            }
+        }
+    }
+
+    return result
+}
+
+// ToDOTWithLevels generates a Graphviz DOT digraph showing the execution plan with levels
+// Each level is rendered as a cluster; edges point from a validator to the validators it runs after
+func (r *DependencyResolver) ToDOTWithLevels(groups []ExecutionGroup) string {
+    var result string
+    result += "digraph validators {\n"
+    result += "    rankdir=TB;\n"
+    result += "    node [shape=box];\n\n"
+
+    // Create clusters for each level
+    for _, group := range groups {
+        parallelInfo := ""
+        if len(group.Validators) > 1 {
+            parallelInfo = fmt.Sprintf(" - %d Validators in Parallel", len(group.Validators))
+        }
+        result += fmt.Sprintf("    subgraph cluster_level_%d {\n", group.Level)
+        result += fmt.Sprintf("        label=\"Level %d%s\";\n", group.Level, parallelInfo)
+        for _, v := range group.Validators {
+            result += fmt.Sprintf("        \"%s\";\n", v.Metadata().Name)
+        }
+        result += "    }\n\n"
+    }
+
+    // Add dependency edges in plan order so the output is stable
+    for _, group := range groups {
+        for _, v := range group.Validators {
+            meta := v.Metadata()
+            for _, dep := range meta.Dependencies() {
+                if _, exists := r.validators[dep]; exists {
+                    result += fmt.Sprintf("    \"%s\" -> \"%s\";\n", meta.Name, dep)
+                }
             }
         }
     }
 
+    result += "}\n"
     return result
 }
+
+// GraphJSON is the machine-readable execution plan emitted by ToJSON
+type GraphJSON struct {
+    Validators []GraphValidatorJSON `json:"validators"`
+    Levels     []GraphLevelJSON     `json:"levels"`
+}
+
+// GraphValidatorJSON describes one validator in the plan with its declared dependencies
+// Dependencies absent from the resolved set stay in runAfter/requireSuccess and are also listed in unresolved
+type GraphValidatorJSON struct {
+    Name           string   `json:"name"`
+    Level          int      `json:"level"`
+    RunAfter       []string `json:"runAfter"`
+    RequireSuccess []string `json:"requireSuccess,omitempty"`
+    Tags           []string `json:"tags"`
+    Unresolved     []string `json:"unresolved,omitempty"`
+}
+
+// GraphLevelJSON lists the validators that run in parallel at one level
+type GraphLevelJSON struct {
+    Level      int      `json:"level"`
+    Validators []string `json:"validators"`
+}
+
+// ToJSON resolves the execution plan and renders it as indented JSON for external schedulers and UIs
+// Validators are listed in plan order (level, then name), so the output is stable across calls
+func (r *DependencyResolver) ToJSON() ([]byte, error) {
+    groups, err := r.ResolveExecutionGroups()
+    if err != nil {
+        return nil, err
+    }
+
+    graph := GraphJSON{
+        Validators: []GraphValidatorJSON{},
+        Levels:     make([]GraphLevelJSON, 0, len(groups)),
+    }
+    for _, group := range groups {
+        level := GraphLevelJSON{Level: group.Level, Validators: make([]string, 0, len(group.Validators))}
+        for _, v := range group.Validators {
+            meta := v.Metadata()
+            level.Validators = append(level.Validators, meta.Name)
+
+            node := GraphValidatorJSON{
+                Name:           meta.Name,
+                Level:          group.Level,
+                RunAfter:       append([]string{}, meta.RunAfter...),
+                RequireSuccess: meta.RequireSuccess,
+                Tags:           append([]string{}, meta.Tags...),
+            }
+            for _, dep := range meta.Dependencies() {
+                if _, exists := r.validators[dep]; !exists {
+                    node.Unresolved = append(node.Unresolved, dep)
+                }
+            }
+            graph.Validators = append(graph.Validators, node)
+        }
+        graph.Levels = append(graph.Levels, level)
+    }
+
+    return json.MarshalIndent(graph, "", "  ")
+}
@@ -1,6 +1,8 @@
 package validator_test
 
 import (
+    "encoding/json"
+
     . "github.com/onsi/ginkgo/v2"
     . "github.com/onsi/gomega"
 
@@ -525,4 +527,120 @@ var _ = Describe("DependencyResolver", func() {
             })
         })
     })
+
+    Describe("SelectWithDependencies", func() {
+        BeforeEach(func() {
+            validators = []validator.Validator{
+                &MockValidator{name: "validator-a", runAfter: []string{}},
+                &MockValidator{name: "validator-b", runAfter: []string{"validator-a"}},
+                &MockValidator{name: "validator-c", runAfter: []string{"validator-b", "missing"}},
+                &MockValidator{name: "validator-d", runAfter: []string{"validator-a"}},
+            }
+        })
+
+        It("should return the validator with its transitive dependencies in input order", func() {
+            subset, err := validator.SelectWithDependencies(validators, "validator-c")
+            Expect(err).NotTo(HaveOccurred())
+            names := []string{}
+            for _, v := range subset {
+                names = append(names, v.Metadata().Name)
+            }
+            Expect(names).To(Equal([]string{"validator-a", "validator-b", "validator-c"}))
+        })
+
+        It("should return just the validator when it has no dependencies", func() {
+            subset, err := validator.SelectWithDependencies(validators, "validator-a")
+            Expect(err).NotTo(HaveOccurred())
+            Expect(subset).To(HaveLen(1))
+        })
+
+        It("should error when the validator is not in the set", func() {
+            _, err := validator.SelectWithDependencies(validators, "validator-z")
+            Expect(err).To(HaveOccurred())
+        })
+    })
+
+    Describe("ToDOTWithLevels", func() {
+        BeforeEach(func() {
+            validators = []validator.Validator{
+                &MockValidator{name: "validator-a", runAfter: []string{}},
+                &MockValidator{name: "validator-b", runAfter: []string{"validator-a"}},
+                &MockValidator{name: "validator-c", runAfter: []string{"validator-a", "missing"}},
+            }
+            resolver = validator.NewDependencyResolver(validators)
+        })
+
+        It("should render a cluster per level and dependency edges", func() {
+            groups, _ := resolver.ResolveExecutionGroups()
+            dot := resolver.ToDOTWithLevels(groups)
+
+            Expect(dot).To(HavePrefix("digraph validators {"))
+            Expect(dot).To(ContainSubstring("subgraph cluster_level_0"))
+            Expect(dot).To(ContainSubstring(`label="Level 1 - 2 Validators in Parallel";`))
+            Expect(dot).To(ContainSubstring(`"validator-b" -> "validator-a";`))
+            Expect(dot).To(ContainSubstring(`"validator-c" -> "validator-a";`))
+            Expect(dot).NotTo(ContainSubstring(`"missing"`))
+            Expect(dot).To(HaveSuffix("}\n"))
+        })
+
+        It("should render identically across calls", func() {
+            groups, _ := resolver.ResolveExecutionGroups()
+            Expect(resolver.ToDOTWithLevels(groups)).To(Equal(resolver.ToDOTWithLevels(groups)))
+        })
+    })
+
+    Describe("ToJSON", func() {
+        BeforeEach(func() {
+            validators = []validator.Validator{
+                &MockValidator{name: "validator-a", tags: []string{"mvp"}},
+                &MockValidator{name: "validator-b", runAfter: []string{"validator-a"}, requireSuccess: []string{"gate"}},
+                &MockValidator{name: "validator-c", runAfter: []string{"validator-a", "missing"}},
+            }
+            resolver = validator.NewDependencyResolver(validators)
+        })
+
+        It("should list validators in plan order with their levels", func() {
+            data, err := resolver.ToJSON()
+            Expect(err).NotTo(HaveOccurred())
+
+            var graph validator.GraphJSON
+            Expect(json.Unmarshal(data, &graph)).To(Succeed())
+            Expect(graph.Levels).To(Equal([]validator.GraphLevelJSON{
+                {Level: 0, Validators: []string{"validator-a"}},
+                {Level: 1, Validators: []string{"validator-b", "validator-c"}},
+            }))
+            Expect(graph.Validators).To(HaveLen(3))
+            Expect(graph.Validators[0]).To(Equal(validator.GraphValidatorJSON{
+                Name: "validator-a", Level: 0, RunAfter: []string{}, Tags: []string{"mvp"},
+            }))
+        })
+
+        It("should keep missing dependencies in runAfter and flag them as unresolved", func() {
+            data, err := resolver.ToJSON()
+            Expect(err).NotTo(HaveOccurred())
+
+            var graph validator.GraphJSON
+            Expect(json.Unmarshal(data, &graph)).To(Succeed())
+            Expect(graph.Validators[1].RequireSuccess).To(Equal([]string{"gate"}))
+            Expect(graph.Validators[1].Unresolved).To(Equal([]string{"gate"}))
+            Expect(graph.Validators[2].RunAfter).To(Equal([]string{"validator-a", "missing"}))
+            Expect(graph.Validators[2].Unresolved).To(Equal([]string{"missing"}))
+        })
+
+        It("should emit empty arrays rather than null", func() {
+            data, err := resolver.ToJSON()
+            Expect(err).NotTo(HaveOccurred())
+            Expect(string(data)).To(ContainSubstring(`"runAfter": []`))
+            Expect(string(data)).NotTo(ContainSubstring("null"))
+        })
+
+        It("should fail on a cycle", func() {
+            resolver = validator.NewDependencyResolver([]validator.Validator{
+                &MockValidator{name: "x", runAfter: []string{"y"}},
+                &MockValidator{name: "y", runAfter: []string{"x"}},
+            })
+            _, err := resolver.ToJSON()
+            Expect(err).To(HaveOccurred())
+        })
+    })
 })
@@ -0,0 +1,96 @@
+package validator
+
+import (
+    "context"
+    "log/slog"
+    "sort"
+    "sync"
+)
+
+// samplingState counts debug records per message, shared by a sampling handler and its derived handlers
+type samplingState struct {
+    mu         sync.Mutex
+    seen       map[string]int
+    suppressed map[string]int
+}
+
+// samplingHandler is a slog middleware that emits only every rate-th debug record per message
+// Records above debug level always pass through so warnings and errors are never dropped
+type samplingHandler struct {
+    next  slog.Handler
+    rate  int
+    state *samplingState
+}
+
+// Enabled delegates to the wrapped handler
+func (h *samplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+    return h.next.Enabled(ctx, level)
+}
+
+// Handle forwards the record unless it is a repeated debug line that falls outside the sample
+func (h *samplingHandler) Handle(ctx context.Context, r slog.Record) error {
+    if r.Level > slog.LevelDebug {
+        return h.next.Handle(ctx, r)
+    }
+
+    h.state.mu.Lock()
+    n := h.state.seen[r.Message]
+    h.state.seen[r.Message] = n + 1
+    keep := n%h.rate == 0
+    if !keep {
+        h.state.suppressed[r.Message]++
+    }
+    h.state.mu.Unlock()
+
+    if !keep {
+        return nil
+    }
+    return h.next.Handle(ctx, r)
+}
+
+// WithAttrs returns a sampling handler sharing the same counters
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+    return &samplingHandler{next: h.next.WithAttrs(attrs), rate: h.rate, state: h.state}
+}
+
+// WithGroup returns a sampling handler sharing the same counters
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+    return &samplingHandler{next: h.next.WithGroup(name), rate: h.rate, state: h.state}
+}
+
+// NewSampledLogger wraps logger so each repetitive debug message is emitted at most once per rate records
+// Validators use it in per-item loops; call the returned flush when the loop is done to log
+// how many lines were suppressed. A rate of 1 or less disables sampling.
+func NewSampledLogger(logger *slog.Logger, rate int) (*slog.Logger, func()) {
+    if rate <= 1 {
+        return logger, func() {}
+    }
+
+    state := &samplingState{
+        seen:       make(map[string]int),
+        suppressed: make(map[string]int),
+    }
+    sampled := slog.New(&samplingHandler{next: logger.Handler(), rate: rate, state: state})
+
+    flush := func() {
+        state.mu.Lock()
+        messages := make([]string, 0, len(state.suppressed))
+        for msg := range state.suppressed {
+            messages = append(messages, msg)
+        }
+        sort.Strings(messages)
+        counts := make([]int, len(messages))
+        for i, msg := range messages {
+            counts[i] = state.suppressed[msg]
+        }
+        state.mu.Unlock()
+
+        for i, msg := range messages {
+            logger.Debug("Suppressed sampled debug logs",
+                "message", msg,
+                "suppressed", counts[i],
+                "sample_rate", rate)
+        }
+    }
+    return sampled, flush
+}
@@ -0,0 +1,57 @@
+package validator_test
+
+import (
+    "bytes"
+    "log/slog"
+    "strings"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/validator"
+)
+
+var _ = Describe("NewSampledLogger", func() {
+    var (
+        buf  *bytes.Buffer
+        base *slog.Logger
+    )
+
+    BeforeEach(func() {
+        buf = &bytes.Buffer{}
+        base = slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+    })
+
+    It("should emit every rate-th debug record per message and summarize the rest", func() {
+        logger, flush := validator.NewSampledLogger(base, 3)
+        for i := 0; i < 7; i++ {
+            logger.Debug("Checking API", "index", i)
+        }
+        Expect(strings.Count(buf.String(), `msg="Checking API"`)).To(Equal(3)) // 0, 3, 6
+
+        flush()
+        Expect(buf.String()).To(ContainSubstring(`msg="Suppressed sampled debug logs" message="Checking API" suppressed=4`))
+    })
+
+    It("should never sample records above debug level", func() {
+        logger, _ := validator.NewSampledLogger(base, 10)
+        for i := 0; i < 3; i++ {
+            logger.Warn("API is NOT enabled")
+        }
+        Expect(strings.Count(buf.String(), "API is NOT enabled")).To(Equal(3))
+    })
+
+    It("should share counters with derived loggers", func() {
+        logger, _ := validator.NewSampledLogger(base, 2)
+        logger.Debug("Checking API")
+        logger.With("validator", "api-enabled").Debug("Checking API")
+        Expect(strings.Count(buf.String(), "Checking API")).To(Equal(1))
+    })
+
+    It("should return the logger unchanged when sampling is disabled", func() {
+        logger, flush := validator.NewSampledLogger(base, 1)
+        Expect(logger).To(BeIdenticalTo(base))
+        flush()
+        Expect(buf.String()).To(BeEmpty())
+    })
+})
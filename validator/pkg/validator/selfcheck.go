@@ -0,0 +1,80 @@
+package validator
+
+import (
+    "fmt"
+    "reflect"
+    "sort"
+)
+
+// optionalInterfaces maps each method of the optional validator interfaces to its interface
+// The executor detects them by type assertion, so a method with the wrong signature is silently ignored
+var optionalInterfaces = map[string]reflect.Type{
+    "Enabled":      reflect.TypeFor[Conditional](),
+    "Describe":     reflect.TypeFor[Describer](),
+    "RequiredAPIs": reflect.TypeFor[APIRequirer](),
+    "Version":      reflect.TypeFor[Versioned](),
+    "CacheKey":     reflect.TypeFor[CacheKeyer](),
+}
+
+// SelfCheck verifies the authoring invariants of validators without running them or contacting GCP:
+// every validator has a name and a description, targets the current InterfaceVersion, implements the
+// optional interfaces whose methods it declares, and the dependencies form no cycle.
+// Duplicate names are caught earlier: Register panics on them.
+// Problems list one violation each, sorted; none means the set is consistent
+// A dependency on a validator outside the set is ignored by runs, so it is only a warning unless strict is set
+func SelfCheck(validators []Validator, strict bool) (problems, warnings []string) {
+    seen := make(map[string]bool, len(validators))
+    for _, v := range validators {
+        meta := v.Metadata()
+        if meta.Name == "" {
+            problems = append(problems, fmt.Sprintf("validator %T has an empty name", v))
+            continue
+        }
+        seen[meta.Name] = true
+        if meta.Description == "" {
+            problems = append(problems, fmt.Sprintf("%s: empty description", meta.Name))
+        }
+        if meta.Weight < 0 {
+            problems = append(problems, fmt.Sprintf("%s: negative weight %d", meta.Name, meta.Weight))
+        }
+        if version := VersionOf(v); version != InterfaceVersion {
+            problems = append(problems, fmt.Sprintf("%s: targets interface version %d, current is %d", meta.Name, version, InterfaceVersion))
+        }
+        problems = append(problems, interfaceMismatches(meta.Name, v)...)
+    }
+
+    for _, v := range validators {
+        meta := v.Metadata()
+        for _, dep := range meta.Dependencies() {
+            if seen[dep] {
+                continue
+            }
+            unknown := fmt.Sprintf("%s: depends on unknown validator %q", meta.Name, dep)
+            if strict {
+                problems = append(problems, unknown)
+            } else {
+                warnings = append(warnings, unknown)
+            }
+        }
+    }
+
+    if err := NewDependencyResolver(validators).detectCycles(); err != nil {
+        problems = append(problems, err.Error())
+    }
+
+    sort.Strings(problems)
+    sort.Strings(warnings)
+    return problems, warnings
+}
+
+// interfaceMismatches reports methods named like an optional interface's method that do not implement it
+func interfaceMismatches(name string, v Validator) []string {
+    var problems []string
+    t := reflect.TypeOf(v)
+    for method, iface := range optionalInterfaces {
+        if _, declared := t.MethodByName(method); declared && !t.Implements(iface) {
+            problems = append(problems, fmt.Sprintf("%s: method %s does not match the %s interface", name, method, iface))
+        }
+    }
+    return problems
+}
@@ -0,0 +1,88 @@
+package validator_test
+
+import (
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/validator"
+)
+
+var _ = Describe("SelfCheck", func() {
+    It("should report nothing for a consistent set", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &MockValidator{name: "validator-a", description: "A"},
+            &MockValidator{name: "validator-b", description: "B", runAfter: []string{"validator-a"}},
+            &MockValidator{name: "validator-c", description: "C", requireSuccess: []string{"validator-b"}},
+        }, true)
+        Expect(problems).To(BeEmpty())
+    })
+
+    It("should report missing names and descriptions", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &MockValidator{name: "validator-a"},
+            &MockValidator{description: "B"},
+        }, false)
+        Expect(problems).To(ConsistOf(
+            "validator *validator_test.MockValidator has an empty name",
+            "validator-a: empty description",
+        ))
+    })
+
+    It("should report optional interface methods with the wrong signature", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &misdeclaredValidator{MockValidator{name: "validator-a", description: "A"}},
+            &conditionalValidator{MockValidator: MockValidator{name: "validator-b", description: "B"}, key: "EXPECTED_PARENT"},
+        }, false)
+        Expect(problems).To(Equal([]string{"validator-a: method Enabled does not match the validator.Conditional interface"}))
+    })
+
+    It("should report dependencies on unknown validators when strict", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &MockValidator{name: "validator-a", description: "A", runAfter: []string{"missing-check"}},
+            &MockValidator{name: "validator-b", description: "B", requireSuccess: []string{"other-check"}},
+        }, true)
+        Expect(problems).To(ConsistOf(
+            `validator-a: depends on unknown validator "missing-check"`,
+            `validator-b: depends on unknown validator "other-check"`,
+        ))
+    })
+
+    It("should only warn about dependencies on unknown validators unless strict", func() {
+        problems, warnings := validator.SelfCheck([]validator.Validator{
+            &MockValidator{name: "validator-a", description: "A", runAfter: []string{"missing-check"}},
+        }, false)
+        Expect(problems).To(BeEmpty())
+        Expect(warnings).To(ConsistOf(`validator-a: depends on unknown validator "missing-check"`))
+    })
+
+    It("should report a negative weight", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &weightedValidator{MockValidator: MockValidator{name: "validator-a", description: "A"}, weight: -1},
+        }, false)
+        Expect(problems).To(Equal([]string{"validator-a: negative weight -1"}))
+    })
+
+    It("should report dependency cycles", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &MockValidator{name: "validator-a", description: "A", runAfter: []string{"validator-b"}},
+            &MockValidator{name: "validator-b", description: "B", runAfter: []string{"validator-a"}},
+        }, false)
+        Expect(problems).To(ConsistOf(ContainSubstring("circular dependency detected")))
+    })
+
+    It("should report validators targeting another interface version", func() {
+        problems, _ := validator.SelfCheck([]validator.Validator{
+            &versionedValidator{MockValidator: MockValidator{name: "validator-a", description: "A"}, version: validator.InterfaceVersion + 1},
+        }, false)
+        Expect(problems).To(ConsistOf(ContainSubstring("validator-a: targets interface version")))
+    })
+})
+
+// misdeclaredValidator declares Enabled without the Context parameter, so it is not a Conditional
+type misdeclaredValidator struct {
+    MockValidator
+}
+
+func (v *misdeclaredValidator) Enabled() bool {
+    return false
+}
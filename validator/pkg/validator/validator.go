@@ -3,6 +3,7 @@ package validator
 import (
     "context"
     "fmt"
+    "slices"
     "strings"
     "time"
 )
@@ -13,7 +14,55 @@ type ValidatorMetadata struct {
     Name        string   // Unique identifier (e.g., "wif-check")
     Description string   // Human-readable description
     RunAfter    []string // Validators this should run after (dependencies)
-    Tags        []string // For grouping/filtering (e.g., "mvp", "network", "quota")
+    // RequireSuccess lists predecessors that must have passed (success or warning) for this validator
+    // to run; otherwise it is skipped with PrerequisiteFailed. They are ordered like RunAfter entries
+    RequireSuccess []string
+    Tags           []string // For grouping/filtering (e.g., "mvp", "network", "quota")
+    // Experimental validators run with a warning and their failures do not fail the run
+    // unless TREAT_EXPERIMENTAL_AS_BLOCKING=true; their results carry Details["experimental"]
+    Experimental bool
+    // DefaultTimeout is the recommended time limit for one Validate call; the executor uses it
+    // unless VALIDATOR_<NAME>_TIMEOUT_SECONDS overrides it. Zero means use VALIDATOR_TIMEOUT_SECONDS
+    DefaultTimeout time.Duration
+    // Cacheable validators check things that rarely change; when the Context has a ResultCache their
+    // successful or warning result is reused for CacheTTL (DefaultCacheTTL when zero) for the same project
+    // Validators whose verdict depends on other settings must implement CacheKeyer
+    Cacheable bool
+    CacheTTL  time.Duration
+    // Weight is the validator's share of Details["readiness_score"]; critical validators weigh more
+    // Zero means DefaultWeight. Results of weighted validators carry Details["weight"]
+    Weight int
+}
+
+// DefaultWeight is the readiness score weight of validators that leave Weight unset
+const DefaultWeight = 1
+
+// TagDestructive marks validators that create, modify or delete GCP resources while probing
+// The executor refuses to run them unless ALLOW_DESTRUCTIVE=true is set explicitly
+const TagDestructive = "destructive"
+
+// Dependencies returns the validators this one runs after: RunAfter followed by RequireSuccess
+func (m ValidatorMetadata) Dependencies() []string {
+    if len(m.RequireSuccess) == 0 {
+        return m.RunAfter
+    }
+    deps := append([]string{}, m.RunAfter...)
+    for _, dep := range m.RequireSuccess {
+        if !slices.Contains(deps, dep) {
+            deps = append(deps, dep)
+        }
+    }
+    return deps
+}
+
+// HasTag reports whether the metadata carries the given tag
+func (m ValidatorMetadata) HasTag(tag string) bool {
+    for _, t := range m.Tags {
+        if t == tag {
+            return true
+        }
+    }
+    return false
 }
 
 // Validator is the core interface all validators must implement
@@ -25,12 +74,97 @@ type Validator interface {
     Validate(ctx context.Context, vctx *Context) *Result
 }
 
+// InterfaceVersion is the current version of the Validator contract
+// Version 1: Metadata and Validate, with the optional Conditional interface and Result.SubResults
+// Bump it when validators gain new expectations so the executor can flag validators written against an older contract
+const InterfaceVersion = 1
+
+// Versioned is optionally implemented by validators to declare the Validator contract version they were written against
+// Validators that do not implement it are treated as version 1
+type Versioned interface {
+    // Version returns the InterfaceVersion the validator targets
+    Version() int
+}
+
+// VersionOf returns the contract version a validator targets, defaulting to 1
+func VersionOf(v Validator) int {
+    if vv, ok := v.(Versioned); ok {
+        return vv.Version()
+    }
+    return 1
+}
+
+// Conditional is optionally implemented by validators that only apply when their configuration is present
+// A validator whose Enabled returns false is "not enabled": it is left out of the plan and produces no result.
+// This differs from "skipped", where the validator runs and declines with StatusSkipped.
+type Conditional interface {
+    // Enabled reports whether the validator should be part of the execution plan
+    Enabled(vctx *Context) bool
+}
+
+// Describer is optionally implemented by validators that can explain, from the configuration in vctx,
+// exactly what they will check; dry runs print it instead of the static Metadata description
+type Describer interface {
+    // Describe returns a one-line, config-aware explanation such as "will verify APIs: compute, iam in project X"
+    Describe(vctx *Context) string
+}
+
+// DescribeValidator returns the validator's Describe output, falling back to its Metadata description
+func DescribeValidator(v Validator, vctx *Context) string {
+    if d, ok := v.(Describer); ok {
+        if description := d.Describe(vctx); description != "" {
+            return description
+        }
+    }
+    return v.Metadata().Description
+}
+
+// DescribePlan renders the execution plan level by level with what each validator will check
+// The plan is recorded in vctx first, so descriptions may use it (e.g., PlannedAPIs); nothing is executed
+func DescribePlan(vctx *Context, groups []ExecutionGroup) string {
+    vctx.setExecutionPlan(groups)
+
+    var b strings.Builder
+    for _, group := range groups {
+        fmt.Fprintf(&b, "Level %d:\n", group.Level)
+        for _, v := range group.Validators {
+            fmt.Fprintf(&b, "  %s: %s\n", v.Metadata().Name, DescribeValidator(v, vctx))
+        }
+    }
+    return b.String()
+}
+
+// CacheKeyer is optionally implemented by Cacheable validators whose verdict depends on configuration
+// beyond PROJECT_ID. The key is part of the result cache key, so runs with different settings
+// (e.g. batch entries expecting different parents) never share a result
+type CacheKeyer interface {
+    // CacheKey returns a fingerprint of the settings the result depends on, e.g. the expected parent
+    CacheKey(vctx *Context) string
+}
+
+// cacheKeyOf returns the validator's CacheKey, or "" when it does not implement CacheKeyer
+func cacheKeyOf(v Validator, vctx *Context) string {
+    if k, ok := v.(CacheKeyer); ok {
+        return k.CacheKey(vctx)
+    }
+    return ""
+}
+
+// APIRequirer is optionally implemented by validators that call GCP APIs beyond the configured REQUIRED_APIS
+// When AUTO_REQUIRED_APIS is on, api-enabled checks the union of the planned validators' APIs with REQUIRED_APIS
+type APIRequirer interface {
+    // RequiredAPIs returns the service names the validator calls, e.g. compute.googleapis.com
+    RequiredAPIs() []string
+}
+
 // Status represents the validation outcome
 type Status string
 
 const (
     StatusSuccess Status = "success"
     StatusFailure Status = "failure"
+    StatusWarning Status = "warning" // Passed, but with a condition operators should look at
+    StatusSkipped Status = "skipped" // Not applicable, e.g. its configuration is missing
 )
 
 // Result represents the outcome of a single validator
@@ -42,6 +176,49 @@ type Result struct {
     Details       map[string]interface{} `json:"details,omitempty"`
     Duration      time.Duration          `json:"duration_ns"`
     Timestamp     time.Time              `json:"timestamp"`
+
+    // SubResults optionally reports granular per-item outcomes (e.g., one per API)
+    // ValidatorName on a sub-result names the item; the parent Status remains the overall outcome
+    SubResults []*Result `json:"sub_results,omitempty"`
+}
+
+// experimentalDetail is the Details key the executor sets on results of experimental validators
+const experimentalDetail = "experimental"
+
+// IsExperimental reports whether the result came from a validator marked Experimental
+func (r *Result) IsExperimental() bool {
+    experimental, _ := r.Details[experimentalDetail].(bool)
+    return experimental
+}
+
+// weightDetail is the Details key the executor sets on results of validators with a non-default Weight
+const weightDetail = "weight"
+
+// Weight returns the readiness score weight recorded on the result, DefaultWeight when none is
+func (r *Result) Weight() int {
+    if weight, ok := r.Details[weightDetail].(int); ok && weight > 0 {
+        return weight
+    }
+    return DefaultWeight
+}
+
+// Flatten expands sub-results into a flat list for exporters
+// Sub-results follow their parent and are named "<parent>/<item>"; results without sub-results pass through unchanged
+func Flatten(results []*Result) []*Result {
+    flat := make([]*Result, 0, len(results))
+    for _, r := range results {
+        flat = append(flat, r)
+        for _, sub := range r.SubResults {
+            if sub == nil {
+                continue
+            }
+            item := *sub
+            item.ValidatorName = r.ValidatorName + "/" + sub.ValidatorName
+            item.SubResults = nil
+            flat = append(flat, &item)
+        }
+    }
+    return flat
 }
 
 // AggregatedResult combines all validator results into the expected output format
@@ -52,41 +229,247 @@ type AggregatedResult struct {
     Details map[string]interface{} `json:"details"`
 }
 
+// SummaryResult is the compact projection of an AggregatedResult written for OUTPUT_FORMAT=summary
+// It omits the per-validator dump for lightweight polling consumers
+type SummaryResult struct {
+    Status       Status   `json:"status"`
+    Message      string   `json:"message"`
+    ChecksRun    int      `json:"checks_run"`
+    ChecksPassed int      `json:"checks_passed"`
+    FailedChecks []string `json:"failed_checks"`
+}
+
+// Summary projects the aggregated result onto its top-level status and counts
+// FailedChecks is always a list (empty on success) so consumers need no null check
+func (a *AggregatedResult) Summary() *SummaryResult {
+    summary := &SummaryResult{
+        Status:       a.Status,
+        Message:      a.Message,
+        FailedChecks: []string{},
+    }
+    if n, ok := a.Details["checks_run"].(int); ok {
+        summary.ChecksRun = n
+    }
+    if n, ok := a.Details["checks_passed"].(int); ok {
+        summary.ChecksPassed = n
+    }
+    if failed, ok := a.Details["failed_checks"].([]string); ok {
+        summary.FailedChecks = failed
+    }
+    return summary
+}
+
+// Results returns the validator results the aggregate was built from, in execution order
+func (a *AggregatedResult) Results() []*Result {
+    results, _ := a.Details["validators"].([]*Result)
+    return results
+}
+
+// PanicCount returns how many validators panicked, as counted in details.panic_count
+func (a *AggregatedResult) PanicCount() int {
+    n, _ := a.Details["panic_count"].(int)
+    return n
+}
+
+// aggregateOptions controls how Aggregate treats non-binary statuses
+type aggregateOptions struct {
+    failOnSkipped        bool
+    experimentalBlocking bool
+    scopesUsed           []string
+    levelStats           []LevelStats
+    clock                Clock
+}
+
+// AggregateOption configures Aggregate
+type AggregateOption func(*aggregateOptions)
+
+// WithFailOnSkipped makes skipped validators count as failures instead of being neutral
+func WithFailOnSkipped(failOnSkipped bool) AggregateOption {
+    return func(o *aggregateOptions) {
+        o.failOnSkipped = failOnSkipped
+    }
+}
+
+// WithExperimentalBlocking makes failures of experimental validators fail the run like any other
+func WithExperimentalBlocking(blocking bool) AggregateOption {
+    return func(o *aggregateOptions) {
+        o.experimentalBlocking = blocking
+    }
+}
+
+// WithScopesUsed records the OAuth scopes requested during the run in Details["scopes_used"]
+// Pass Context.ScopesUsed so the output proves which permissions the run exercised
+func WithScopesUsed(scopes []string) AggregateOption {
+    return func(o *aggregateOptions) {
+        o.scopesUsed = scopes
+    }
+}
+
+// WithLevelStats records the executor's per-level parallelism in Details["level_stats"]
+// Pass Executor.LevelStats to see whether a level was dominated by one slow validator
+func WithLevelStats(stats []LevelStats) AggregateOption {
+    return func(o *aggregateOptions) {
+        o.levelStats = stats
+    }
+}
+
+// WithClock sets the clock for Details["timestamp"] (for testing); defaults to the real clock
+func WithClock(clock Clock) AggregateOption {
+    return func(o *aggregateOptions) {
+        o.clock = clock
+    }
+}
+
+// distinctReasons returns the sorted, deduplicated reasons of the results named in failedChecks
+func distinctReasons(results []*Result, failedChecks []string) []string {
+    var reasons []string
+    for _, r := range results {
+        if slices.Contains(failedChecks, r.ValidatorName) && !slices.Contains(reasons, r.Reason) {
+            reasons = append(reasons, r.Reason)
+        }
+    }
+    slices.Sort(reasons)
+    return reasons
+}
+
 // Aggregate combines multiple validator results into final output
-func Aggregate(results []*Result) *AggregatedResult {
+// Skipped validators are neutral by default: they neither pass nor fail the run
+func Aggregate(results []*Result, opts ...AggregateOption) *AggregatedResult {
+    options := aggregateOptions{clock: realClock{}}
+    for _, opt := range opts {
+        opt(&options)
+    }
+
     checksRun := len(results)
     checksPassed := 0
     var failedChecks []string
     var failureDescriptions []string
+    var warningChecks []string
+    var skippedChecks []string
+    var experimentalFailures []string
+    var panickedChecks []string
+    failureCategories := map[string]string{}
+    // Weights of the results that count towards the readiness score: passed ones and the ones failing the run
+    passedWeight, totalWeight := 0, 0
 
     // Single pass to collect all failure information
     for _, r := range results {
         switch r.Status {
         case StatusSuccess:
             checksPassed++
+            passedWeight += r.Weight()
+            totalWeight += r.Weight()
+        case StatusWarning:
+            // Warnings do not fail validation but are surfaced separately
+            checksPassed++
+            passedWeight += r.Weight()
+            totalWeight += r.Weight()
+            warningChecks = append(warningChecks, r.ValidatorName)
+        case StatusSkipped:
+            skippedChecks = append(skippedChecks, r.ValidatorName)
+            if options.failOnSkipped {
+                failedChecks = append(failedChecks, r.ValidatorName)
+                failureDescriptions = append(failureDescriptions, fmt.Sprintf("%s (skipped: %s)", r.ValidatorName, r.Reason))
+                failureCategories[r.ValidatorName] = ReasonCategory(r.Reason)
+                totalWeight += r.Weight()
+            }
         case StatusFailure:
+            // A panic is a validator bug, counted even when the validator is experimental
+            if r.Reason == ReasonValidatorPanic {
+                panickedChecks = append(panickedChecks, r.ValidatorName)
+            }
+            // Experimental validators are reported but only gate the run when opted in
+            if r.IsExperimental() && !options.experimentalBlocking {
+                experimentalFailures = append(experimentalFailures, r.ValidatorName)
+                continue
+            }
             failedChecks = append(failedChecks, r.ValidatorName)
             failureDescriptions = append(failureDescriptions, fmt.Sprintf("%s (%s)", r.ValidatorName, r.Reason))
+            failureCategories[r.ValidatorName] = ReasonCategory(r.Reason)
+            totalWeight += r.Weight()
         }
     }
 
+    // Neutral results (skipped, non-blocking experimental failures) are left out; with none counted the run is fully ready
+    readinessScore := 1.0
+    if totalWeight > 0 {
+        readinessScore = float64(passedWeight) / float64(totalWeight)
+    }
+
     details := map[string]interface{}{
         "checks_run":    checksRun,
         "checks_passed": checksPassed,
-        "timestamp":     time.Now().UTC().Format(time.RFC3339),
+        "timestamp":     options.clock.Now().UTC().Format(time.RFC3339),
         "validators":    results,
+        "panic_count":   len(panickedChecks),
+        // Weighted fraction of passed checks in [0, 1]: one tunable health metric beside the binary Status
+        "readiness_score": readinessScore,
+    }
+
+    if len(panickedChecks) > 0 {
+        details["panicked_checks"] = panickedChecks
+    }
+    if len(warningChecks) > 0 {
+        details["warning_checks"] = warningChecks
+    }
+    if len(skippedChecks) > 0 {
+        details["skipped_checks"] = skippedChecks
+    }
+    if len(experimentalFailures) > 0 {
+        details["experimental_failed_checks"] = experimentalFailures
+    }
+    if options.scopesUsed != nil {
+        details["scopes_used"] = options.scopesUsed
+    }
+    if options.levelStats != nil {
+        details["level_stats"] = options.levelStats
     }
 
-    if checksPassed == checksRun {
+    // Flatten sub-results so per-item outcomes are visible without walking each validator
+    subChecksRun, subChecksPassed := 0, 0
+    var failedSubChecks []string
+    for _, r := range results {
+        // Flatten a single result: index 0 is the parent, the rest are its named sub-results
+        for _, sub := range Flatten([]*Result{r})[1:] {
+            subChecksRun++
+            if sub.Status == StatusFailure {
+                failedSubChecks = append(failedSubChecks, sub.ValidatorName)
+            } else {
+                subChecksPassed++
+            }
+        }
+    }
+    if subChecksRun > 0 {
+        details["sub_checks_run"] = subChecksRun
+        details["sub_checks_passed"] = subChecksPassed
+        if len(failedSubChecks) > 0 {
+            details["failed_sub_checks"] = failedSubChecks
+        }
+    }
+
+    if len(failedChecks) == 0 {
+        message := "All GCP validation checks passed successfully"
+        if len(warningChecks) > 0 {
+            message = fmt.Sprintf("All GCP validation checks passed with %d warning(s): %s",
+                len(warningChecks), strings.Join(warningChecks, ", "))
+        }
+        if len(skippedChecks) > 0 {
+            message += fmt.Sprintf(" (%d skipped: %s)", len(skippedChecks), strings.Join(skippedChecks, ", "))
+        }
+        if len(experimentalFailures) > 0 {
+            message += fmt.Sprintf(" (%d experimental failed: %s)", len(experimentalFailures), strings.Join(experimentalFailures, ", "))
+        }
         return &AggregatedResult{
             Status:  StatusSuccess,
-            Reason:  "ValidationPassed",
-            Message: "All GCP validation checks passed successfully",
+            Reason:  ReasonValidationPassed,
+            Message: message,
             Details: details,
         }
     }
 
     details["failed_checks"] = failedChecks
+    details["failure_categories"] = failureCategories
+    details["distinct_reasons"] = distinctReasons(results, failedChecks)
 
     // Build informative failure message with pass ratio and reasons
     message := fmt.Sprintf("%d validation check(s) failed: %s. Passed: %d/%d",
@@ -97,7 +480,7 @@ func Aggregate(results []*Result) *AggregatedResult {
 
     return &AggregatedResult{
         Status:  StatusFailure,
-        Reason:  "ValidationFailed",
+        Reason:  ReasonValidationFailed,
         Message: message,
         Details: details,
     }
@@ -0,0 +1,339 @@
+package validator_test
+
+import (
+    "time"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/validator"
+)
+
+var _ = Describe("Result", func() {
+    var withSubResults *validator.Result
+
+    BeforeEach(func() {
+        withSubResults = &validator.Result{
+            ValidatorName: "api-enabled",
+            Status:        validator.StatusFailure,
+            Reason:        "RequiredAPIsDisabled",
+            SubResults: []*validator.Result{
+                {ValidatorName: "compute.googleapis.com", Status: validator.StatusSuccess, Reason: "APIEnabled"},
+                {ValidatorName: "iam.googleapis.com", Status: validator.StatusFailure, Reason: "APIDisabled"},
+            },
+        }
+    })
+
+    Describe("Flatten", func() {
+        It("should expand sub-results after their parent with prefixed names", func() {
+            plain := &validator.Result{ValidatorName: "quota-check", Status: validator.StatusSuccess}
+
+            flat := validator.Flatten([]*validator.Result{withSubResults, plain})
+
+            var names []string
+            for _, r := range flat {
+                names = append(names, r.ValidatorName)
+            }
+            Expect(names).To(Equal([]string{
+                "api-enabled",
+                "api-enabled/compute.googleapis.com",
+                "api-enabled/iam.googleapis.com",
+                "quota-check",
+            }))
+        })
+
+        It("should not modify the original sub-results", func() {
+            validator.Flatten([]*validator.Result{withSubResults})
+            Expect(withSubResults.SubResults[0].ValidatorName).To(Equal("compute.googleapis.com"))
+        })
+    })
+
+    Describe("Summary", func() {
+        It("should project status, message and counts without the validators", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusFailure, Reason: "Broken"},
+            })
+
+            summary := agg.Summary()
+            Expect(summary.Status).To(Equal(validator.StatusFailure))
+            Expect(summary.Message).To(Equal(agg.Message))
+            Expect(summary.ChecksRun).To(Equal(2))
+            Expect(summary.ChecksPassed).To(Equal(1))
+            Expect(summary.FailedChecks).To(Equal([]string{"b"}))
+        })
+
+        It("should report an empty failed list on success", func() {
+            agg := validator.Aggregate([]*validator.Result{{ValidatorName: "a", Status: validator.StatusSuccess}})
+            Expect(agg.Summary().FailedChecks).To(BeEmpty())
+            Expect(agg.Summary().FailedChecks).NotTo(BeNil())
+        })
+    })
+
+    Describe("Results", func() {
+        It("should return the aggregated validator results in order", func() {
+            results := []*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusWarning, Reason: "Drift"},
+            }
+            Expect(validator.Aggregate(results).Results()).To(Equal(results))
+        })
+    })
+
+    Describe("Aggregate", func() {
+        It("should take the timestamp from the injected clock", func() {
+            now := time.Date(2026, 1, 15, 10, 30, 0, 0, time.FixedZone("CET", 3600))
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+            }, validator.WithClock(fixedClock(now)))
+            Expect(agg.Details).To(HaveKeyWithValue("timestamp", "2026-01-15T09:30:00Z"))
+        })
+
+        It("should report success when all validators pass", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusSuccess},
+            })
+            Expect(agg.Status).To(Equal(validator.StatusSuccess))
+            Expect(agg.Reason).To(Equal("ValidationPassed"))
+            Expect(agg.Details).To(HaveKeyWithValue("checks_run", 2))
+            Expect(agg.Details).NotTo(HaveKey("sub_checks_run"))
+            Expect(agg.PanicCount()).To(BeZero())
+        })
+
+        It("should count panicked validators, experimental ones included", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusFailure, Reason: validator.ReasonValidatorPanic},
+                {ValidatorName: "b", Status: validator.StatusFailure, Reason: "Broken"},
+                {
+                    ValidatorName: "beta",
+                    Status:        validator.StatusFailure,
+                    Reason:        validator.ReasonValidatorPanic,
+                    Details:       map[string]interface{}{"experimental": true},
+                },
+            })
+            Expect(agg.PanicCount()).To(Equal(2))
+            Expect(agg.Details).To(HaveKeyWithValue("panic_count", 2))
+            Expect(agg.Details["panicked_checks"]).To(ConsistOf("a", "beta"))
+        })
+
+        It("should report failed validators with their reasons", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusFailure, Reason: "Broken"},
+            })
+            Expect(agg.Status).To(Equal(validator.StatusFailure))
+            Expect(agg.Message).To(ContainSubstring("b (Broken)"))
+            Expect(agg.Details["failed_checks"]).To(ConsistOf("b"))
+        })
+
+        It("should categorize failed validators by reason", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusFailure, Reason: validator.ReasonRequiredAPIsDisabled},
+                {ValidatorName: "b", Status: validator.StatusFailure, Reason: "HTTP_503"},
+                {ValidatorName: "c", Status: validator.StatusFailure, Reason: "Broken"},
+                {ValidatorName: "d", Status: validator.StatusWarning, Reason: validator.ReasonMTUMismatch},
+            })
+            Expect(agg.Details["failure_categories"]).To(Equal(map[string]string{
+                "a": validator.CategoryConfig,
+                "b": validator.CategoryTransient,
+                "c": validator.CategoryUnknown,
+            }))
+        })
+
+        It("should list the distinct failure reasons, sorted", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusFailure, Reason: "WIFNotConfigured"},
+                {ValidatorName: "b", Status: validator.StatusFailure, Reason: "HTTP_503"},
+                {ValidatorName: "c", Status: validator.StatusSuccess, Reason: "Passed"},
+                {ValidatorName: "d", Status: validator.StatusFailure, Reason: "WIFNotConfigured"},
+                {ValidatorName: "e", Status: validator.StatusWarning, Reason: validator.ReasonMTUMismatch},
+                {ValidatorName: "f", Status: validator.StatusFailure, Reason: "Broken"},
+            })
+            Expect(agg.Details["distinct_reasons"]).To(Equal([]string{"Broken", "HTTP_503", "WIFNotConfigured"}))
+            Expect(agg.Details["failed_checks"]).To(Equal([]string{"a", "b", "d", "f"}))
+        })
+
+        It("should pass with warnings listed separately", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusWarning, Reason: "Pending"},
+            })
+            Expect(agg.Status).To(Equal(validator.StatusSuccess))
+            Expect(agg.Reason).To(Equal("ValidationPassed"))
+            Expect(agg.Message).To(ContainSubstring("1 warning(s): b"))
+            Expect(agg.Details).To(HaveKeyWithValue("checks_passed", 2))
+            Expect(agg.Details["warning_checks"]).To(ConsistOf("b"))
+        })
+
+        It("should treat skipped validators as neutral by default", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusSkipped, Reason: "NotConfigured"},
+            })
+            Expect(agg.Status).To(Equal(validator.StatusSuccess))
+            Expect(agg.Message).To(ContainSubstring("1 skipped: b"))
+            Expect(agg.Details).To(HaveKeyWithValue("checks_passed", 1))
+            Expect(agg.Details["skipped_checks"]).To(ConsistOf("b"))
+        })
+
+        It("should fail on skipped validators when requested", func() {
+            agg := validator.Aggregate([]*validator.Result{
+                {ValidatorName: "a", Status: validator.StatusSuccess},
+                {ValidatorName: "b", Status: validator.StatusSkipped, Reason: "NotConfigured"},
+            }, validator.WithFailOnSkipped(true))
+            Expect(agg.Status).To(Equal(validator.StatusFailure))
+            Expect(agg.Message).To(ContainSubstring("b (skipped: NotConfigured)"))
+            Expect(agg.Details["failed_checks"]).To(ConsistOf("b"))
+        })
+
+        Context("with a failed experimental validator", func() {
+            var results []*validator.Result
+
+            BeforeEach(func() {
+                results = []*validator.Result{
+                    {ValidatorName: "a", Status: validator.StatusSuccess},
+                    {
+                        ValidatorName: "beta",
+                        Status:        validator.StatusFailure,
+                        Reason:        "BetaFailed",
+                        Details:       map[string]interface{}{"experimental": true},
+                    },
+                }
+            })
+
+            It("should not fail the run by default", func() {
+                agg := validator.Aggregate(results)
+                Expect(agg.Status).To(Equal(validator.StatusSuccess))
+                Expect(agg.Message).To(ContainSubstring("1 experimental failed: beta"))
+                Expect(agg.Details).To(HaveKeyWithValue("checks_passed", 1))
+                Expect(agg.Details["experimental_failed_checks"]).To(ConsistOf("beta"))
+                Expect(agg.Details).NotTo(HaveKey("failed_checks"))
+            })
+
+            It("should fail the run when experimental validators are blocking", func() {
+                agg := validator.Aggregate(results, validator.WithExperimentalBlocking(true))
+                Expect(agg.Status).To(Equal(validator.StatusFailure))
+                Expect(agg.Details["failed_checks"]).To(ConsistOf("beta"))
+            })
+        })
+
+        Describe("readiness score", func() {
+            weighted := func(name string, status validator.Status, weight int) *validator.Result {
+                return &validator.Result{
+                    ValidatorName: name,
+                    Status:        status,
+                    Details:       map[string]interface{}{"weight": weight},
+                }
+            }
+
+            It("should be the passed fraction when validators are unweighted", func() {
+                agg := validator.Aggregate([]*validator.Result{
+                    {ValidatorName: "a", Status: validator.StatusSuccess},
+                    {ValidatorName: "b", Status: validator.StatusWarning},
+                    {ValidatorName: "c", Status: validator.StatusSuccess},
+                    {ValidatorName: "d", Status: validator.StatusFailure, Reason: "Broken"},
+                })
+                Expect(agg.Details["readiness_score"]).To(BeNumerically("~", 0.75))
+            })
+
+            It("should drop further when a high-weight validator fails", func() {
+                lowFailed := validator.Aggregate([]*validator.Result{
+                    weighted("critical", validator.StatusSuccess, 3),
+                    {ValidatorName: "minor", Status: validator.StatusFailure, Reason: "Broken"},
+                })
+                highFailed := validator.Aggregate([]*validator.Result{
+                    weighted("critical", validator.StatusFailure, 3),
+                    {ValidatorName: "minor", Status: validator.StatusSuccess},
+                })
+                Expect(lowFailed.Details["readiness_score"]).To(BeNumerically("~", 0.75))
+                Expect(highFailed.Details["readiness_score"]).To(BeNumerically("~", 0.25))
+            })
+
+            It("should leave out skipped validators and non-blocking experimental failures", func() {
+                results := []*validator.Result{
+                    weighted("critical", validator.StatusSuccess, 2),
+                    {ValidatorName: "minor", Status: validator.StatusFailure, Reason: "Broken"},
+                    weighted("optional", validator.StatusSkipped, 5),
+                    {
+                        ValidatorName: "beta",
+                        Status:        validator.StatusFailure,
+                        Reason:        "BetaFailed",
+                        Details:       map[string]interface{}{"experimental": true, "weight": 4},
+                    },
+                }
+                Expect(validator.Aggregate(results).Details["readiness_score"]).To(BeNumerically("~", 2.0/3))
+
+                agg := validator.Aggregate(results, validator.WithFailOnSkipped(true), validator.WithExperimentalBlocking(true))
+                Expect(agg.Details["readiness_score"]).To(BeNumerically("~", 2.0/12))
+            })
+
+            It("should be 1 when nothing counted", func() {
+                agg := validator.Aggregate([]*validator.Result{
+                    {ValidatorName: "a", Status: validator.StatusSkipped},
+                })
+                Expect(agg.Details).To(HaveKeyWithValue("readiness_score", 1.0))
+            })
+
+            It("should treat a missing or invalid weight as the default", func() {
+                Expect((&validator.Result{}).Weight()).To(Equal(validator.DefaultWeight))
+                Expect(weighted("a", validator.StatusSuccess, 0).Weight()).To(Equal(validator.DefaultWeight))
+                Expect(weighted("a", validator.StatusSuccess, 3).Weight()).To(Equal(3))
+            })
+        })
+
+        It("should list the scopes used when given", func() {
+            results := []*validator.Result{{ValidatorName: "a", Status: validator.StatusSuccess}}
+            Expect(validator.Aggregate(results).Details).NotTo(HaveKey("scopes_used"))
+
+            agg := validator.Aggregate(results, validator.WithScopesUsed([]string{"https://www.googleapis.com/auth/compute.readonly"}))
+            Expect(agg.Details).To(HaveKeyWithValue("scopes_used", []string{"https://www.googleapis.com/auth/compute.readonly"}))
+        })
+
+        It("should include the level stats when given", func() {
+            results := []*validator.Result{{ValidatorName: "a", Status: validator.StatusSuccess}}
+            Expect(validator.Aggregate(results).Details).NotTo(HaveKey("level_stats"))
+
+            stats := []validator.LevelStats{{Level: 0, Validators: 1, Wall: time.Second, Sum: time.Second, Efficiency: 1, Slowest: "a"}}
+            agg := validator.Aggregate(results, validator.WithLevelStats(stats))
+            Expect(agg.Details).To(HaveKeyWithValue("level_stats", stats))
+        })
+
+        It("should flatten sub-results into sub-check counts", func() {
+            agg := validator.Aggregate([]*validator.Result{withSubResults})
+            Expect(agg.Details).To(HaveKeyWithValue("sub_checks_run", 2))
+            Expect(agg.Details).To(HaveKeyWithValue("sub_checks_passed", 1))
+            Expect(agg.Details["failed_sub_checks"]).To(ConsistOf("api-enabled/iam.googleapis.com"))
+            // Top-level counts are still per validator
+            Expect(agg.Details).To(HaveKeyWithValue("checks_run", 1))
+        })
+    })
+})
+
+var _ = DescribeTable("ReasonCategory",
+    func(reason, category string) {
+        Expect(validator.ReasonCategory(reason)).To(Equal(category))
+    },
+    Entry("client creation failure", validator.ReasonComputeClientError, validator.CategoryAuth),
+    Entry("GCP forbidden", "forbidden", validator.CategoryAuth),
+    Entry("HTTP 401", "HTTP_401", validator.CategoryAuth),
+    Entry("disabled APIs", validator.ReasonRequiredAPIsDisabled, validator.CategoryConfig),
+    Entry("HTTP 404", "HTTP_404", validator.CategoryConfig),
+    Entry("insufficient quota", validator.ReasonInsufficientQuota, validator.CategoryQuota),
+    Entry("GCP quotaExceeded", "quotaExceeded", validator.CategoryQuota),
+    Entry("missing route", validator.ReasonMissingRestrictedVIPRoute, validator.CategoryNetwork),
+    Entry("HTTP 429", "HTTP_429", validator.CategoryTransient),
+    Entry("HTTP 503", "HTTP_503", validator.CategoryTransient),
+    Entry("timeout", validator.ReasonTimeout, validator.CategoryTransient),
+    Entry("lookup without a GCP reason", validator.ReasonRegionCheckFailed, validator.CategoryTransient),
+    Entry("success reason", validator.ReasonAllAPIsEnabled, validator.CategoryUnknown),
+    Entry("unlisted reason", "Broken", validator.CategoryUnknown),
+    Entry("malformed HTTP reason", "HTTP_abc", validator.CategoryUnknown),
+)
+
+// fixedClock is a validator.Clock that always reads the same time
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+    return time.Time(c)
+}
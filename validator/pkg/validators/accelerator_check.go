@@ -0,0 +1,222 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "path"
+    "strings"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the region, quota and per-zone accelerator lookups
+    acceleratorCheckTimeout = 1 * time.Minute
+)
+
+// AcceleratorCheckValidator checks that a GPU type is offered in the target zones
+// and that the region's quota for it has enough headroom
+type AcceleratorCheckValidator struct{}
+
+// init registers the AcceleratorCheckValidator with the global validator registry
+func init() {
+    validator.Register(&AcceleratorCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *AcceleratorCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "accelerator-check",
+        Description: "Verify the required accelerator type is offered in the target zones and has quota",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "quota", "compute"},
+    }
+}
+
+// RequiredAPIs declares the APIs accelerator-check calls so api-enabled checks them
+func (v *AcceleratorCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_ACCELERATOR_TYPE is set
+func (v *AcceleratorCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRED_ACCELERATOR_TYPE")
+}
+
+// Validate looks the accelerator type up in each target zone, then compares the regional
+// quota headroom with REQUIRED_ACCELERATOR_COUNT
+func (v *AcceleratorCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    cfg := vctx.Config
+    accelerator := cfg.RequiredAcceleratorType
+    required := float64(cfg.RequiredAcceleratorCount)
+    metric := cfg.AcceleratorQuotaMetric
+    if metric == "" {
+        metric = acceleratorQuotaMetric(accelerator)
+    }
+
+    // Accelerator quotas are regional; without GCP_REGION the zones tell which region is meant
+    region := cfg.GCPRegion
+    if region == "" && len(cfg.AcceleratorZones) > 0 {
+        region = zoneRegion(cfg.AcceleratorZones[0])
+    }
+    if region == "" {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: "GCP_REGION or ACCELERATOR_ZONES is required to check accelerators",
+            Details: map[string]interface{}{
+                "accelerator_type": accelerator,
+                "project_id":       cfg.ProjectID,
+                "hint":             "Set GCP_REGION to the region the cluster will be created in",
+            },
+        }
+    }
+
+    slog.Info("Checking accelerator availability", "accelerator_type", accelerator, "region", region)
+
+    ctx, cancel := context.WithTimeout(ctx, acceleratorCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", cfg.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": cfg.ProjectID,
+            },
+        }
+    }
+
+    r, err := computeSvc.GetRegion(ctx, cfg.ProjectID, region)
+    if err != nil {
+        return acceleratorLookupFailure(vctx, "region "+region, err)
+    }
+
+    // Region zones are full resource URLs
+    zones := cfg.AcceleratorZones
+    if len(zones) == 0 {
+        for _, z := range r.Zones {
+            zones = append(zones, path.Base(z))
+        }
+    }
+
+    var available, unavailable []string
+    for _, zone := range zones {
+        _, err := computeSvc.GetAcceleratorType(ctx, cfg.ProjectID, zone, accelerator)
+        if err != nil {
+            var apiErr *googleapi.Error
+            if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+                unavailable = append(unavailable, zone)
+                continue
+            }
+            return acceleratorLookupFailure(vctx, fmt.Sprintf("accelerator type %s in zone %s", accelerator, zone), err)
+        }
+        available = append(available, zone)
+    }
+
+    details := map[string]interface{}{
+        "accelerator_type": accelerator,
+        "region":           region,
+        "zones":            zones,
+        "project_id":       cfg.ProjectID,
+    }
+
+    if len(unavailable) > 0 {
+        slog.Warn("Accelerator type is not offered in some zones",
+            "accelerator_type", accelerator,
+            "unavailable_zones", unavailable)
+        details["unavailable_zones"] = unavailable
+        details["hint"] = "List offering zones with: gcloud compute accelerator-types list --filter=name=<type>"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonAcceleratorUnavailable,
+            Message: fmt.Sprintf("Accelerator type %s is not offered in %d of %d zone(s): %s", accelerator, len(unavailable), len(zones), strings.Join(unavailable, ", ")),
+            Details: details,
+        }
+    }
+
+    details["quota_metric"] = metric
+    q := findQuota(r.Quotas, metric)
+    if q == nil {
+        details["hint"] = "Set ACCELERATOR_QUOTA_METRIC to the quota metric of this accelerator type"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonInsufficientAcceleratorQuota,
+            Message: fmt.Sprintf("Region %s reports no %s quota for accelerator type %s", region, metric, accelerator),
+            Details: details,
+        }
+    }
+
+    headroom := q.Limit - q.Usage
+    details["required"] = required
+    details["available"] = headroom
+    details["limit"] = q.Limit
+    details["usage"] = q.Usage
+    if headroom < required {
+        slog.Warn("Insufficient accelerator quota",
+            "metric", metric,
+            "required", required,
+            "available", headroom)
+        details["hint"] = "Request a quota increase for " + metric + " in " + region
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonInsufficientAcceleratorQuota,
+            Message: fmt.Sprintf("%s quota in %s has %.0f available, %.0f required", metric, region, headroom, required),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Accelerator type %s is offered in %d zone(s) with %.0f of %.0f required %s available",
+        accelerator, len(available), headroom, required, metric)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAcceleratorAvailable,
+        Message: message,
+        Details: details,
+    }
+}
+
+// acceleratorQuotaMetric derives the regional quota metric of an accelerator type,
+// e.g. nvidia-tesla-t4 -> NVIDIA_T4_GPUS and nvidia-l4 -> NVIDIA_L4_GPUS
+func acceleratorQuotaMetric(accelerator string) string {
+    name := strings.TrimPrefix(accelerator, "nvidia-")
+    name = strings.TrimPrefix(name, "tesla-")
+    return "NVIDIA_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_GPUS"
+}
+
+// zoneRegion returns the region of a zone, e.g. us-central1-a -> us-central1
+func zoneRegion(zone string) string {
+    if i := strings.LastIndex(zone, "-"); i > 0 {
+        return zone[:i]
+    }
+    return ""
+}
+
+// acceleratorLookupFailure builds the failure result for a failed region or accelerator lookup
+func acceleratorLookupFailure(vctx *validator.Context, what string, err error) *validator.Result {
+    slog.Error("Failed to get "+what,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonAcceleratorCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s: %v", what, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
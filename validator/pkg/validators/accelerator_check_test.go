@@ -0,0 +1,120 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("AcceleratorCheckValidator", func() {
+    var (
+        v           *validators.AcceleratorCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    const zoneURL = "https://www.googleapis.com/compute/v1/projects/test-project/zones/"
+
+    BeforeEach(func() {
+        v = &validators.AcceleratorCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("REQUIRED_ACCELERATOR_TYPE", "nvidia-tesla-t4")
+        GinkgoT().Setenv("REQUIRED_ACCELERATOR_COUNT", "4")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{
+            regions: map[string]*compute.Region{
+                "us-central1": {
+                    Name:   "us-central1",
+                    Zones:  []string{zoneURL + "us-central1-a", zoneURL + "us-central1-b"},
+                    Quotas: []*compute.Quota{{Metric: "NVIDIA_T4_GPUS", Limit: 8, Usage: 2}},
+                },
+            },
+            accelerators: map[string][]string{
+                "us-central1-a": {"nvidia-tesla-t4"},
+                "us-central1-b": {"nvidia-tesla-t4", "nvidia-l4"},
+            },
+        }
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without REQUIRED_ACCELERATOR_TYPE", func() {
+            vctx.Config.RequiredAcceleratorType = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when every zone offers the type and quota suffices", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("AcceleratorAvailable"))
+            Expect(result.Details).To(HaveKeyWithValue("zones", []string{"us-central1-a", "us-central1-b"}))
+            Expect(result.Details).To(HaveKeyWithValue("quota_metric", "NVIDIA_T4_GPUS"))
+        })
+
+        It("should fail when a zone does not offer the type", func() {
+            vctx.Config.RequiredAcceleratorType = "nvidia-l4"
+            vctx.Config.AcceleratorQuotaMetric = "NVIDIA_T4_GPUS"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("AcceleratorUnavailable"))
+            Expect(result.Details).To(HaveKeyWithValue("unavailable_zones", []string{"us-central1-a"}))
+        })
+
+        It("should only check the configured zones", func() {
+            vctx.Config.RequiredAcceleratorType = "nvidia-l4"
+            vctx.Config.AcceleratorZones = []string{"us-central1-b"}
+            computeFake.regions["us-central1"].Quotas = append(computeFake.regions["us-central1"].Quotas,
+                &compute.Quota{Metric: "NVIDIA_L4_GPUS", Limit: 4})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should fail with insufficient quota headroom", func() {
+            vctx.Config.RequiredAcceleratorCount = 7
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("InsufficientAcceleratorQuota"))
+            Expect(result.Details).To(HaveKeyWithValue("available", float64(6)))
+        })
+
+        It("should fail when the region reports no quota for the type", func() {
+            computeFake.regions["us-central1"].Quotas = nil
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("InsufficientAcceleratorQuota"))
+        })
+
+        It("should fail when the lookup errors", func() {
+            computeFake.acceleratorErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
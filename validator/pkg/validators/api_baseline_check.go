@@ -0,0 +1,142 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "path"
+    "slices"
+    "strings"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for listing the project's enabled services, all pages included
+    apiBaselineTimeout = 60 * time.Second
+)
+
+// APIBaselineCheckValidator checks that no API outside the approved baseline is enabled
+// It is the inverse of api-enabled, which checks that required APIs are present
+type APIBaselineCheckValidator struct{}
+
+// init registers the APIBaselineCheckValidator with the global validator registry
+func init() {
+    validator.Register(&APIBaselineCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *APIBaselineCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "api-baseline-check",
+        Description: "Verify no GCP API outside the approved baseline is enabled",
+        RunAfter:    []string{"api-enabled"}, // Extras only matter once the required APIs are known to be enabled
+        Tags:        []string{"post-mvp", "gcp-api", "security"},
+    }
+}
+
+// RequiredAPIs declares the APIs api-baseline-check calls so api-enabled checks them
+func (v *APIBaselineCheckValidator) RequiredAPIs() []string {
+    return []string{"serviceusage.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless CHECK_API_BASELINE is set
+func (v *APIBaselineCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CHECK_API_BASELINE")
+}
+
+// baseline returns the sorted APIs allowed to be enabled: API_BASELINE when set, else the
+// required APIs together with those declared by the planned validators
+func (v *APIBaselineCheckValidator) baseline(vctx *validator.Context) []string {
+    var apis []string
+    if len(vctx.Config.APIBaseline) > 0 {
+        apis = append(apis, vctx.Config.APIBaseline...)
+    } else {
+        apis = append(apis, vctx.Config.RequiredAPIs...)
+        for api := range vctx.PlannedAPIs() {
+            apis = append(apis, api)
+        }
+    }
+    slices.Sort(apis)
+    return slices.Compact(apis)
+}
+
+// Validate lists the project's enabled services and warns about any outside the baseline
+func (v *APIBaselineCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    baseline := v.baseline(vctx)
+    slog.Info("Checking enabled APIs against the baseline", "baseline_size", len(baseline))
+
+    ctx, cancel := context.WithTimeout(ctx, apiBaselineTimeout)
+    defer cancel()
+
+    svc, err := vctx.GetServiceUsageAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Service Usage client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonServiceUsageClientError),
+            Message: fmt.Sprintf("Failed to get Service Usage client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    services, err := svc.ListEnabledServices(ctx, fmt.Sprintf("projects/%s", vctx.Config.ProjectID))
+    if err != nil {
+        slog.Error("Failed to list enabled services",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonAPIBaselineCheckFailed),
+            Message: fmt.Sprintf("Failed to list enabled services: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant serviceusage.services.list to the validator's service account",
+            },
+        }
+    }
+
+    // Service names are "projects/<number>/services/<api>"
+    var extraAPIs []string
+    for _, s := range services {
+        if api := path.Base(s.Name); !slices.Contains(baseline, api) {
+            extraAPIs = append(extraAPIs, api)
+        }
+    }
+    slices.Sort(extraAPIs)
+
+    details := map[string]interface{}{
+        "enabled_count": len(services),
+        "baseline":      baseline,
+        "project_id":    vctx.Config.ProjectID,
+    }
+
+    if len(extraAPIs) > 0 {
+        details["extra_apis"] = extraAPIs
+        details["hint"] = "Disable the APIs with: gcloud services disable <api>, or add them to API_BASELINE if approved"
+        slog.Warn("APIs outside the baseline are enabled", "extra_apis", extraAPIs)
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonUnexpectedAPIEnabled,
+            Message: fmt.Sprintf("%d enabled API(s) are not in the baseline: %s", len(extraAPIs), strings.Join(extraAPIs, ", ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("All %d enabled API(s) are in the baseline", len(services))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAPIBaselineMatched,
+        Message: message,
+        Details: details,
+    }
+}
@@ -0,0 +1,94 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("APIBaselineCheckValidator", func() {
+    var (
+        v     *validators.APIBaselineCheckValidator
+        vctx  *validator.Context
+        usage *fakeServiceUsage
+    )
+
+    BeforeEach(func() {
+        v = &validators.APIBaselineCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("CHECK_API_BASELINE", "true")
+        GinkgoT().Setenv("REQUIRED_APIS", "compute.googleapis.com,iam.googleapis.com")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        usage = &fakeServiceUsage{states: map[string]string{
+            "projects/test-project/services/compute.googleapis.com":      "ENABLED",
+            "projects/test-project/services/iam.googleapis.com":          "ENABLED",
+            "projects/test-project/services/serviceusage.googleapis.com": "ENABLED",
+            "projects/test-project/services/bigquery.googleapis.com":     "DISABLED",
+        }}
+        vctx.SetServiceUsageAPI(usage)
+        vctx.SetPlannedAPIs(map[string][]string{"serviceusage.googleapis.com": {"api-baseline-check"}})
+    })
+
+    Describe("Enabled", func() {
+        It("should follow CHECK_API_BASELINE", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.CheckAPIBaseline = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should pass when every enabled API is required or planned", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("APIBaselineMatched"))
+            Expect(result.Details).To(HaveKeyWithValue("enabled_count", 3))
+        })
+
+        It("should warn listing enabled APIs outside the baseline", func() {
+            usage.states["projects/test-project/services/bigquery.googleapis.com"] = "ENABLED"
+            usage.states["projects/test-project/services/appengine.googleapis.com"] = "ENABLED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("UnexpectedAPIEnabled"))
+            Expect(result.Details["extra_apis"]).To(Equal([]string{"appengine.googleapis.com", "bigquery.googleapis.com"}))
+        })
+
+        It("should compare against API_BASELINE instead of the required APIs when set", func() {
+            vctx.Config.APIBaseline = []string{"compute.googleapis.com", "serviceusage.googleapis.com"}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Details["extra_apis"]).To(Equal([]string{"iam.googleapis.com"}))
+            Expect(result.Details["baseline"]).To(Equal([]string{"compute.googleapis.com", "serviceusage.googleapis.com"}))
+        })
+
+        It("should fail when the enabled services cannot be listed", func() {
+            usage.errs = map[string]error{
+                "projects/test-project": &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
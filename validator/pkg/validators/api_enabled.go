@@ -2,42 +2,28 @@ package validators
 
 import (
     "context"
-    "errors"
     "fmt"
     "log/slog"
+    "slices"
+    "sort"
+    "strings"
     "time"
 
-    "google.golang.org/api/googleapi"
+    "validator/pkg/gcp"
     "validator/pkg/validator"
 )
 
 const (
-    // Timeout for overall API validation
+    // Default time limit for the whole validator, applied by the executor via metadata
     apiValidationTimeout = 2 * time.Minute
     // Timeout for individual API check requests
     apiRequestTimeout = 30 * time.Second
 )
 
 // extractErrorReason extracts a structured error reason from GCP API errors
-// Prioritizes GCP-specific error reasons, falls back to HTTP status code
+// Kept as a thin wrapper over gcp.ExtractReason for the validators in this package
 func extractErrorReason(err error, fallbackReason string) string {
-    if err == nil {
-        return fallbackReason
-    }
-
-    var apiErr *googleapi.Error
-    if errors.As(err, &apiErr) {
-        // First, try to get GCP-specific reason (more detailed)
-        if len(apiErr.Errors) > 0 && apiErr.Errors[0].Reason != "" {
-            return apiErr.Errors[0].Reason
-        }
-
-        // No specific reason provided, return generic HTTP code
-        return fmt.Sprintf("HTTP_%d", apiErr.Code)
-    }
-
-    // Not a GCP API error, use fallback
-    return fallbackReason
+    return gcp.ExtractReason(err, fallbackReason)
 }
 
 // APIEnabledValidator checks if required GCP APIs are enabled
@@ -55,20 +41,59 @@ func (v *APIEnabledValidator) Metadata() validator.ValidatorMetadata {
         Description: "Verify required GCP APIs are enabled in the target project",
         RunAfter:    []string{}, // No dependencies - WIF is implicitly validated when API calls succeed
         Tags:        []string{"mvp", "gcp-api"},
+        // Overridable with VALIDATOR_API_ENABLED_TIMEOUT_SECONDS
+        DefaultTimeout: apiValidationTimeout,
+        // Every GCP validator depends on the APIs being enabled
+        Weight: 3,
+    }
+}
+
+// Describe lists the APIs that will be checked, REQUIRED_APIS and those added for the planned validators
+func (v *APIEnabledValidator) Describe(vctx *validator.Context) string {
+    apis, autoAdded := withPlannedAPIs(vctx)
+    if len(apis) == 0 {
+        return fmt.Sprintf("no required APIs configured for project %s", vctx.Config.ProjectID)
+    }
+    description := fmt.Sprintf("will verify APIs %s are enabled in project %s", strings.Join(apis, ", "), vctx.Config.ProjectID)
+    if len(autoAdded) > 0 {
+        added := make([]string, 0, len(autoAdded))
+        for api := range autoAdded {
+            added = append(added, api)
+        }
+        sort.Strings(added)
+        description += fmt.Sprintf(" (%s added for the enabled validators)", strings.Join(added, ", "))
     }
+    return description
 }
 
 // Validate performs the actual validation logic to check if required GCP APIs are enabled
 func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
     slog.Info("Checking if required GCP APIs are enabled")
 
-    // Add timeout for overall validation
-    ctx, cancel := context.WithTimeout(ctx, apiValidationTimeout)
-    defer cancel()
+    // Guard against an accidentally blanked API list silently passing
+    // Checked before client creation so no credentials are requested, and before the planned
+    // validators' APIs are merged in, as those would otherwise always make the list non-empty
+    if len(vctx.Config.RequiredAPIs) == 0 && vctx.Config.FailOnEmptyAPIList {
+        slog.Error("No required APIs configured", "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonNoAPIsConfigured,
+            Message: "No required APIs configured to validate",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set REQUIRED_APIS or disable FAIL_ON_EMPTY_API_LIST",
+            },
+        }
+    }
+
+    requiredAPIs, autoAdded := withPlannedAPIs(vctx)
+    if len(autoAdded) > 0 {
+        slog.Info("Adding APIs required by enabled validators", "apis", autoAdded)
+    }
 
-    // Get Service Usage client from context (lazy initialization with least privilege)
+    // Get Service Usage API from context (lazy initialization with least privilege)
     // Only requests serviceusage.readonly scope when this validator actually runs
-    svc, err := vctx.GetServiceUsageService(ctx)
+    svc, err := vctx.GetServiceUsageAPI(ctx)
     if err != nil {
         // Log full error for debugging
         slog.Error("Failed to get Service Usage client",
@@ -76,7 +101,7 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
             "project_id", vctx.Config.ProjectID)
 
         // Extract structured reason
-        reason := extractErrorReason(err, "ServiceUsageClientError")
+        reason := extractErrorReason(err, validator.ReasonServiceUsageClientError)
 
         return &validator.Result{
             Status:  validator.StatusFailure,
@@ -92,9 +117,14 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
     }
 
     // Check each required API
-    requiredAPIs := vctx.Config.RequiredAPIs
     enabledAPIs := []string{}
     disabledAPIs := []string{}
+    disabledStates := map[string]string{}
+    subResults := make([]*validator.Result, 0, len(requiredAPIs))
+
+    // Per-API debug lines are sampled so long API lists don't flood DEBUG logs
+    itemLog, flushItemLog := validator.NewSampledLogger(slog.Default(), vctx.Config.LogSampleRate)
+    defer flushItemLog()
 
     for _, apiName := range requiredAPIs {
         // Add per-request timeout
@@ -102,8 +132,8 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
 
         serviceName := fmt.Sprintf("projects/%s/services/%s", vctx.Config.ProjectID, apiName)
 
-        slog.Debug("Checking API", "api", apiName)
-        service, err := svc.Services.Get(serviceName).Context(reqCtx).Do()
+        itemLog.Debug("Checking API", "api", apiName)
+        service, err := svc.GetService(reqCtx, serviceName)
         reqCancel() // Clean up context
 
         if err != nil {
@@ -115,7 +145,7 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
                 "service_name", serviceName)
 
             // Extract structured reason
-            reason := extractErrorReason(err, "APICheckFailed")
+            reason := extractErrorReason(err, validator.ReasonAPICheckFailed)
 
             return &validator.Result{
                 Status:  validator.StatusFailure,
@@ -131,12 +161,36 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
             }
         }
 
-        if service.State == "ENABLED" {
+        switch {
+        case service.State == "ENABLED":
+            enabledAPIs = append(enabledAPIs, apiName)
+            itemLog.Debug("API is enabled", "api", apiName)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonAPIEnabled,
+                Message:       fmt.Sprintf("API %s is enabled", apiName),
+            })
+        case isAcceptableAPIState(service.State, vctx.Config.AcceptableAPIStates):
+            // Transitional states operators opted into via ACCEPTABLE_API_STATES
             enabledAPIs = append(enabledAPIs, apiName)
-            slog.Debug("API is enabled", "api", apiName)
-        } else {
+            slog.Info("API state accepted", "api", apiName, "state", service.State)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonAPIStateAccepted,
+                Message:       fmt.Sprintf("API %s is in accepted state %s", apiName, service.State),
+            })
+        default:
             disabledAPIs = append(disabledAPIs, apiName)
+            disabledStates[apiName] = service.State
             slog.Warn("API is NOT enabled", "api", apiName, "state", service.State)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusFailure,
+                Reason:        validator.ReasonAPIDisabled,
+                Message:       fmt.Sprintf("API %s is not enabled (state: %s)", apiName, service.State),
+            })
         }
     }
 
@@ -144,14 +198,36 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
     if len(disabledAPIs) > 0 {
         return &validator.Result{
             Status:  validator.StatusFailure,
-            Reason:  "RequiredAPIsDisabled",
+            Reason:  validator.ReasonRequiredAPIsDisabled,
             Message: fmt.Sprintf("%d required API(s) are not enabled", len(disabledAPIs)),
             Details: map[string]interface{}{
-                "disabled_apis": disabledAPIs,
-                "enabled_apis":  enabledAPIs,
-                "project_id":    vctx.Config.ProjectID,
-                "hint":          "Enable APIs with: gcloud services enable <api-name>",
+                "disabled_apis":   disabledAPIs,
+                "disabled_states": disabledStates,
+                "accepted_states": vctx.Config.AcceptableAPIStates,
+                "enabled_apis":    enabledAPIs,
+                "project_id":      vctx.Config.ProjectID,
+                "hint":            "Enable APIs with: gcloud services enable <api-name>",
+                "auto_added_apis": autoAdded,
             },
+            SubResults: subResults,
+        }
+    }
+
+    // Enabled APIs whose prerequisites are off tend to fail later in confusing ways, so warn about them
+    if missing := missingPrerequisites(ctx, svc, vctx, enabledAPIs); len(missing) > 0 {
+        slog.Warn("Enabled APIs are missing prerequisite APIs", "missing_prerequisites", missing)
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonAPIPrerequisiteMissing,
+            Message: fmt.Sprintf("%d enabled API(s) have prerequisite APIs that are not enabled", len(missing)),
+            Details: map[string]interface{}{
+                "missing_prerequisites": missing,
+                "enabled_apis":          enabledAPIs,
+                "auto_added_apis":       autoAdded,
+                "project_id":            vctx.Config.ProjectID,
+                "hint":                  "Enable the prerequisites with: gcloud services enable <api-name>, or adjust API_PREREQUISITES",
+            },
+            SubResults: subResults,
         }
     }
 
@@ -164,11 +240,85 @@ func (v *APIEnabledValidator) Validate(ctx context.Context, vctx *validator.Cont
 
     return &validator.Result{
         Status:  validator.StatusSuccess,
-        Reason:  "AllAPIsEnabled",
+        Reason:  validator.ReasonAllAPIsEnabled,
         Message: message,
         Details: map[string]interface{}{
-            "enabled_apis": enabledAPIs,
-            "project_id":   vctx.Config.ProjectID,
+            "enabled_apis":    enabledAPIs,
+            "auto_added_apis": autoAdded,
+            "project_id":      vctx.Config.ProjectID,
         },
+        SubResults: subResults,
+    }
+}
+
+// missingPrerequisites returns, for each enabled API, its API_PREREQUISITES entries that are not enabled
+// Prerequisites outside the checked list are looked up; a failed lookup is logged and not reported as missing
+func missingPrerequisites(ctx context.Context, svc gcp.ServiceUsageAPI, vctx *validator.Context, enabledAPIs []string) map[string][]string {
+    // Whether each API counts as enabled, seeded with the checked APIs and filled in by lookups
+    enabled := make(map[string]bool, len(enabledAPIs))
+    for _, api := range enabledAPIs {
+        enabled[api] = true
+    }
+    isEnabled := func(api, requiredBy string) bool {
+        if ok, known := enabled[api]; known {
+            return ok
+        }
+        reqCtx, reqCancel := context.WithTimeout(ctx, apiRequestTimeout)
+        defer reqCancel()
+        service, err := svc.GetService(reqCtx, fmt.Sprintf("projects/%s/services/%s", vctx.Config.ProjectID, api))
+        if err != nil {
+            slog.Warn("Failed to check prerequisite API", "api", api, "required_by", requiredBy, "error", err.Error())
+            enabled[api] = true
+            return true
+        }
+        enabled[api] = service.State == "ENABLED" || isAcceptableAPIState(service.State, vctx.Config.AcceptableAPIStates)
+        return enabled[api]
+    }
+
+    missing := map[string][]string{}
+    for _, api := range enabledAPIs {
+        for _, prereq := range vctx.Config.APIPrerequisites[api] {
+            if !isEnabled(prereq, api) {
+                missing[api] = append(missing[api], prereq)
+            }
+        }
+    }
+    return missing
+}
+
+// withPlannedAPIs returns REQUIRED_APIS followed by the APIs the planned validators declare that it lacks,
+// and those added APIs mapped to the validators needing them
+func withPlannedAPIs(vctx *validator.Context) ([]string, map[string][]string) {
+    requiredAPIs := vctx.Config.RequiredAPIs
+    autoAdded := map[string][]string{}
+    if !vctx.Config.AutoRequiredAPIs {
+        return requiredAPIs, autoAdded
+    }
+
+    planned := vctx.PlannedAPIs()
+    apis := make([]string, 0, len(planned))
+    for api := range planned {
+        if !slices.Contains(requiredAPIs, api) {
+            apis = append(apis, api)
+        }
+    }
+    if len(apis) == 0 {
+        return requiredAPIs, autoAdded
+    }
+
+    sort.Strings(apis)
+    for _, api := range apis {
+        autoAdded[api] = planned[api]
+    }
+    return append(slices.Clone(requiredAPIs), apis...), autoAdded
+}
+
+// isAcceptableAPIState reports whether a Service Usage state is in the configured allowlist
+func isAcceptableAPIState(state string, acceptable []string) bool {
+    for _, s := range acceptable {
+        if s == state {
+            return true
+        }
     }
+    return false
 }
@@ -1,11 +1,13 @@
 package validators_test
 
 import (
+    "context"
     "log/slog"
     "os"
 
     . "github.com/onsi/ginkgo/v2"
     . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
 
     "validator/pkg/config"
     "validator/pkg/validator"
@@ -138,8 +140,180 @@ var _ = Describe("APIEnabledValidator", func() {
         })
     })
 
-    // Note: Testing Validate() method requires either:
-    // 1. A real GCP project with Service Usage API enabled (integration test)
-    // 2. Mocked GCP client (complex setup)
-    // These tests would be added in integration test suite
+    Describe("Describe", func() {
+        It("should list the configured APIs and the ones added for planned validators", func() {
+            vctx.Config.RequiredAPIs = []string{"compute.googleapis.com"}
+            vctx.SetPlannedAPIs(map[string][]string{"file.googleapis.com": {"filestore-check"}})
+
+            Expect(v.Describe(vctx)).To(Equal("will verify APIs compute.googleapis.com, file.googleapis.com are enabled " +
+                "in project test-project (file.googleapis.com added for the enabled validators)"))
+        })
+    })
+
+    Describe("Validate with empty API list", func() {
+        BeforeEach(func() {
+            vctx.Config.RequiredAPIs = []string{}
+        })
+
+        Context("when FAIL_ON_EMPTY_API_LIST is enabled", func() {
+            BeforeEach(func() {
+                vctx.Config.FailOnEmptyAPIList = true
+            })
+
+            It("should fail without contacting GCP", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result).NotTo(BeNil())
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("NoAPIsConfigured"))
+                Expect(result.Details).To(HaveKeyWithValue("project_id", "test-project"))
+            })
+
+            It("should fail even when the planned validators declare APIs", func() {
+                vctx.Config.AutoRequiredAPIs = true
+                vctx.SetPlannedAPIs(map[string][]string{"compute.googleapis.com": {"connectivity-check"}})
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("NoAPIsConfigured"))
+            })
+        })
+    })
+
+    Describe("Validate with fake Service Usage API", func() {
+        var fake *fakeServiceUsage
+
+        BeforeEach(func() {
+            vctx.Config.RequiredAPIs = []string{"compute.googleapis.com", "iam.googleapis.com"}
+            fake = &fakeServiceUsage{
+                states: map[string]string{
+                    "projects/test-project/services/compute.googleapis.com": "ENABLED",
+                    "projects/test-project/services/iam.googleapis.com":     "ENABLED",
+                },
+            }
+            vctx.SetServiceUsageAPI(fake)
+        })
+
+        It("should succeed when all APIs are enabled", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("AllAPIsEnabled"))
+            Expect(result.Details["enabled_apis"]).To(ConsistOf("compute.googleapis.com", "iam.googleapis.com"))
+        })
+
+        It("should report one sub-result per API", func() {
+            fake.states["projects/test-project/services/iam.googleapis.com"] = "DISABLED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.SubResults).To(HaveLen(2))
+            Expect(result.SubResults[0].ValidatorName).To(Equal("compute.googleapis.com"))
+            Expect(result.SubResults[0].Status).To(Equal(validator.StatusSuccess))
+            Expect(result.SubResults[1].ValidatorName).To(Equal("iam.googleapis.com"))
+            Expect(result.SubResults[1].Status).To(Equal(validator.StatusFailure))
+            Expect(result.SubResults[1].Reason).To(Equal("APIDisabled"))
+        })
+
+        It("should fail when an API is disabled", func() {
+            fake.states["projects/test-project/services/iam.googleapis.com"] = "DISABLED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("RequiredAPIsDisabled"))
+            Expect(result.Details["disabled_apis"]).To(ConsistOf("iam.googleapis.com"))
+            Expect(result.Details["disabled_states"]).To(HaveKeyWithValue("iam.googleapis.com", "DISABLED"))
+        })
+
+        It("should reject STATE_UNSPECIFIED by default", func() {
+            fake.states["projects/test-project/services/iam.googleapis.com"] = "STATE_UNSPECIFIED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Details["disabled_states"]).To(HaveKeyWithValue("iam.googleapis.com", "STATE_UNSPECIFIED"))
+        })
+
+        It("should pass an API whose state is in ACCEPTABLE_API_STATES", func() {
+            vctx.Config.AcceptableAPIStates = []string{"ENABLED", "STATE_UNSPECIFIED"}
+            fake.states["projects/test-project/services/iam.googleapis.com"] = "STATE_UNSPECIFIED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details["enabled_apis"]).To(ConsistOf("compute.googleapis.com", "iam.googleapis.com"))
+            Expect(result.SubResults[1].Reason).To(Equal("APIStateAccepted"))
+        })
+
+        It("should also check APIs declared by planned validators", func() {
+            vctx.SetPlannedAPIs(map[string][]string{
+                "compute.googleapis.com":           {"image-check"},
+                "iamcredentials.googleapis.com":    {"impersonation-check"},
+                "servicenetworking.googleapis.com": {"private-service-access-check"},
+            })
+            fake.states["projects/test-project/services/iamcredentials.googleapis.com"] = "ENABLED"
+            fake.states["projects/test-project/services/servicenetworking.googleapis.com"] = "DISABLED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Details["disabled_apis"]).To(ConsistOf("servicenetworking.googleapis.com"))
+            Expect(result.Details["auto_added_apis"]).To(Equal(map[string][]string{
+                "iamcredentials.googleapis.com":    {"impersonation-check"},
+                "servicenetworking.googleapis.com": {"private-service-access-check"},
+            }))
+            Expect(result.SubResults).To(HaveLen(4))
+            Expect(result.SubResults[2].ValidatorName).To(Equal("iamcredentials.googleapis.com"))
+        })
+
+        It("should ignore planned APIs when AUTO_REQUIRED_APIS is off", func() {
+            vctx.Config.AutoRequiredAPIs = false
+            vctx.SetPlannedAPIs(map[string][]string{"servicenetworking.googleapis.com": {"private-service-access-check"}})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details["auto_added_apis"]).To(BeEmpty())
+        })
+
+        Context("with API prerequisites", func() {
+            BeforeEach(func() {
+                vctx.Config.RequiredAPIs = []string{"container.googleapis.com"}
+                fake.states["projects/test-project/services/container.googleapis.com"] = "ENABLED"
+            })
+
+            It("should pass when the prerequisites are enabled", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.Reason).To(Equal("AllAPIsEnabled"))
+            })
+
+            It("should warn when an enabled API's prerequisite is not enabled", func() {
+                fake.states["projects/test-project/services/compute.googleapis.com"] = "DISABLED"
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusWarning))
+                Expect(result.Reason).To(Equal("APIPrerequisiteMissing"))
+                Expect(result.Details["missing_prerequisites"]).To(Equal(map[string][]string{
+                    "container.googleapis.com": {"compute.googleapis.com"},
+                }))
+            })
+
+            It("should use the configured prerequisites instead of the built-in ones", func() {
+                vctx.Config.APIPrerequisites = map[string][]string{"container.googleapis.com": {"gkehub.googleapis.com"}}
+                fake.states["projects/test-project/services/gkehub.googleapis.com"] = "DISABLED"
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusWarning))
+                Expect(result.Details["missing_prerequisites"]).To(HaveKeyWithValue("container.googleapis.com", []string{"gkehub.googleapis.com"}))
+            })
+        })
+
+        It("should surface the GCP error reason when a lookup fails", func() {
+            fake.errs = map[string]error{
+                "projects/test-project/services/compute.googleapis.com": &googleapi.Error{
+                    Code:   403,
+                    Errors: []googleapi.ErrorItem{{Reason: "forbidden"}},
+                },
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+            Expect(result.Details).To(HaveKeyWithValue("api", "compute.googleapis.com"))
+        })
+    })
 })
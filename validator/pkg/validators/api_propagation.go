@@ -0,0 +1,222 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "sort"
+    "strings"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for overall API propagation validation
+    apiPropagationTimeout = 2 * time.Minute
+    // Timeout for individual API probe requests
+    apiProbeTimeout = 30 * time.Second
+)
+
+// apiProbe makes a lightweight real call against a single API
+// A nil error means the API answered, regardless of the resource outcome
+type apiProbe func(ctx context.Context, vctx *validator.Context) error
+
+// apiProbes maps required API names to their probe
+// APIs without a probe are reported as unprobed rather than guessed at
+var apiProbes = map[string]apiProbe{
+    "compute.googleapis.com": func(ctx context.Context, vctx *validator.Context) error {
+        svc, err := vctx.GetComputeAPI(ctx)
+        if err != nil {
+            return err
+        }
+        _, err = svc.GetProject(ctx, vctx.Config.ProjectID)
+        return err
+    },
+    "cloudresourcemanager.googleapis.com": func(ctx context.Context, vctx *validator.Context) error {
+        svc, err := vctx.GetResourceManagerAPI(ctx)
+        if err != nil {
+            return err
+        }
+        _, err = svc.GetProject(ctx, vctx.Config.ProjectID)
+        return err
+    },
+    "iam.googleapis.com": func(ctx context.Context, vctx *validator.Context) error {
+        svc, err := vctx.GetIAMAPI(ctx)
+        if err != nil {
+            return err
+        }
+        // The account need not exist: a 404 proves IAM is serving requests for the project
+        name := fmt.Sprintf("projects/%s/serviceAccounts/api-propagation-probe@%s.iam.gserviceaccount.com",
+            vctx.Config.ProjectID, vctx.Config.ProjectID)
+        _, err = svc.GetServiceAccount(ctx, name)
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return nil
+        }
+        return err
+    },
+}
+
+// isServiceDisabled reports whether err is the 403 GCP returns for an API that is not (yet) enabled
+func isServiceDisabled(err error) bool {
+    var apiErr *googleapi.Error
+    if !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+        return false
+    }
+    for _, e := range apiErr.Errors {
+        if e.Reason == "accessNotConfigured" || e.Reason == "SERVICE_DISABLED" {
+            return true
+        }
+    }
+    return strings.Contains(apiErr.Message, "SERVICE_DISABLED") || strings.Contains(apiErr.Body, "SERVICE_DISABLED")
+}
+
+// APIPropagationValidator checks that enabled APIs actually answer requests
+// An API can report ENABLED while its enablement is still propagating
+// It only probes APIs listed in REQUIRED_APIS, so it declares no RequiredAPIs of its own
+type APIPropagationValidator struct{}
+
+// init registers the APIPropagationValidator with the global validator registry
+func init() {
+    validator.Register(&APIPropagationValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *APIPropagationValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "api-propagation-check",
+        Description: "Verify enabled GCP APIs have finished propagating and answer real requests",
+        RunAfter:    []string{"api-enabled"}, // Only meaningful once the APIs report ENABLED
+        Tags:        []string{"post-mvp", "gcp-api"},
+    }
+}
+
+// Enabled drops the validator from the plan unless CHECK_API_PROPAGATION is set
+func (v *APIPropagationValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CHECK_API_PROPAGATION")
+}
+
+// Validate probes each required API and warns when any still reports SERVICE_DISABLED
+func (v *APIPropagationValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    if !vctx.Config.CheckAPIPropagation {
+        slog.Info("API propagation check disabled, skipping")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonAPIPropagationCheckDisabled,
+            Message: "API propagation check is disabled",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set CHECK_API_PROPAGATION=true to probe each required API",
+            },
+        }
+    }
+
+    slog.Info("Checking that required GCP APIs have propagated")
+
+    // Add timeout for overall validation
+    ctx, cancel := context.WithTimeout(ctx, apiPropagationTimeout)
+    defer cancel()
+
+    propagatedAPIs := []string{}
+    pendingAPIs := []string{}
+    unprobedAPIs := []string{}
+    var failedAPIs []string
+    var subResults []*validator.Result
+
+    for _, apiName := range vctx.Config.RequiredAPIs {
+        probe, ok := apiProbes[apiName]
+        if !ok {
+            unprobedAPIs = append(unprobedAPIs, apiName)
+            continue
+        }
+
+        reqCtx, reqCancel := context.WithTimeout(ctx, apiProbeTimeout)
+        slog.Debug("Probing API", "api", apiName)
+        err := probe(reqCtx, vctx)
+        reqCancel()
+
+        switch {
+        case err == nil:
+            propagatedAPIs = append(propagatedAPIs, apiName)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonAPIPropagated,
+                Message:       fmt.Sprintf("API %s answered a probe request", apiName),
+            })
+        case isServiceDisabled(err):
+            pendingAPIs = append(pendingAPIs, apiName)
+            slog.Warn("API enabled but not yet propagated", "api", apiName)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusWarning,
+                Reason:        validator.ReasonAPIPropagationPending,
+                Message:       fmt.Sprintf("API %s still reports SERVICE_DISABLED", apiName),
+            })
+        default:
+            failedAPIs = append(failedAPIs, apiName)
+            slog.Error("Failed to probe API",
+                "api", apiName,
+                "error", err.Error(),
+                "project_id", vctx.Config.ProjectID)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusFailure,
+                Reason:        extractErrorReason(err, validator.ReasonAPIProbeFailed),
+                Message:       fmt.Sprintf("Failed to probe API %s: %v", apiName, err),
+            })
+        }
+    }
+    sort.Strings(unprobedAPIs)
+
+    if len(failedAPIs) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonAPIProbeFailed,
+            Message: fmt.Sprintf("%d API probe(s) failed", len(failedAPIs)),
+            Details: map[string]interface{}{
+                "failed_apis":     failedAPIs,
+                "pending_apis":    pendingAPIs,
+                "propagated_apis": propagatedAPIs,
+                "unprobed_apis":   unprobedAPIs,
+                "project_id":      vctx.Config.ProjectID,
+            },
+            SubResults: subResults,
+        }
+    }
+
+    if len(pendingAPIs) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonAPIPropagationPending,
+            Message: fmt.Sprintf("%d enabled API(s) have not finished propagating", len(pendingAPIs)),
+            Details: map[string]interface{}{
+                "pending_apis":    pendingAPIs,
+                "propagated_apis": propagatedAPIs,
+                "unprobed_apis":   unprobedAPIs,
+                "project_id":      vctx.Config.ProjectID,
+                "hint":            "API enablement can take a few minutes to propagate; retry shortly",
+            },
+            SubResults: subResults,
+        }
+    }
+
+    message := fmt.Sprintf("All %d probed API(s) have propagated", len(propagatedAPIs))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAPIsPropagated,
+        Message: message,
+        Details: map[string]interface{}{
+            "propagated_apis": propagatedAPIs,
+            "unprobed_apis":   unprobedAPIs,
+            "project_id":      vctx.Config.ProjectID,
+        },
+        SubResults: subResults,
+    }
+}
@@ -0,0 +1,125 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("APIPropagationValidator", func() {
+    var (
+        v       *validators.APIPropagationValidator
+        vctx    *validator.Context
+        compute *fakeCompute
+    )
+
+    serviceDisabled := &googleapi.Error{
+        Code:    403,
+        Message: "Compute Engine API has not been used in project test-project before or it is disabled",
+        Errors:  []googleapi.ErrorItem{{Reason: "accessNotConfigured"}},
+    }
+
+    BeforeEach(func() {
+        v = &validators.APIPropagationValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("CHECK_API_PROPAGATION", "true")
+        GinkgoT().Setenv("REQUIRED_APIS", "compute.googleapis.com,iam.googleapis.com,storage.googleapis.com")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        compute = &fakeCompute{}
+        vctx.SetComputeAPI(compute)
+        vctx.SetIAMAPI(&fakeIAM{})
+        vctx.SetResourceManagerAPI(&fakeResourceManager{project: &cloudresourcemanager.Project{}})
+    })
+
+    Describe("Metadata", func() {
+        It("should return correct metadata", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("api-propagation-check"))
+            Expect(meta.RunAfter).To(ConsistOf("api-enabled"))
+        })
+
+        It("should not add APIs to REQUIRED_APIS", func() {
+            var validatorIface validator.Validator = v
+            _, ok := validatorIface.(validator.APIRequirer)
+            Expect(ok).To(BeFalse())
+        })
+    })
+
+    Describe("Enabled", func() {
+        It("should follow CHECK_API_PROPAGATION", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.CheckAPIPropagation = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should skip when CHECK_API_PROPAGATION is unset", func() {
+            vctx.Config.CheckAPIPropagation = false
+            compute.projectErr = serviceDisabled
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+            Expect(result.Reason).To(Equal("APIPropagationCheckDisabled"))
+        })
+
+        It("should succeed when every probed API answers", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("APIsPropagated"))
+            Expect(result.Details["propagated_apis"]).To(ConsistOf("compute.googleapis.com", "iam.googleapis.com"))
+            Expect(result.Details["unprobed_apis"]).To(ConsistOf("storage.googleapis.com"))
+            Expect(result.SubResults).To(HaveLen(2))
+        })
+
+        It("should warn when an API still reports SERVICE_DISABLED", func() {
+            compute.projectErr = serviceDisabled
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("APIPropagationPending"))
+            Expect(result.Details["pending_apis"]).To(ConsistOf("compute.googleapis.com"))
+        })
+
+        It("should recognise SERVICE_DISABLED in the error body", func() {
+            compute.projectErr = &googleapi.Error{
+                Code: 403,
+                Body: `{"error":{"details":[{"reason":"SERVICE_DISABLED"}]}}`,
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("APIPropagationPending"))
+        })
+
+        It("should fail when a probe fails for another reason", func() {
+            compute.projectErr = &googleapi.Error{
+                Code:   403,
+                Errors: []googleapi.ErrorItem{{Reason: "forbidden"}},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("APIProbeFailed"))
+            Expect(result.Details["failed_apis"]).To(ConsistOf("compute.googleapis.com"))
+        })
+    })
+})
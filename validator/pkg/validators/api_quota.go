@@ -0,0 +1,197 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "sort"
+    "strings"
+    "time"
+
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for overall API quota validation
+    apiQuotaTimeout = 2 * time.Minute
+    // Timeout for listing the quota metrics of a single API
+    apiQuotaRequestTimeout = 30 * time.Second
+)
+
+// APIQuotaValidator checks that required APIs have no zeroed consumer quota
+// An API can be ENABLED yet unusable because an override set one of its quotas to zero
+type APIQuotaValidator struct{}
+
+// init registers the APIQuotaValidator with the global validator registry
+func init() {
+    validator.Register(&APIQuotaValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *APIQuotaValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "api-quota-check",
+        Description: "Warn when an enabled GCP API has a consumer quota limit of zero",
+        RunAfter:    []string{"api-enabled"}, // Only meaningful once the APIs report ENABLED
+        Tags:        []string{"post-mvp", "gcp-api"},
+    }
+}
+
+// Enabled drops the validator from the plan unless CHECK_API_QUOTAS is set
+// Reading consumer quotas costs one extra call per required API
+func (v *APIQuotaValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CHECK_API_QUOTAS")
+}
+
+// Validate lists the consumer quota metrics of each required API and warns on any zero limit
+func (v *APIQuotaValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    if !vctx.Config.CheckAPIQuotas {
+        slog.Info("API quota check disabled, skipping")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonAPIQuotaCheckDisabled,
+            Message: "API quota check is disabled",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set CHECK_API_QUOTAS=true to read consumer quotas of each required API",
+            },
+        }
+    }
+
+    slog.Info("Checking consumer quotas of required GCP APIs")
+
+    // Add timeout for overall validation
+    ctx, cancel := context.WithTimeout(ctx, apiQuotaTimeout)
+    defer cancel()
+
+    svc, err := vctx.GetServiceQuotaAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Service Usage v1beta1 client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonServiceUsageClientError),
+            Message: fmt.Sprintf("Failed to get Service Usage v1beta1 client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    zeroQuotas := []string{}
+    var failedAPIs []string
+    var subResults []*validator.Result
+
+    for _, apiName := range vctx.Config.RequiredAPIs {
+        parent := fmt.Sprintf("projects/%s/services/%s", vctx.Config.ProjectID, apiName)
+
+        reqCtx, reqCancel := context.WithTimeout(ctx, apiQuotaRequestTimeout)
+        slog.Debug("Listing consumer quota metrics", "api", apiName)
+        metrics, err := svc.ListConsumerQuotaMetrics(reqCtx, parent)
+        reqCancel()
+
+        if err != nil {
+            failedAPIs = append(failedAPIs, apiName)
+            slog.Error("Failed to list consumer quota metrics",
+                "api", apiName,
+                "error", err.Error(),
+                "project_id", vctx.Config.ProjectID)
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusFailure,
+                Reason:        extractErrorReason(err, validator.ReasonAPIQuotaCheckFailed),
+                Message:       fmt.Sprintf("Failed to list consumer quotas of API %s: %v", apiName, err),
+            })
+            continue
+        }
+
+        zeros := zeroQuotaLimits(metrics, vctx.Config.GCPRegion)
+        if len(zeros) == 0 {
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: apiName,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonAPIQuotaAvailable,
+                Message:       fmt.Sprintf("API %s has no zero consumer quota", apiName),
+            })
+            continue
+        }
+
+        slog.Warn("API has zero consumer quota", "api", apiName, "quotas", zeros)
+        zeroQuotas = append(zeroQuotas, zeros...)
+        subResults = append(subResults, &validator.Result{
+            ValidatorName: apiName,
+            Status:        validator.StatusWarning,
+            Reason:        validator.ReasonAPIQuotaZero,
+            Message:       fmt.Sprintf("API %s has %d zero quota limit(s): %s", apiName, len(zeros), strings.Join(zeros, ", ")),
+        })
+    }
+
+    if len(failedAPIs) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonAPIQuotaCheckFailed,
+            Message: fmt.Sprintf("Failed to read consumer quotas of %d API(s)", len(failedAPIs)),
+            Details: map[string]interface{}{
+                "failed_apis": failedAPIs,
+                "zero_quotas": zeroQuotas,
+                "project_id":  vctx.Config.ProjectID,
+                "hint":        "Reading consumer quotas requires serviceusage.quotas.get (e.g., roles/serviceusage.serviceUsageViewer)",
+            },
+            SubResults: subResults,
+        }
+    }
+
+    if len(zeroQuotas) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonAPIQuotaZero,
+            Message: fmt.Sprintf("%d quota limit(s) of required APIs are zero", len(zeroQuotas)),
+            Details: map[string]interface{}{
+                "zero_quotas": zeroQuotas,
+                "project_id":  vctx.Config.ProjectID,
+                "hint":        "Remove the quota override in the console under IAM & Admin > Quotas",
+            },
+            SubResults: subResults,
+        }
+    }
+
+    message := fmt.Sprintf("No zero consumer quota on %d required API(s)", len(vctx.Config.RequiredAPIs))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAPIQuotasAvailable,
+        Message: message,
+        Details: map[string]interface{}{
+            "checked_apis": vctx.Config.RequiredAPIs,
+            "project_id":   vctx.Config.ProjectID,
+        },
+        SubResults: subResults,
+    }
+}
+
+// zeroQuotaLimits returns "<metric> (<unit>)" for every limit whose enforced bucket is zero
+// Only the global bucket and, when region is set, that region's bucket are considered
+func zeroQuotaLimits(metrics []*serviceusagebeta.ConsumerQuotaMetric, region string) []string {
+    zeros := []string{}
+    for _, m := range metrics {
+        for _, limit := range m.ConsumerQuotaLimits {
+            for _, bucket := range limit.QuotaBuckets {
+                if bucket.EffectiveLimit != 0 {
+                    continue
+                }
+                switch {
+                case len(bucket.Dimensions) == 0:
+                    zeros = append(zeros, fmt.Sprintf("%s (%s)", m.Metric, limit.Unit))
+                case region != "" && len(bucket.Dimensions) == 1 && bucket.Dimensions["region"] == region:
+                    zeros = append(zeros, fmt.Sprintf("%s (%s, region=%s)", m.Metric, limit.Unit, region))
+                }
+            }
+        }
+    }
+    sort.Strings(zeros)
+    return zeros
+}
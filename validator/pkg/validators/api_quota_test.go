@@ -0,0 +1,131 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("APIQuotaValidator", func() {
+    var (
+        v     *validators.APIQuotaValidator
+        vctx  *validator.Context
+        quota *fakeServiceQuota
+    )
+
+    const computeParent = "projects/test-project/services/compute.googleapis.com"
+
+    // quotaMetric builds a metric with a single limit holding the given buckets
+    quotaMetric := func(metric string, buckets ...*serviceusagebeta.QuotaBucket) *serviceusagebeta.ConsumerQuotaMetric {
+        return &serviceusagebeta.ConsumerQuotaMetric{
+            Metric: metric,
+            ConsumerQuotaLimits: []*serviceusagebeta.ConsumerQuotaLimit{
+                {Metric: metric, Unit: "1/{project}", QuotaBuckets: buckets},
+            },
+        }
+    }
+
+    BeforeEach(func() {
+        v = &validators.APIQuotaValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("CHECK_API_QUOTAS", "true")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("REQUIRED_APIS", "compute.googleapis.com,iam.googleapis.com")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        quota = &fakeServiceQuota{
+            metrics: map[string][]*serviceusagebeta.ConsumerQuotaMetric{
+                computeParent: {
+                    quotaMetric("compute.googleapis.com/cpus",
+                        &serviceusagebeta.QuotaBucket{EffectiveLimit: 24},
+                        &serviceusagebeta.QuotaBucket{EffectiveLimit: 0, Dimensions: map[string]string{"region": "europe-west1"}},
+                    ),
+                },
+            },
+        }
+        vctx.SetServiceQuotaAPI(quota)
+    })
+
+    Describe("Metadata", func() {
+        It("should return correct metadata", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("api-quota-check"))
+            Expect(meta.RunAfter).To(ConsistOf("api-enabled"))
+        })
+    })
+
+    Describe("Enabled", func() {
+        It("should follow CHECK_API_QUOTAS", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.CheckAPIQuotas = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should skip when CHECK_API_QUOTAS is unset", func() {
+            vctx.Config.CheckAPIQuotas = false
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+            Expect(result.Reason).To(Equal("APIQuotaCheckDisabled"))
+        })
+
+        It("should succeed when no relevant quota is zero", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("APIQuotasAvailable"))
+            Expect(result.SubResults).To(HaveLen(2))
+        })
+
+        It("should warn with APIQuotaZero when the global bucket is zero", func() {
+            quota.metrics[computeParent] = append(quota.metrics[computeParent],
+                quotaMetric("compute.googleapis.com/instances", &serviceusagebeta.QuotaBucket{EffectiveLimit: 0}))
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("APIQuotaZero"))
+            Expect(result.Details["zero_quotas"]).To(ConsistOf("compute.googleapis.com/instances (1/{project})"))
+        })
+
+        It("should warn when the configured region's bucket is zero", func() {
+            quota.metrics[computeParent] = append(quota.metrics[computeParent],
+                quotaMetric("compute.googleapis.com/ssd_total_storage",
+                    &serviceusagebeta.QuotaBucket{EffectiveLimit: -1},
+                    &serviceusagebeta.QuotaBucket{EffectiveLimit: 0, Dimensions: map[string]string{"region": "us-central1"}},
+                ))
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Details["zero_quotas"]).To(ConsistOf(ContainSubstring("region=us-central1")))
+        })
+
+        It("should fail when quota metrics cannot be read", func() {
+            quota.errs = map[string]error{
+                computeParent: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("APIQuotaCheckFailed"))
+            Expect(result.Details["failed_apis"]).To(ConsistOf("compute.googleapis.com"))
+        })
+    })
+})
@@ -0,0 +1,165 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "sort"
+    "strings"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the project IAM policy lookup
+    auditLoggingRequestTimeout = 30 * time.Second
+
+    // Audit config service that applies to every service in the project
+    auditAllServices = "allServices"
+)
+
+// AuditLoggingCheckValidator checks that the audit log types in REQUIRED_AUDIT_SERVICES are enabled
+// Data Access logs are off by default for most services, so compliance setups must turn them on
+type AuditLoggingCheckValidator struct{}
+
+// init registers the AuditLoggingCheckValidator with the global validator registry
+func init() {
+    validator.Register(&AuditLoggingCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *AuditLoggingCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "audit-logging-check",
+        Description: "Verify required audit log types are enabled in the project IAM policy",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure cloudresourcemanager API is available
+        Tags:        []string{"post-mvp", "iam", "compliance"},
+    }
+}
+
+// RequiredAPIs declares the APIs audit-logging-check calls so api-enabled checks them
+func (v *AuditLoggingCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_AUDIT_SERVICES is set
+func (v *AuditLoggingCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRED_AUDIT_SERVICES")
+}
+
+// Validate reads the audit configs of the project IAM policy and checks each configured service
+// Log types enabled for allServices count for every service
+func (v *AuditLoggingCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking audit logging configuration", "services", len(vctx.Config.RequiredAuditServices))
+
+    ctx, cancel := context.WithTimeout(ctx, auditLoggingRequestTimeout)
+    defer cancel()
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    policy, err := crm.GetIamPolicy(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to get project IAM policy",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMPolicyLookupFailed),
+            Message: fmt.Sprintf("Failed to get IAM policy of project %s: %v", vctx.Config.ProjectID, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant resourcemanager.projects.getIamPolicy to the validator's service account",
+            },
+        }
+    }
+
+    // Index enabled log types by service so each check is a map lookup
+    enabled := map[string]map[string]bool{}
+    for _, ac := range policy.AuditConfigs {
+        for _, lc := range ac.AuditLogConfigs {
+            if enabled[ac.Service] == nil {
+                enabled[ac.Service] = map[string]bool{}
+            }
+            enabled[ac.Service][lc.LogType] = true
+        }
+    }
+
+    services := make([]string, 0, len(vctx.Config.RequiredAuditServices))
+    for service := range vctx.Config.RequiredAuditServices {
+        services = append(services, service)
+    }
+    sort.Strings(services)
+
+    gaps := map[string][]string{}
+    subResults := make([]*validator.Result, 0, len(services))
+    for _, service := range services {
+        var missing []string
+        for _, logType := range vctx.Config.RequiredAuditServices[service] {
+            if !enabled[service][logType] && !enabled[auditAllServices][logType] {
+                missing = append(missing, logType)
+            }
+        }
+
+        if len(missing) == 0 {
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: service,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonAuditLoggingConfigured,
+                Message:       fmt.Sprintf("Audit logging for %s has the required log types enabled", service),
+            })
+            continue
+        }
+        gaps[service] = missing
+        slog.Warn("Audit log types are not enabled", "service", service, "missing_log_types", missing)
+        subResults = append(subResults, &validator.Result{
+            ValidatorName: service,
+            Status:        validator.StatusFailure,
+            Reason:        validator.ReasonAuditLoggingNotConfigured,
+            Message:       fmt.Sprintf("Audit logging for %s is missing %s", service, strings.Join(missing, ", ")),
+        })
+    }
+
+    if len(gaps) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonAuditLoggingNotConfigured,
+            Message: fmt.Sprintf("%d service(s) are missing required audit log types", len(gaps)),
+            Details: map[string]interface{}{
+                "missing_log_types": gaps,
+                "project_id":        vctx.Config.ProjectID,
+                "hint":              "Enable the log types under IAM & Admin > Audit Logs, or add auditConfigs to the project IAM policy",
+            },
+            SubResults: subResults,
+        }
+    }
+
+    message := fmt.Sprintf("All %d service(s) have the required audit log types enabled", len(services))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAuditLoggingConfigured,
+        Message: message,
+        Details: map[string]interface{}{
+            "services":   services,
+            "project_id": vctx.Config.ProjectID,
+        },
+        SubResults: subResults,
+    }
+}
@@ -0,0 +1,99 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("AuditLoggingCheckValidator", func() {
+    var (
+        v    *validators.AuditLoggingCheckValidator
+        vctx *validator.Context
+        crm  *fakeResourceManager
+    )
+
+    BeforeEach(func() {
+        v = &validators.AuditLoggingCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("REQUIRED_AUDIT_SERVICES", "storage.googleapis.com")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        crm = &fakeResourceManager{
+            policy: &cloudresourcemanager.Policy{AuditConfigs: []*cloudresourcemanager.AuditConfig{{
+                Service: "storage.googleapis.com",
+                AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{
+                    {LogType: "DATA_READ"},
+                    {LogType: "DATA_WRITE"},
+                },
+            }}},
+        }
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without REQUIRED_AUDIT_SERVICES", func() {
+            vctx.Config.RequiredAuditServices = nil
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the Data Access logs are enabled", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("AuditLoggingConfigured"))
+            Expect(result.SubResults).To(HaveLen(1))
+        })
+
+        It("should fail with the missing log types per service", func() {
+            vctx.Config.RequiredAuditServices["storage.googleapis.com"] = []string{"ADMIN_READ", "DATA_READ"}
+            vctx.Config.RequiredAuditServices["bigquery.googleapis.com"] = []string{"DATA_READ"}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("AuditLoggingNotConfigured"))
+            Expect(result.Details).To(HaveKeyWithValue("missing_log_types", map[string][]string{
+                "storage.googleapis.com":  {"ADMIN_READ"},
+                "bigquery.googleapis.com": {"DATA_READ"},
+            }))
+            Expect(result.SubResults).To(HaveLen(2))
+        })
+
+        It("should count log types enabled for allServices", func() {
+            vctx.Config.RequiredAuditServices["bigquery.googleapis.com"] = []string{"DATA_READ"}
+            crm.policy.AuditConfigs = append(crm.policy.AuditConfigs, &cloudresourcemanager.AuditConfig{
+                Service:         "allServices",
+                AuditLogConfigs: []*cloudresourcemanager.AuditLogConfig{{LogType: "DATA_READ"}},
+            })
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should fail when the IAM policy lookup errors", func() {
+            crm.policyErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
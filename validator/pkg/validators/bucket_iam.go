@@ -0,0 +1,212 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the bucket and project IAM policy lookups
+    bucketIAMRequestTimeout = 30 * time.Second
+)
+
+// BucketIAMValidator checks that the installer principal can read and write REQUIRED_BUCKET
+// Roles may be granted on the bucket or inherited from the project, so both policies are consulted
+// A missing bucket fails with BucketNotFound, so this validator also covers bucket existence
+type BucketIAMValidator struct{}
+
+// init registers the BucketIAMValidator with the global validator registry
+func init() {
+    validator.Register(&BucketIAMValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *BucketIAMValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "bucket-iam-check",
+        Description: "Verify the installer principal holds the required roles on the GCS bucket",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure storage API is available
+        Tags:        []string{"post-mvp", "iam", "storage"},
+    }
+}
+
+// RequiredAPIs declares the APIs bucket-iam-check calls so api-enabled checks them
+func (v *BucketIAMValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com", "storage.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_BUCKET is set
+func (v *BucketIAMValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasValidatorConfig(v.Metadata().Name, "REQUIRED_BUCKET")
+}
+
+// requiredBucket returns the bucket validatorName checks: VALIDATOR_<NAME>_REQUIRED_BUCKET, then REQUIRED_BUCKET
+func requiredBucket(vctx *validator.Context, validatorName string) string {
+    return vctx.Config.ValidatorString(validatorName, "REQUIRED_BUCKET", vctx.Config.RequiredBucket)
+}
+
+// Validate fetches the bucket and project IAM policies and checks the principal's roles
+func (v *BucketIAMValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    bucket := requiredBucket(vctx, v.Metadata().Name)
+    principal := vctx.Config.BucketIAMPrincipal
+    if principal == "" {
+        slog.Info("BUCKET_IAM_PRINCIPAL not set, skipping bucket IAM check")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonBucketIAMPrincipalNotConfigured,
+            Message: fmt.Sprintf("No principal configured, IAM of bucket %s not checked", bucket),
+            Details: map[string]interface{}{
+                "bucket":     bucket,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set BUCKET_IAM_PRINCIPAL to the installer's service account",
+            },
+        }
+    }
+
+    slog.Info("Checking bucket IAM", "bucket", bucket, "principal", principal)
+
+    ctx, cancel := context.WithTimeout(ctx, bucketIAMRequestTimeout)
+    defer cancel()
+
+    storageSvc, err := vctx.GetStorageAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Storage client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonStorageClientError),
+            Message: fmt.Sprintf("Failed to get Storage client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    bucketPolicy, err := storageSvc.GetBucketIamPolicy(ctx, bucket)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonBucketNotFound,
+                Message: fmt.Sprintf("Bucket %s does not exist", bucket),
+                Details: map[string]interface{}{
+                    "bucket":     bucket,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Create it with: gcloud storage buckets create gs://<name>",
+                },
+            }
+        }
+
+        slog.Error("Failed to get bucket IAM policy",
+            "error", err.Error(),
+            "bucket", bucket,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonBucketIAMCheckFailed),
+            Message: fmt.Sprintf("Failed to get IAM policy of bucket %s: %v", bucket, err),
+            Details: map[string]interface{}{
+                "bucket":     bucket,
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant storage.buckets.getIamPolicy on the bucket to the validator's service account",
+            },
+        }
+    }
+
+    granted := map[string]bool{}
+    for _, b := range bucketPolicy.Bindings {
+        for _, member := range b.Members {
+            if member == principal {
+                granted[b.Role] = true
+            }
+        }
+    }
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    projectPolicy, err := crm.GetIamPolicy(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to get project IAM policy",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMPolicyLookupFailed),
+            Message: fmt.Sprintf("Failed to get IAM policy of project %s: %v", vctx.Config.ProjectID, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant resourcemanager.projects.getIamPolicy to the validator's service account",
+            },
+        }
+    }
+    for _, b := range projectPolicy.Bindings {
+        for _, member := range b.Members {
+            if member == principal {
+                granted[b.Role] = true
+            }
+        }
+    }
+
+    var missing []string
+    for _, role := range vctx.Config.BucketRequiredRoles {
+        if !granted[role] {
+            missing = append(missing, role)
+        }
+    }
+
+    if len(missing) > 0 {
+        slog.Warn("Principal is missing bucket roles", "bucket", bucket, "principal", principal, "missing_roles", missing)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonBucketIAMInsufficient,
+            Message: fmt.Sprintf("%s is missing %d required role(s) on bucket %s", principal, len(missing), bucket),
+            Details: map[string]interface{}{
+                "bucket":        bucket,
+                "principal":     principal,
+                "missing_roles": missing,
+                "project_id":    vctx.Config.ProjectID,
+                "hint":          "Grant the roles with: gcloud storage buckets add-iam-policy-binding gs://<name>",
+            },
+        }
+    }
+
+    message := fmt.Sprintf("%s holds all %d required role(s) on bucket %s", principal, len(vctx.Config.BucketRequiredRoles), bucket)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonBucketIAMSufficient,
+        Message: message,
+        Details: map[string]interface{}{
+            "bucket":     bucket,
+            "principal":  principal,
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,114 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/storage/v1"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("BucketIAMValidator", func() {
+    var (
+        v    *validators.BucketIAMValidator
+        vctx *validator.Context
+        gcs  *fakeStorage
+        crm  *fakeResourceManager
+    )
+
+    const installer = "serviceAccount:installer@test-project.iam.gserviceaccount.com"
+
+    BeforeEach(func() {
+        v = &validators.BucketIAMValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("REQUIRED_BUCKET", "installer-bucket")
+        GinkgoT().Setenv("BUCKET_IAM_PRINCIPAL", installer)
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        gcs = &fakeStorage{policies: map[string]*storage.Policy{
+            "installer-bucket": {Bindings: []*storage.PolicyBindings{
+                {Role: "roles/storage.objectAdmin", Members: []string{installer}},
+            }},
+        }}
+        vctx.SetStorageAPI(gcs)
+
+        crm = &fakeResourceManager{policy: &cloudresourcemanager.Policy{}}
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without REQUIRED_BUCKET", func() {
+            vctx.Config.RequiredBucket = ""
+            GinkgoT().Setenv("REQUIRED_BUCKET", "")
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the bucket grants the required roles", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("BucketIAMSufficient"))
+        })
+
+        It("should count roles inherited from the project", func() {
+            gcs.policies["installer-bucket"] = &storage.Policy{}
+            crm.policy.Bindings = []*cloudresourcemanager.Binding{
+                {Role: "roles/storage.objectAdmin", Members: []string{installer}},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should fail with the missing roles", func() {
+            vctx.Config.BucketRequiredRoles = []string{"roles/storage.objectAdmin", "roles/storage.legacyBucketReader"}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("BucketIAMInsufficient"))
+            Expect(result.Details).To(HaveKeyWithValue("missing_roles", []string{"roles/storage.legacyBucketReader"}))
+            Expect(result.Details).To(HaveKeyWithValue("principal", installer))
+        })
+
+        It("should fail when the bucket does not exist", func() {
+            vctx.Config.RequiredBucket = "missing-bucket"
+            GinkgoT().Setenv("REQUIRED_BUCKET", "missing-bucket")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("BucketNotFound"))
+        })
+
+        It("should fail when the bucket policy cannot be read", func() {
+            gcs.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+
+        It("should skip when no principal is configured", func() {
+            vctx.Config.BucketIAMPrincipal = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+        })
+    })
+})
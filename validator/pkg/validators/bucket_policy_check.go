@@ -0,0 +1,172 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "strings"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the bucket metadata lookup
+    bucketPolicyRequestTimeout = 30 * time.Second
+
+    // Retention periods are reported by the API in seconds
+    secondsPerDay = 24 * 60 * 60
+)
+
+// BucketPolicyCheckValidator checks REQUIRED_BUCKET's versioning and retention policy against
+// REQUIRE_BUCKET_VERSIONING and MIN_RETENTION_DAYS, which governance may require on the state bucket
+type BucketPolicyCheckValidator struct{}
+
+// init registers the BucketPolicyCheckValidator with the global validator registry
+func init() {
+    validator.Register(&BucketPolicyCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *BucketPolicyCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "bucket-policy-check",
+        Description: "Verify the GCS bucket's versioning and retention policy meet the configured expectations",
+        RunAfter:    []string{"api-enabled", "bucket-iam-check"}, // bucket-iam-check reports a missing bucket first
+        Tags:        []string{"post-mvp", "storage"},
+    }
+}
+
+// RequiredAPIs declares the APIs bucket-policy-check calls so api-enabled checks them
+func (v *BucketPolicyCheckValidator) RequiredAPIs() []string {
+    return []string{"storage.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_BUCKET and a policy expectation are set
+func (v *BucketPolicyCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasValidatorConfig(v.Metadata().Name, "REQUIRED_BUCKET") &&
+        (vctx.HasConfig("REQUIRE_BUCKET_VERSIONING") || vctx.HasConfig("MIN_RETENTION_DAYS"))
+}
+
+// Describe names the bucket and the expectations that will be checked
+func (v *BucketPolicyCheckValidator) Describe(vctx *validator.Context) string {
+    var expectations []string
+    if vctx.Config.RequireBucketVersioning {
+        expectations = append(expectations, "versioning enabled")
+    }
+    if vctx.Config.MinRetentionDays > 0 {
+        expectations = append(expectations, fmt.Sprintf("a retention policy of at least %d day(s)", vctx.Config.MinRetentionDays))
+    }
+    return fmt.Sprintf("will verify bucket %s has %s", requiredBucket(vctx, v.Metadata().Name), strings.Join(expectations, " and "))
+}
+
+// Validate fetches the bucket's metadata and compares its versioning and retention settings with the expectations
+func (v *BucketPolicyCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    bucket := requiredBucket(vctx, v.Metadata().Name)
+    slog.Info("Checking bucket policy", "bucket", bucket,
+        "require_versioning", vctx.Config.RequireBucketVersioning,
+        "min_retention_days", vctx.Config.MinRetentionDays)
+
+    ctx, cancel := context.WithTimeout(ctx, bucketPolicyRequestTimeout)
+    defer cancel()
+
+    storageSvc, err := vctx.GetStorageAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Storage client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonStorageClientError),
+            Message: fmt.Sprintf("Failed to get Storage client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    b, err := storageSvc.GetBucket(ctx, bucket)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonBucketNotFound,
+                Message: fmt.Sprintf("Bucket %s does not exist", bucket),
+                Details: map[string]interface{}{
+                    "bucket":     bucket,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Create it with: gcloud storage buckets create gs://<name>",
+                },
+            }
+        }
+
+        slog.Error("Failed to get bucket",
+            "error", err.Error(),
+            "bucket", bucket,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonBucketPolicyCheckFailed),
+            Message: fmt.Sprintf("Failed to get bucket %s: %v", bucket, err),
+            Details: map[string]interface{}{
+                "bucket":     bucket,
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant storage.buckets.get on the bucket to the validator's service account",
+            },
+        }
+    }
+
+    versioningEnabled := b.Versioning != nil && b.Versioning.Enabled
+    var retentionDays int64
+    if b.RetentionPolicy != nil {
+        retentionDays = b.RetentionPolicy.RetentionPeriod / secondsPerDay
+    }
+
+    details := map[string]interface{}{
+        "bucket":             bucket,
+        "versioning_enabled": versioningEnabled,
+        "retention_days":     retentionDays,
+        "project_id":         vctx.Config.ProjectID,
+    }
+
+    var violations []string
+    if vctx.Config.RequireBucketVersioning && !versioningEnabled {
+        violations = append(violations, "versioning is disabled but REQUIRE_BUCKET_VERSIONING is set")
+    }
+    if minDays := int64(vctx.Config.MinRetentionDays); minDays > 0 && retentionDays < minDays {
+        if b.RetentionPolicy == nil {
+            violations = append(violations, fmt.Sprintf("no retention policy, MIN_RETENTION_DAYS requires %d day(s)", minDays))
+        } else {
+            violations = append(violations, fmt.Sprintf("retention period is %d day(s), %d short of MIN_RETENTION_DAYS %d",
+                retentionDays, minDays-retentionDays, minDays))
+        }
+    }
+
+    if len(violations) > 0 {
+        details["violations"] = violations
+        details["hint"] = "Update the bucket with: gcloud storage buckets update gs://<name> --versioning --retention-period=<days>d"
+        slog.Warn("Bucket policy does not meet expectations", "bucket", bucket, "violations", violations)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonBucketPolicyNoncompliant,
+            Message: fmt.Sprintf("Bucket %s does not meet the policy expectations: %s", bucket, strings.Join(violations, "; ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Bucket %s meets the versioning and retention expectations", bucket)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonBucketPolicyCompliant,
+        Message: message,
+        Details: details,
+    }
+}
@@ -0,0 +1,124 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/storage/v1"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("BucketPolicyCheckValidator", func() {
+    var (
+        v    *validators.BucketPolicyCheckValidator
+        vctx *validator.Context
+        gcs  *fakeStorage
+    )
+
+    BeforeEach(func() {
+        v = &validators.BucketPolicyCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("REQUIRED_BUCKET", "state-bucket")
+        GinkgoT().Setenv("REQUIRE_BUCKET_VERSIONING", "true")
+        GinkgoT().Setenv("MIN_RETENTION_DAYS", "7")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        gcs = &fakeStorage{buckets: map[string]*storage.Bucket{
+            "state-bucket": {
+                Name:            "state-bucket",
+                Versioning:      &storage.BucketVersioning{Enabled: true},
+                RetentionPolicy: &storage.BucketRetentionPolicy{RetentionPeriod: 30 * 24 * 60 * 60},
+            },
+        }}
+        vctx.SetStorageAPI(gcs)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without REQUIRED_BUCKET", func() {
+            vctx.Config.RequiredBucket = ""
+            GinkgoT().Setenv("REQUIRED_BUCKET", "")
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+
+        It("should not be enabled without any policy expectation", func() {
+            vctx.Config.RequireBucketVersioning = false
+            vctx.Config.MinRetentionDays = 0
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+
+        It("should be enabled with a single expectation", func() {
+            vctx.Config.RequireBucketVersioning = false
+            Expect(v.Enabled(vctx)).To(BeTrue())
+        })
+    })
+
+    It("should pass when versioning and retention meet the expectations", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("BucketPolicyCompliant"))
+        Expect(result.Details).To(HaveKeyWithValue("versioning_enabled", true))
+        Expect(result.Details).To(HaveKeyWithValue("retention_days", int64(30)))
+    })
+
+    It("should fail listing each delta when the bucket falls short", func() {
+        gcs.buckets["state-bucket"].Versioning = nil
+        gcs.buckets["state-bucket"].RetentionPolicy.RetentionPeriod = 3 * 24 * 60 * 60
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("BucketPolicyNoncompliant"))
+        Expect(result.Details["violations"]).To(ConsistOf(
+            "versioning is disabled but REQUIRE_BUCKET_VERSIONING is set",
+            "retention period is 3 day(s), 4 short of MIN_RETENTION_DAYS 7",
+        ))
+    })
+
+    It("should fail when retention is required but the bucket has no retention policy", func() {
+        vctx.Config.RequireBucketVersioning = false
+        gcs.buckets["state-bucket"].RetentionPolicy = nil
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Details["violations"]).To(ConsistOf("no retention policy, MIN_RETENTION_DAYS requires 7 day(s)"))
+    })
+
+    It("should ignore settings without an expectation", func() {
+        vctx.Config.MinRetentionDays = 0
+        gcs.buckets["state-bucket"].RetentionPolicy = nil
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+    })
+
+    It("should report a missing bucket", func() {
+        vctx.Config.RequiredBucket = "missing-bucket"
+        GinkgoT().Setenv("REQUIRED_BUCKET", "missing-bucket")
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("BucketNotFound"))
+    })
+
+    It("should fail when the bucket cannot be read", func() {
+        gcs.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
@@ -0,0 +1,242 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "net/netip"
+    "path"
+    "strings"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the network and subnetwork lookups, peered networks included
+    cidrOverlapCheckTimeout = 60 * time.Second
+
+    // Peering state in which routes are exchanged between the networks
+    peeringStateActive = "ACTIVE"
+)
+
+// CIDROverlapCheckValidator checks that CLUSTER_CIDR does not overlap a subnet range of the VPC
+// network or of the networks actively peered with it
+// GCP rejects an overlapping subnet only when it is created, late in an installation
+type CIDROverlapCheckValidator struct{}
+
+// init registers the CIDROverlapCheckValidator with the global validator registry
+func init() {
+    validator.Register(&CIDROverlapCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *CIDROverlapCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "cidr-overlap-check",
+        Description: "Verify CLUSTER_CIDR does not overlap subnet ranges of the VPC network and its peered networks",
+        RunAfter:    []string{"api-enabled"}, // Requires compute.googleapis.com
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs cidr-overlap-check calls so api-enabled checks them
+func (v *CIDROverlapCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless CLUSTER_CIDR is set
+func (v *CIDROverlapCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CLUSTER_CIDR")
+}
+
+// Describe names the range and network that will be checked
+func (v *CIDROverlapCheckValidator) Describe(vctx *validator.Context) string {
+    network := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    return fmt.Sprintf("will verify CLUSTER_CIDR %s does not overlap subnets of VPC network %s in project %s or its active peers",
+        vctx.Config.ClusterCIDR, network, vctx.Config.ProjectID)
+}
+
+// subnetRange is a primary or secondary range of a subnetwork
+type subnetRange struct {
+    label  string // "<network>/<subnet> (<region>)", with the secondary range name when there is one
+    prefix netip.Prefix
+}
+
+// Validate lists the subnetworks of the VPC network and its active peers and compares their ranges with CLUSTER_CIDR
+func (v *CIDROverlapCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    clusterCIDR := netip.MustParsePrefix(vctx.Config.ClusterCIDR).Masked() // Validated by LoadFromEnv
+    slog.Info("Checking cluster CIDR for overlapping subnets", "network", name, "cluster_cidr", clusterCIDR)
+
+    ctx, cancel := context.WithTimeout(ctx, cidrOverlapCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    network, err := computeSvc.GetNetwork(ctx, vctx.Config.ProjectID, name)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonNetworkNotFound,
+                Message: fmt.Sprintf("VPC network %s does not exist", name),
+                Details: map[string]interface{}{
+                    "network":    name,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Check VPC_NAME or create the network with: gcloud compute networks create <name>",
+                },
+            }
+        }
+
+        slog.Error("Failed to get VPC network",
+            "error", err.Error(),
+            "network", name,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonCIDROverlapCheckFailed),
+            Message: fmt.Sprintf("Failed to get VPC network %s: %v", name, err),
+            Details: map[string]interface{}{
+                "network":    name,
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    subnetworks, err := computeSvc.ListSubnetworks(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to list subnetworks",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonCIDROverlapCheckFailed),
+            Message: fmt.Sprintf("Failed to list subnetworks: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant compute.subnetworks.list to the validator's service account",
+            },
+        }
+    }
+    ranges := networkSubnetRanges(subnetworks, vctx.Config.ProjectID, name)
+
+    // Peered networks may live in projects the validator cannot read; they are reported rather than failing the check
+    var peerings, uncheckedPeerings []string
+    for _, peering := range network.Peerings {
+        if peering.State != peeringStateActive {
+            continue
+        }
+        peerProject, peerName, ok := parseNetworkURL(peering.Network)
+        if !ok {
+            continue
+        }
+        peerings = append(peerings, peering.Name)
+        peerSubnetworks, err := computeSvc.ListSubnetworks(ctx, peerProject)
+        if err != nil {
+            slog.Warn("Failed to list subnetworks of peered network",
+                "peering", peering.Name,
+                "peer_network", peering.Network,
+                "error", err.Error())
+            uncheckedPeerings = append(uncheckedPeerings, peering.Name)
+            continue
+        }
+        ranges = append(ranges, networkSubnetRanges(peerSubnetworks, peerProject, peerName)...)
+    }
+
+    var conflicts []string
+    for _, r := range ranges {
+        if r.prefix.Overlaps(clusterCIDR) {
+            conflicts = append(conflicts, fmt.Sprintf("%s %s", r.label, r.prefix))
+        }
+    }
+
+    details := map[string]interface{}{
+        "network":        name,
+        "cluster_cidr":   clusterCIDR.String(),
+        "checked_ranges": len(ranges),
+        "peerings":       peerings,
+        "project_id":     vctx.Config.ProjectID,
+    }
+    if len(uncheckedPeerings) > 0 {
+        details["unchecked_peerings"] = uncheckedPeerings
+    }
+
+    if len(conflicts) > 0 {
+        details["conflicting_subnets"] = conflicts
+        details["hint"] = "Choose a CLUSTER_CIDR outside the listed ranges, e.g. with: gcloud compute networks subnets list --network=" + name
+        slog.Warn("Cluster CIDR overlaps existing subnets", "cluster_cidr", clusterCIDR, "conflicts", conflicts)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonCIDROverlap,
+            Message: fmt.Sprintf("CLUSTER_CIDR %s overlaps %d existing subnet range(s): %s", clusterCIDR, len(conflicts), strings.Join(conflicts, ", ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("CLUSTER_CIDR %s does not overlap any of %d subnet range(s) of VPC network %s and its peers", clusterCIDR, len(ranges), name)
+    slog.Info(message, "unchecked_peerings", uncheckedPeerings)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonNoCIDROverlap,
+        Message: message,
+        Details: details,
+    }
+}
+
+// networkSubnetRanges returns the primary and secondary ranges of the subnetworks of project's network name
+// Ranges that do not parse are skipped
+func networkSubnetRanges(subnetworks []*compute.Subnetwork, project, name string) []subnetRange {
+    var ranges []subnetRange
+    for _, s := range subnetworks {
+        if p, n, ok := parseNetworkURL(s.Network); !ok || p != project || n != name {
+            continue
+        }
+        label := fmt.Sprintf("%s/%s (%s)", name, s.Name, path.Base(s.Region))
+        if prefix, err := netip.ParsePrefix(s.IpCidrRange); err == nil {
+            ranges = append(ranges, subnetRange{label: label, prefix: prefix})
+        }
+        for _, secondary := range s.SecondaryIpRanges {
+            if prefix, err := netip.ParsePrefix(secondary.IpCidrRange); err == nil {
+                ranges = append(ranges, subnetRange{label: label + " " + secondary.RangeName, prefix: prefix})
+            }
+        }
+    }
+    return ranges
+}
+
+// parseNetworkURL returns the project and name of a network URL such as
+// https://www.googleapis.com/compute/v1/projects/<project>/global/networks/<name>
+func parseNetworkURL(url string) (project, name string, ok bool) {
+    _, rest, found := strings.Cut(url, "projects/")
+    if !found {
+        return "", "", false
+    }
+    project, name, found = strings.Cut(rest, "/global/networks/")
+    if !found || project == "" || name == "" {
+        return "", "", false
+    }
+    return project, name, true
+}
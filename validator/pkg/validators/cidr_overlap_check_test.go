@@ -0,0 +1,134 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("CIDROverlapCheckValidator", func() {
+    var (
+        v           *validators.CIDROverlapCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    const (
+        vpcURL  = "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/cluster-vpc"
+        peerURL = "https://www.googleapis.com/compute/v1/projects/shared-project/global/networks/shared-vpc"
+    )
+
+    BeforeEach(func() {
+        v = &validators.CIDROverlapCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("VPC_NAME", "cluster-vpc")
+        GinkgoT().Setenv("CLUSTER_CIDR", "10.128.0.0/14")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{
+            networks: []*compute.Network{{
+                Name:     "cluster-vpc",
+                SelfLink: vpcURL,
+                Peerings: []*compute.NetworkPeering{
+                    {Name: "to-shared", Network: peerURL, State: "ACTIVE"},
+                    {Name: "stale", Network: "projects/old-project/global/networks/old-vpc", State: "INACTIVE"},
+                },
+            }},
+            subnetworks: map[string][]*compute.Subnetwork{
+                "test-project": {
+                    {
+                        Name:        "nodes",
+                        Network:     vpcURL,
+                        Region:      "https://www.googleapis.com/compute/v1/projects/test-project/regions/us-central1",
+                        IpCidrRange: "10.0.0.0/20",
+                        SecondaryIpRanges: []*compute.SubnetworkSecondaryRange{
+                            {RangeName: "pods", IpCidrRange: "10.4.0.0/14"},
+                        },
+                    },
+                    {Name: "elsewhere", Network: "projects/test-project/global/networks/other", IpCidrRange: "10.128.0.0/20"},
+                },
+                "shared-project": {
+                    {Name: "shared", Network: peerURL, Region: "regions/us-east1", IpCidrRange: "172.16.0.0/16"},
+                },
+            },
+        }
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    It("should not be enabled without CLUSTER_CIDR", func() {
+        vctx.Config.ClusterCIDR = ""
+        Expect(v.Enabled(vctx)).To(BeFalse())
+    })
+
+    It("should pass when no subnet of the VPC or its active peers overlaps", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("NoCIDROverlap"))
+        Expect(result.Details).To(HaveKeyWithValue("checked_ranges", 3))
+        Expect(result.Details["peerings"]).To(Equal([]string{"to-shared"}))
+    })
+
+    It("should fail listing each overlapping range, secondary ranges included", func() {
+        vctx.Config.ClusterCIDR = "10.0.0.0/8"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("CIDROverlap"))
+        Expect(result.Details["conflicting_subnets"]).To(ConsistOf(
+            "cluster-vpc/nodes (us-central1) 10.0.0.0/20",
+            "cluster-vpc/nodes (us-central1) pods 10.4.0.0/14",
+        ))
+    })
+
+    It("should fail when a peered network's subnet overlaps", func() {
+        vctx.Config.ClusterCIDR = "172.16.128.0/17"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Details["conflicting_subnets"]).To(ConsistOf("shared-vpc/shared (us-east1) 172.16.0.0/16"))
+    })
+
+    It("should report peered networks it cannot read without failing", func() {
+        computeFake.subnetworkErrs = map[string]error{
+            "shared-project": &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+        }
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Details["unchecked_peerings"]).To(Equal([]string{"to-shared"}))
+    })
+
+    It("should report a missing VPC network", func() {
+        vctx.Config.VPCName = "missing-vpc"
+        GinkgoT().Setenv("VPC_NAME", "missing-vpc")
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("NetworkNotFound"))
+    })
+
+    It("should fail when the subnetworks cannot be listed", func() {
+        computeFake.listErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
@@ -0,0 +1,147 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the compute service account lookup
+    computeSARequestTimeout = 30 * time.Second
+)
+
+// ComputeSAEnabledValidator checks that the service account used by cluster nodes exists and is not disabled
+// Defaults to the Compute Engine default service account, <project-number>-compute@developer.gserviceaccount.com
+type ComputeSAEnabledValidator struct{}
+
+// init registers the ComputeSAEnabledValidator with the global validator registry
+func init() {
+    validator.Register(&ComputeSAEnabledValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ComputeSAEnabledValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "compute-sa-enabled-check",
+        Description: "Verify the Compute Engine default service account exists and is not disabled",
+        RunAfter:    []string{"api-enabled"}, // Requires iam.googleapis.com and cloudresourcemanager.googleapis.com
+        Tags:        []string{"post-mvp", "iam"},
+    }
+}
+
+// RequiredAPIs declares the APIs compute-sa-enabled-check calls so api-enabled checks them
+func (v *ComputeSAEnabledValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com", "iam.googleapis.com"}
+}
+
+// Validate resolves the compute service account email and checks it via IAM
+func (v *ComputeSAEnabledValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking Compute Engine service account")
+
+    ctx, cancel := context.WithTimeout(ctx, computeSARequestTimeout)
+    defer cancel()
+
+    // Custom node service accounts take precedence over the project default
+    // VALIDATOR_COMPUTE_SA_ENABLED_CHECK_COMPUTE_SERVICE_ACCOUNT overrides the generic setting
+    email := vctx.Config.ValidatorString(v.Metadata().Name, "COMPUTE_SERVICE_ACCOUNT", vctx.Config.ComputeServiceAccount)
+    if email == "" {
+        projectNumber, err := vctx.GetProjectNumber(ctx)
+        if err != nil {
+            slog.Error("Failed to resolve project number",
+                "error", err.Error(),
+                "project_id", vctx.Config.ProjectID)
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  extractErrorReason(err, validator.ReasonProjectNumberLookupFailed),
+                Message: fmt.Sprintf("Failed to resolve project number for default compute service account: %v", err),
+                Details: map[string]interface{}{
+                    "error_type": fmt.Sprintf("%T", err),
+                    "project_id": vctx.Config.ProjectID,
+                },
+            }
+        }
+        email = fmt.Sprintf("%d-compute@developer.gserviceaccount.com", projectNumber)
+    }
+
+    iamSvc, err := vctx.GetIAMAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get IAM client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMClientError),
+            Message: fmt.Sprintf("Failed to get IAM client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    // "-" as the project lets IAM resolve custom service accounts that live in another project
+    name := fmt.Sprintf("projects/-/serviceAccounts/%s", email)
+    sa, err := iamSvc.GetServiceAccount(ctx, name)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonComputeSANotFound,
+                Message: fmt.Sprintf("Compute service account %s does not exist", email),
+                Details: map[string]interface{}{
+                    "service_account": email,
+                    "project_id":      vctx.Config.ProjectID,
+                    "hint":            "Re-enable the Compute Engine API to recreate the default service account, or set COMPUTE_SERVICE_ACCOUNT",
+                },
+            }
+        }
+
+        slog.Error("Failed to get compute service account",
+            "error", err.Error(),
+            "service_account", email,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeSACheckFailed),
+            Message: fmt.Sprintf("Failed to get compute service account %s: %v", email, err),
+            Details: map[string]interface{}{
+                "service_account": email,
+                "error_type":      fmt.Sprintf("%T", err),
+                "project_id":      vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    if sa.Disabled {
+        slog.Warn("Compute service account is disabled", "service_account", email)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonComputeSADisabled,
+            Message: fmt.Sprintf("Compute service account %s is disabled", email),
+            Details: map[string]interface{}{
+                "service_account": email,
+                "project_id":      vctx.Config.ProjectID,
+                "hint":            "Enable it with: gcloud iam service-accounts enable <email>",
+            },
+        }
+    }
+
+    slog.Info("Compute service account is enabled", "service_account", email)
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonComputeSAEnabled,
+        Message: fmt.Sprintf("Compute service account %s is enabled", email),
+        Details: map[string]interface{}{
+            "service_account": email,
+            "project_id":      vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,110 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/iam/v1"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ComputeSAEnabledValidator", func() {
+    const defaultSAName = "projects/-/serviceAccounts/123456789-compute@developer.gserviceaccount.com"
+
+    var (
+        v       *validators.ComputeSAEnabledValidator
+        vctx    *validator.Context
+        fakeSvc *fakeIAM
+    )
+
+    BeforeEach(func() {
+        v = &validators.ComputeSAEnabledValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+        vctx.SetResourceManagerAPI(&fakeResourceManager{
+            project: &cloudresourcemanager.Project{ProjectId: "test-project", ProjectNumber: 123456789},
+        })
+
+        fakeSvc = &fakeIAM{serviceAccounts: map[string]*iam.ServiceAccount{
+            defaultSAName: {Email: "123456789-compute@developer.gserviceaccount.com"},
+        }}
+        vctx.SetIAMAPI(fakeSvc)
+    })
+
+    Describe("Metadata", func() {
+        It("should return correct metadata", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("compute-sa-enabled-check"))
+            Expect(meta.RunAfter).To(ConsistOf("api-enabled"))
+            Expect(meta.Tags).To(ContainElement("iam"))
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the default compute SA is enabled", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ComputeSAEnabled"))
+            Expect(result.Details).To(HaveKeyWithValue("service_account", "123456789-compute@developer.gserviceaccount.com"))
+            Expect(vctx.ProjectNumber).To(Equal(int64(123456789)))
+        })
+
+        It("should fail when the default compute SA is disabled", func() {
+            fakeSvc.serviceAccounts[defaultSAName].Disabled = true
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("ComputeSADisabled"))
+        })
+
+        It("should fail when the compute SA does not exist", func() {
+            fakeSvc.serviceAccounts = map[string]*iam.ServiceAccount{}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("ComputeSANotFound"))
+        })
+
+        It("should surface the project number lookup error", func() {
+            vctx.SetResourceManagerAPI(&fakeResourceManager{
+                err: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+            })
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+
+        Context("with COMPUTE_SERVICE_ACCOUNT set", func() {
+            BeforeEach(func() {
+                vctx.Config.ComputeServiceAccount = "nodes@other-project.iam.gserviceaccount.com"
+                vctx.SetResourceManagerAPI(&fakeResourceManager{err: &googleapi.Error{Code: 500}})
+                fakeSvc.serviceAccounts["projects/-/serviceAccounts/nodes@other-project.iam.gserviceaccount.com"] = &iam.ServiceAccount{
+                    Email: "nodes@other-project.iam.gserviceaccount.com",
+                }
+            })
+
+            It("should check the custom SA without resolving the project number", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.Details).To(HaveKeyWithValue("service_account", "nodes@other-project.iam.gserviceaccount.com"))
+            })
+        })
+    })
+})
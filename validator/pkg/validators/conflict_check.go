@@ -0,0 +1,157 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "sort"
+    "strings"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the instance, disk and network listings
+    conflictCheckRequestTimeout = 1 * time.Minute
+)
+
+// ConflictCheckValidator looks for resources left behind by a cluster with the same name prefix
+// Re-running an installer into such a project collides with them, so finding any is a warning
+// rather than a failure: the operator decides whether they are stale
+type ConflictCheckValidator struct{}
+
+// init registers the ConflictCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ConflictCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ConflictCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "conflict-check",
+        Description: "Warn about existing instances, disks or networks matching CLUSTER_NAME_PREFIX",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "compute"},
+    }
+}
+
+// RequiredAPIs declares the APIs conflict-check calls so api-enabled checks them
+func (v *ConflictCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless CLUSTER_NAME_PREFIX is set
+func (v *ConflictCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CLUSTER_NAME_PREFIX")
+}
+
+// Validate lists instances and disks across all zones plus the project's networks,
+// and reports those whose names start with the configured prefix
+func (v *ConflictCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    prefix := vctx.Config.ClusterNamePrefix
+    slog.Info("Checking for conflicting resources", "prefix", prefix)
+
+    ctx, cancel := context.WithTimeout(ctx, conflictCheckRequestTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    instances, err := computeSvc.ListInstances(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        return conflictLookupFailure(vctx, "instances", err)
+    }
+    disks, err := computeSvc.ListDisks(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        return conflictLookupFailure(vctx, "disks", err)
+    }
+    networks, err := computeSvc.ListNetworks(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        return conflictLookupFailure(vctx, "networks", err)
+    }
+
+    found := map[string][]string{}
+    for _, i := range instances {
+        if strings.HasPrefix(i.Name, prefix) {
+            found["instances"] = append(found["instances"], i.Name)
+        }
+    }
+    for _, d := range disks {
+        if strings.HasPrefix(d.Name, prefix) {
+            found["disks"] = append(found["disks"], d.Name)
+        }
+    }
+    for _, n := range networks {
+        if strings.HasPrefix(n.Name, prefix) {
+            found["networks"] = append(found["networks"], n.Name)
+        }
+    }
+
+    total := 0
+    for _, names := range found {
+        sort.Strings(names)
+        total += len(names)
+    }
+
+    if total > 0 {
+        slog.Warn("Found existing resources matching cluster name prefix",
+            "prefix", prefix,
+            "instances", len(found["instances"]),
+            "disks", len(found["disks"]),
+            "networks", len(found["networks"]))
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonExistingResourcesFound,
+            Message: fmt.Sprintf("Found %d existing resource(s) named with prefix %q", total, prefix),
+            Details: map[string]interface{}{
+                "prefix":     prefix,
+                "resources":  found,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Delete the leftover resources or choose a different cluster name before installing",
+            },
+        }
+    }
+
+    message := fmt.Sprintf("No instances, disks or networks named with prefix %q", prefix)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonNoConflictingResources,
+        Message: message,
+        Details: map[string]interface{}{
+            "prefix":     prefix,
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
+
+// conflictLookupFailure builds the failure result for a failed resource listing
+func conflictLookupFailure(vctx *validator.Context, kind string, err error) *validator.Result {
+    slog.Error("Failed to list "+kind,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonConflictCheckFailed),
+        Message: fmt.Sprintf("Failed to list %s: %v", kind, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
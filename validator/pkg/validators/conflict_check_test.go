@@ -0,0 +1,84 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ConflictCheckValidator", func() {
+    var (
+        v           *validators.ConflictCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    BeforeEach(func() {
+        v = &validators.ConflictCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("CLUSTER_NAME_PREFIX", "prod-a")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{
+            instances: []*compute.Instance{{Name: "other-vm"}},
+            disks:     []*compute.Disk{{Name: "other-disk"}},
+            networks:  []*compute.Network{{Name: "default"}},
+        }
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without CLUSTER_NAME_PREFIX", func() {
+            vctx.Config.ClusterNamePrefix = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when nothing matches the prefix", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("NoConflictingResources"))
+        })
+
+        It("should warn with the matching resources", func() {
+            computeFake.instances = append(computeFake.instances, &compute.Instance{Name: "prod-a-master-0"})
+            computeFake.disks = append(computeFake.disks, &compute.Disk{Name: "prod-a-master-0"})
+            computeFake.networks = append(computeFake.networks, &compute.Network{Name: "prod-a-network"})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("ExistingResourcesFound"))
+            Expect(result.Details).To(HaveKeyWithValue("resources", map[string][]string{
+                "instances": {"prod-a-master-0"},
+                "disks":     {"prod-a-master-0"},
+                "networks":  {"prod-a-network"},
+            }))
+        })
+
+        It("should fail when a listing fails", func() {
+            computeFake.listErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
@@ -0,0 +1,143 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net"
+    "net/http"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the single connectivity probe
+    connectivityRequestTimeout = 30 * time.Second
+
+    // Failure kinds reported in Details["failure_kind"] by connectivity-check
+    connectivityFailureAuth    = "auth"    // Credentials rejected or missing permission (401/403)
+    connectivityFailureNetwork = "network" // GCP unreachable (DNS, dial, TLS, timeout)
+    connectivityFailureAPI     = "api"     // GCP answered with another error
+)
+
+// ConnectivityCheckValidator makes one cheap authenticated call to prove the run can talk to GCP
+// A Level 0 gate: a single clear "can we reach GCP as the right identity" signal before anything else
+type ConnectivityCheckValidator struct{}
+
+// init registers the ConnectivityCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ConnectivityCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ConnectivityCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "connectivity-check",
+        Description: "Verify the credentials can make an authenticated GCP API call",
+        RunAfter:    []string{}, // No dependencies - runs at Level 0 as an early gate
+        Tags:        []string{"mvp", "auth"},
+    }
+}
+
+// RequiredAPIs declares the APIs connectivity-check calls so api-enabled checks them
+func (v *ConnectivityCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com"}
+}
+
+// Validate reads the target project through Cloud Resource Manager
+// Any error fails with ConnectivityFailed; Details["failure_kind"] tells auth from network problems
+func (v *ConnectivityCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking GCP connectivity")
+
+    ctx, cancel := context.WithTimeout(ctx, connectivityRequestTimeout)
+    defer cancel()
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        // Client creation only fails when credentials cannot be found or loaded
+        return connectivityFailure(vctx, connectivityFailureAuth,
+            fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err), err)
+    }
+
+    start := time.Now()
+    project, err := crm.GetProject(ctx, vctx.Config.ProjectID)
+    latency := time.Since(start)
+    if err != nil {
+        kind := classifyConnectivityError(err)
+        var message string
+        switch kind {
+        case connectivityFailureAuth:
+            message = fmt.Sprintf("GCP rejected the credentials for project %s: %v", vctx.Config.ProjectID, err)
+        case connectivityFailureNetwork:
+            message = fmt.Sprintf("Could not reach GCP: %v", err)
+        default:
+            message = fmt.Sprintf("GCP API call for project %s failed: %v", vctx.Config.ProjectID, err)
+        }
+        return connectivityFailure(vctx, kind, message, err)
+    }
+
+    vctx.SetProjectNumber(project.ProjectNumber)
+
+    message := fmt.Sprintf("Authenticated GCP API call for project %s succeeded", vctx.Config.ProjectID)
+    slog.Info(message, "latency", latency)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonConnectivityOK,
+        Message: message,
+        Details: map[string]interface{}{
+            "latency_ms": latency.Milliseconds(),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
+
+// classifyConnectivityError sorts a failed call into auth, network or other API failures
+func classifyConnectivityError(err error) string {
+    var apiErr *googleapi.Error
+    if errors.As(err, &apiErr) {
+        if apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden {
+            return connectivityFailureAuth
+        }
+        return connectivityFailureAPI
+    }
+    var netErr net.Error
+    if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+        return connectivityFailureNetwork
+    }
+    return connectivityFailureAPI
+}
+
+// connectivityFailure builds the ConnectivityFailed result for the given failure kind
+func connectivityFailure(vctx *validator.Context, kind, message string, err error) *validator.Result {
+    slog.Error("GCP connectivity check failed",
+        "failure_kind", kind,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+
+    details := map[string]interface{}{
+        "failure_kind": kind,
+        "error_type":   fmt.Sprintf("%T", err),
+        "project_id":   vctx.Config.ProjectID,
+    }
+    var apiErr *googleapi.Error
+    if errors.As(err, &apiErr) {
+        details["http_status"] = apiErr.Code
+    }
+    switch kind {
+    case connectivityFailureAuth:
+        details["hint"] = "Verify WIF annotation on KSA and that the GSA has resourcemanager.projects.get on the project"
+    case connectivityFailureNetwork:
+        details["hint"] = "Check egress to *.googleapis.com, DNS, and HTTPS_PROXY/NO_PROXY settings"
+    }
+
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  validator.ReasonConnectivityFailed,
+        Message: message,
+        Details: details,
+    }
+}
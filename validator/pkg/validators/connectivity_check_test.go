@@ -0,0 +1,81 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "net"
+    "net/url"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ConnectivityCheckValidator", func() {
+    var (
+        v    *validators.ConnectivityCheckValidator
+        vctx *validator.Context
+        crm  *fakeResourceManager
+    )
+
+    BeforeEach(func() {
+        v = &validators.ConnectivityCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        crm = &fakeResourceManager{project: &cloudresourcemanager.Project{
+            ProjectId:     "test-project",
+            ProjectNumber: 123456789,
+        }}
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Metadata", func() {
+        It("should run at Level 0", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("connectivity-check"))
+            Expect(meta.RunAfter).To(BeEmpty())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the project can be read", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ConnectivityOK"))
+            Expect(vctx.ProjectNumber).To(Equal(int64(123456789)))
+        })
+
+        DescribeTable("should fail with ConnectivityFailed and classify the error",
+            func(err error, kind string) {
+                crm.err = err
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("ConnectivityFailed"))
+                Expect(result.Details).To(HaveKeyWithValue("failure_kind", kind))
+            },
+            Entry("401 as auth", &googleapi.Error{Code: 401}, "auth"),
+            Entry("403 as auth", &googleapi.Error{Code: 403}, "auth"),
+            Entry("a dial error as network",
+                &url.Error{Op: "Get", URL: "https://cloudresourcemanager.googleapis.com", Err: &net.OpError{Op: "dial", Err: &net.DNSError{IsNotFound: true}}},
+                "network"),
+            Entry("a deadline as network", context.DeadlineExceeded, "network"),
+            Entry("other API errors as api", &googleapi.Error{Code: 500}, "api"),
+        )
+    })
+})
@@ -0,0 +1,194 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "strings"
+    "time"
+
+    "google.golang.org/api/dns/v1"
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the DNS server policy lookup
+    dnsPolicyRequestTimeout = 30 * time.Second
+)
+
+// DNSPolicyCheckValidator checks that the DNS server policy DNS_POLICY_NAME is attached to the VPC network
+// and, with REQUIRE_INBOUND_FORWARDING, that it accepts queries forwarded from on-premises resolvers
+type DNSPolicyCheckValidator struct{}
+
+// init registers the DNSPolicyCheckValidator with the global validator registry
+func init() {
+    validator.Register(&DNSPolicyCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *DNSPolicyCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "dns-policy-check",
+        Description: "Verify the Cloud DNS server policy is attached to the VPC network with the expected forwarding settings",
+        RunAfter:    []string{"api-enabled"}, // Requires dns.googleapis.com
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs dns-policy-check calls so api-enabled checks them
+func (v *DNSPolicyCheckValidator) RequiredAPIs() []string {
+    return []string{"dns.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless DNS_POLICY_NAME is set
+func (v *DNSPolicyCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("DNS_POLICY_NAME")
+}
+
+// Describe names the policy, the network and the forwarding expectation that will be checked
+func (v *DNSPolicyCheckValidator) Describe(vctx *validator.Context) string {
+    network := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    description := fmt.Sprintf("will verify DNS server policy %s is attached to VPC network %s in project %s",
+        vctx.Config.DNSPolicyName, network, vctx.Config.ProjectID)
+    if vctx.Config.RequireInboundForwarding {
+        description += " with inbound forwarding enabled"
+    }
+    return description
+}
+
+// Validate fetches the DNS server policy and checks its networks and inbound forwarding setting
+func (v *DNSPolicyCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := vctx.Config.DNSPolicyName
+    network := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    slog.Info("Checking DNS server policy", "policy", name, "network", network,
+        "require_inbound_forwarding", vctx.Config.RequireInboundForwarding)
+
+    ctx, cancel := context.WithTimeout(ctx, dnsPolicyRequestTimeout)
+    defer cancel()
+
+    dnsSvc, err := vctx.GetDNSAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud DNS client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonDNSClientError),
+            Message: fmt.Sprintf("Failed to get Cloud DNS client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    policy, err := dnsSvc.GetPolicy(ctx, vctx.Config.ProjectID, name)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonDNSPolicyNotFound,
+                Message: fmt.Sprintf("DNS server policy %s does not exist in project %s", name, vctx.Config.ProjectID),
+                Details: map[string]interface{}{
+                    "policy":     name,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Create it with: gcloud dns policies create <name> --networks=<network> --enable-inbound-forwarding",
+                },
+            }
+        }
+
+        slog.Error("Failed to get DNS server policy",
+            "error", err.Error(),
+            "policy", name,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonDNSPolicyCheckFailed),
+            Message: fmt.Sprintf("Failed to get DNS server policy %s: %v", name, err),
+            Details: map[string]interface{}{
+                "policy":     name,
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant dns.policies.get to the validator's service account",
+            },
+        }
+    }
+
+    attached := false
+    var networks []string
+    for _, n := range policy.Networks {
+        project, networkName, ok := parseNetworkURL(n.NetworkUrl)
+        if !ok {
+            networks = append(networks, n.NetworkUrl)
+            continue
+        }
+        networks = append(networks, networkName)
+        if networkName == network && project == vctx.Config.ProjectID {
+            attached = true
+        }
+    }
+
+    details := map[string]interface{}{
+        "policy":                    name,
+        "network":                   network,
+        "attached_networks":         networks,
+        "enable_inbound_forwarding": policy.EnableInboundForwarding,
+        "outbound_name_servers":     outboundNameServers(policy),
+        "project_id":                vctx.Config.ProjectID,
+    }
+
+    var violations []string
+    if !attached {
+        violations = append(violations, fmt.Sprintf("policy is not attached to VPC network %s", network))
+    }
+    if vctx.Config.RequireInboundForwarding && !policy.EnableInboundForwarding {
+        violations = append(violations, "inbound forwarding is disabled but REQUIRE_INBOUND_FORWARDING is set")
+    }
+
+    if len(violations) > 0 {
+        details["violations"] = violations
+        details["hint"] = "Update the policy with: gcloud dns policies update <name> --networks=<network> --enable-inbound-forwarding"
+        slog.Warn("DNS server policy is misconfigured", "policy", name, "violations", violations)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonDNSPolicyMisconfigured,
+            Message: fmt.Sprintf("DNS server policy %s is misconfigured: %s", name, strings.Join(violations, "; ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("DNS server policy %s is attached to VPC network %s with the expected forwarding settings", name, network)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonDNSPolicyValid,
+        Message: message,
+        Details: details,
+    }
+}
+
+// outboundNameServers returns the alternative name servers the policy forwards outbound queries to,
+// formatted as "<address> (<forwarding path>)"; empty when the policy does not forward outbound
+func outboundNameServers(policy *dns.Policy) []string {
+    if policy.AlternativeNameServerConfig == nil {
+        return []string{}
+    }
+    servers := make([]string, 0, len(policy.AlternativeNameServerConfig.TargetNameServers))
+    for _, s := range policy.AlternativeNameServerConfig.TargetNameServers {
+        address := s.Ipv4Address
+        if address == "" {
+            address = s.Ipv6Address
+        }
+        path := s.ForwardingPath
+        if path == "" {
+            path = "default"
+        }
+        servers = append(servers, fmt.Sprintf("%s (%s)", address, path))
+    }
+    return servers
+}
@@ -0,0 +1,116 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/dns/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("DNSPolicyCheckValidator", func() {
+    var (
+        v      *validators.DNSPolicyCheckValidator
+        vctx   *validator.Context
+        dnsAPI *fakeDNS
+    )
+
+    BeforeEach(func() {
+        v = &validators.DNSPolicyCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("VPC_NAME", "cluster-vpc")
+        GinkgoT().Setenv("DNS_POLICY_NAME", "hybrid-dns")
+        GinkgoT().Setenv("REQUIRE_INBOUND_FORWARDING", "true")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        dnsAPI = &fakeDNS{policies: map[string]*dns.Policy{
+            "hybrid-dns": {
+                Name:                    "hybrid-dns",
+                EnableInboundForwarding: true,
+                Networks: []*dns.PolicyNetwork{
+                    {NetworkUrl: "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/cluster-vpc"},
+                },
+                AlternativeNameServerConfig: &dns.PolicyAlternativeNameServerConfig{
+                    TargetNameServers: []*dns.PolicyAlternativeNameServerConfigTargetNameServer{
+                        {Ipv4Address: "10.0.0.53", ForwardingPath: "private"},
+                    },
+                },
+            },
+        }}
+        vctx.SetDNSAPI(dnsAPI)
+    })
+
+    Describe("Enabled", func() {
+        It("should follow DNS_POLICY_NAME", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.DNSPolicyName = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    It("should pass when the policy is attached with inbound forwarding", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("DNSPolicyValid"))
+        Expect(result.Details).To(HaveKeyWithValue("enable_inbound_forwarding", true))
+        Expect(result.Details["attached_networks"]).To(Equal([]string{"cluster-vpc"}))
+        Expect(result.Details["outbound_name_servers"]).To(Equal([]string{"10.0.0.53 (private)"}))
+    })
+
+    It("should fail when the policy is not attached to the VPC network", func() {
+        dnsAPI.policies["hybrid-dns"].Networks[0].NetworkUrl = "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/other-vpc"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("DNSPolicyMisconfigured"))
+        Expect(result.Details["violations"]).To(ConsistOf("policy is not attached to VPC network cluster-vpc"))
+    })
+
+    It("should fail when inbound forwarding is required but disabled", func() {
+        dnsAPI.policies["hybrid-dns"].EnableInboundForwarding = false
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("DNSPolicyMisconfigured"))
+        Expect(result.Details["violations"]).To(ConsistOf("inbound forwarding is disabled but REQUIRE_INBOUND_FORWARDING is set"))
+    })
+
+    It("should not require inbound forwarding unless REQUIRE_INBOUND_FORWARDING is set", func() {
+        vctx.Config.RequireInboundForwarding = false
+        dnsAPI.policies["hybrid-dns"].EnableInboundForwarding = false
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+    })
+
+    It("should report a missing policy", func() {
+        vctx.Config.DNSPolicyName = "missing-policy"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("DNSPolicyNotFound"))
+    })
+
+    It("should fail when the policy cannot be read", func() {
+        dnsAPI.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
@@ -0,0 +1,567 @@
+package validators_test
+
+import (
+    "context"
+    "strings"
+
+    "google.golang.org/api/cloudkms/v1"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/dns/v1"
+    file "google.golang.org/api/file/v1"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/iam/v1"
+    iamv2 "google.golang.org/api/iam/v2"
+    "google.golang.org/api/iamcredentials/v1"
+    logging "google.golang.org/api/logging/v2"
+    "google.golang.org/api/servicenetworking/v1"
+    "google.golang.org/api/serviceusage/v1"
+    serviceusagebeta "google.golang.org/api/serviceusage/v1beta1"
+    "google.golang.org/api/storage/v1"
+)
+
+// fakeServiceUsage implements gcp.ServiceUsageAPI with canned responses keyed by service name
+type fakeServiceUsage struct {
+    states map[string]string // service name -> state (e.g., "ENABLED")
+    errs   map[string]error  // service name -> error to return
+}
+
+func (f *fakeServiceUsage) GetService(ctx context.Context, name string) (*serviceusage.GoogleApiServiceusageV1Service, error) {
+    if err, ok := f.errs[name]; ok {
+        return nil, err
+    }
+    state, ok := f.states[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "service not found"}
+    }
+    return &serviceusage.GoogleApiServiceusageV1Service{Name: name, State: state}, nil
+}
+
+// ListEnabledServices returns the ENABLED entries of states under parent, or errs[parent]
+func (f *fakeServiceUsage) ListEnabledServices(ctx context.Context, parent string) ([]*serviceusage.GoogleApiServiceusageV1Service, error) {
+    if err, ok := f.errs[parent]; ok {
+        return nil, err
+    }
+    var services []*serviceusage.GoogleApiServiceusageV1Service
+    for name, state := range f.states {
+        if state == "ENABLED" && strings.HasPrefix(name, parent+"/services/") {
+            services = append(services, &serviceusage.GoogleApiServiceusageV1Service{Name: name, State: state})
+        }
+    }
+    return services, nil
+}
+
+// fakeServiceQuota implements gcp.ServiceQuotaAPI with canned quota metrics keyed by parent
+type fakeServiceQuota struct {
+    metrics map[string][]*serviceusagebeta.ConsumerQuotaMetric // parent -> metrics
+    errs    map[string]error                                   // parent -> error to return
+}
+
+func (f *fakeServiceQuota) ListConsumerQuotaMetrics(ctx context.Context, parent string) ([]*serviceusagebeta.ConsumerQuotaMetric, error) {
+    if err, ok := f.errs[parent]; ok {
+        return nil, err
+    }
+    return f.metrics[parent], nil
+}
+
+// fakeCompute implements gcp.ComputeAPI with canned project, region, zone, certificate and hybrid connectivity resources
+type fakeCompute struct {
+    project     *compute.Project
+    regions     map[string]*compute.Region
+    zones       map[string]*compute.Zone
+    sslCerts    map[string]*compute.SslCertificate
+    vpnTunnels  []*compute.VpnTunnel
+    attachments []*compute.InterconnectAttachment
+    projectErr  error
+    regionErr   error
+    zoneErr     error
+    sslCertErr  error
+    hybridErr   error
+    instances   []*compute.Instance
+    disks       []*compute.Disk
+    networks    []*compute.Network
+    routes      []*compute.Route
+    firewalls   []*compute.Firewall
+    addresses   []*compute.Address
+    // globalAddresses are returned by ListGlobalAddresses, subject to listErr
+    globalAddresses []*compute.Address
+    listErr         error
+    networkErr      error
+    // subnetworks and subnetworkErrs by project, returned by ListSubnetworks
+    subnetworks    map[string][]*compute.Subnetwork
+    subnetworkErrs map[string]error
+    // accelerators lists the accelerator types offered per zone
+    accelerators   map[string][]string
+    acceleratorErr error
+    // templates, machineTypes (per zone) and images ("<project>/<name>", families as "<project>/family/<family>")
+    templates    map[string]*compute.InstanceTemplate
+    machineTypes map[string][]string
+    images       map[string]*compute.Image
+    templateErr  error
+    imageErr     error
+    // routers and their runtime status by name
+    routers      map[string]*compute.Router
+    routerStatus map[string]*compute.RouterStatus
+    routerErr    error
+}
+
+func (f *fakeCompute) GetProject(ctx context.Context, project string) (*compute.Project, error) {
+    if f.projectErr != nil {
+        return nil, f.projectErr
+    }
+    if f.project == nil {
+        return &compute.Project{Name: project}, nil
+    }
+    return f.project, nil
+}
+
+func (f *fakeResourceManager) GetIamPolicy(ctx context.Context, projectID string) (*cloudresourcemanager.Policy, error) {
+    if f.policyErr != nil {
+        return nil, f.policyErr
+    }
+    if f.policy == nil {
+        return &cloudresourcemanager.Policy{}, nil
+    }
+    return f.policy, nil
+}
+
+func (f *fakeResourceManager) GetAncestry(ctx context.Context, projectID string) ([]*cloudresourcemanager.Ancestor, error) {
+    if f.ancestryErr != nil {
+        return nil, f.ancestryErr
+    }
+    if f.ancestry == nil {
+        return []*cloudresourcemanager.Ancestor{{ResourceId: &cloudresourcemanager.ResourceId{Type: "project", Id: projectID}}}, nil
+    }
+    return f.ancestry, nil
+}
+
+func (f *fakeResourceManager) GetEffectiveOrgPolicy(ctx context.Context, projectID, constraint string) (*cloudresourcemanager.OrgPolicy, error) {
+    if f.orgPolicyErr != nil {
+        return nil, f.orgPolicyErr
+    }
+    if policy, ok := f.orgPolicies[constraint]; ok {
+        return policy, nil
+    }
+    return &cloudresourcemanager.OrgPolicy{Constraint: constraint}, nil
+}
+
+func (f *fakeCompute) GetRegion(ctx context.Context, project, region string) (*compute.Region, error) {
+    if f.regionErr != nil {
+        return nil, f.regionErr
+    }
+    r, ok := f.regions[region]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "region not found"}
+    }
+    return r, nil
+}
+
+func (f *fakeCompute) GetZone(ctx context.Context, project, zone string) (*compute.Zone, error) {
+    if f.zoneErr != nil {
+        return nil, f.zoneErr
+    }
+    z, ok := f.zones[zone]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "zone not found"}
+    }
+    return z, nil
+}
+
+// fakeResourceManager implements gcp.ResourceManagerAPI with a canned project and IAM policy
+type fakeResourceManager struct {
+    project     *cloudresourcemanager.Project
+    policy      *cloudresourcemanager.Policy
+    ancestry    []*cloudresourcemanager.Ancestor // Default: the project alone
+    err         error
+    policyErr   error
+    ancestryErr error
+    // orgPolicies are the effective org policies by constraint; unset constraints have an empty policy
+    orgPolicies  map[string]*cloudresourcemanager.OrgPolicy
+    orgPolicyErr error
+}
+
+func (f *fakeResourceManager) GetProject(ctx context.Context, projectID string) (*cloudresourcemanager.Project, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    return f.project, nil
+}
+
+// fakeIAM implements gcp.IAMAPI with canned service accounts keyed by resource name
+type fakeIAM struct {
+    serviceAccounts map[string]*iam.ServiceAccount
+    err             error
+}
+
+func (f *fakeIAM) GetServiceAccount(ctx context.Context, name string) (*iam.ServiceAccount, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    sa, ok := f.serviceAccounts[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "service account not found"}
+    }
+    return sa, nil
+}
+
+func (f *fakeCompute) GetSslCertificate(ctx context.Context, project, name string) (*compute.SslCertificate, error) {
+    if f.sslCertErr != nil {
+        return nil, f.sslCertErr
+    }
+    c, ok := f.sslCerts[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "ssl certificate not found"}
+    }
+    return c, nil
+}
+
+func (f *fakeCompute) GetAcceleratorType(ctx context.Context, project, zone, name string) (*compute.AcceleratorType, error) {
+    if f.acceleratorErr != nil {
+        return nil, f.acceleratorErr
+    }
+    for _, a := range f.accelerators[zone] {
+        if a == name {
+            return &compute.AcceleratorType{Name: name, Zone: zone}, nil
+        }
+    }
+    return nil, &googleapi.Error{Code: 404, Message: "accelerator type not found"}
+}
+
+func (f *fakeCompute) GetNetwork(ctx context.Context, project, name string) (*compute.Network, error) {
+    if f.networkErr != nil {
+        return nil, f.networkErr
+    }
+    for _, n := range f.networks {
+        if n.Name == name {
+            return n, nil
+        }
+    }
+    return nil, &googleapi.Error{Code: 404, Message: "network not found"}
+}
+
+func (f *fakeCompute) GetRouter(ctx context.Context, project, region, name string) (*compute.Router, error) {
+    if f.routerErr != nil {
+        return nil, f.routerErr
+    }
+    if r, ok := f.routers[name]; ok {
+        return r, nil
+    }
+    return nil, &googleapi.Error{Code: 404, Message: "router not found"}
+}
+
+func (f *fakeCompute) GetRouterStatus(ctx context.Context, project, region, name string) (*compute.RouterStatusResponse, error) {
+    if f.routerErr != nil {
+        return nil, f.routerErr
+    }
+    return &compute.RouterStatusResponse{Result: f.routerStatus[name]}, nil
+}
+
+func (f *fakeCompute) GetAddress(ctx context.Context, project, region, name string) (*compute.Address, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    for _, a := range f.addresses {
+        if a.Name == name {
+            return a, nil
+        }
+    }
+    return nil, &googleapi.Error{Code: 404, Message: "address not found"}
+}
+
+func (f *fakeCompute) ListAddresses(ctx context.Context, project, region string) ([]*compute.Address, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.addresses, nil
+}
+
+func (f *fakeCompute) ListVpnTunnels(ctx context.Context, project string) ([]*compute.VpnTunnel, error) {
+    if f.hybridErr != nil {
+        return nil, f.hybridErr
+    }
+    return f.vpnTunnels, nil
+}
+
+func (f *fakeCompute) ListInterconnectAttachments(ctx context.Context, project string) ([]*compute.InterconnectAttachment, error) {
+    if f.hybridErr != nil {
+        return nil, f.hybridErr
+    }
+    return f.attachments, nil
+}
+
+func (f *fakeCompute) ListInstances(ctx context.Context, project string) ([]*compute.Instance, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.instances, nil
+}
+
+func (f *fakeCompute) ListDisks(ctx context.Context, project string) ([]*compute.Disk, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.disks, nil
+}
+
+func (f *fakeCompute) ListNetworks(ctx context.Context, project string) ([]*compute.Network, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.networks, nil
+}
+
+func (f *fakeCompute) ListGlobalAddresses(ctx context.Context, project string) ([]*compute.Address, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.globalAddresses, nil
+}
+
+func (f *fakeCompute) ListSubnetworks(ctx context.Context, project string) ([]*compute.Subnetwork, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    if err, ok := f.subnetworkErrs[project]; ok {
+        return nil, err
+    }
+    return f.subnetworks[project], nil
+}
+
+func (f *fakeCompute) ListRoutes(ctx context.Context, project string) ([]*compute.Route, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.routes, nil
+}
+
+func (f *fakeCompute) ListFirewalls(ctx context.Context, project string) ([]*compute.Firewall, error) {
+    if f.listErr != nil {
+        return nil, f.listErr
+    }
+    return f.firewalls, nil
+}
+
+func (f *fakeCompute) GetInstanceTemplate(ctx context.Context, project, name string) (*compute.InstanceTemplate, error) {
+    if f.templateErr != nil {
+        return nil, f.templateErr
+    }
+    t, ok := f.templates[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "instance template not found"}
+    }
+    return t, nil
+}
+
+func (f *fakeCompute) GetMachineType(ctx context.Context, project, zone, name string) (*compute.MachineType, error) {
+    for _, m := range f.machineTypes[zone] {
+        if m == name {
+            return &compute.MachineType{Name: name, Zone: zone}, nil
+        }
+    }
+    return nil, &googleapi.Error{Code: 404, Message: "machine type not found"}
+}
+
+func (f *fakeCompute) GetImage(ctx context.Context, project, name string) (*compute.Image, error) {
+    if f.imageErr != nil {
+        return nil, f.imageErr
+    }
+    image, ok := f.images[project+"/"+name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "image not found"}
+    }
+    return image, nil
+}
+
+func (f *fakeCompute) GetImageFromFamily(ctx context.Context, project, family string) (*compute.Image, error) {
+    return f.GetImage(ctx, project, "family/"+family)
+}
+
+// fakeStorage implements gcp.StorageAPI with canned bucket IAM policies and metadata keyed by bucket name
+type fakeStorage struct {
+    policies map[string]*storage.Policy
+    buckets  map[string]*storage.Bucket
+    err      error
+}
+
+func (f *fakeStorage) GetBucketIamPolicy(ctx context.Context, bucket string) (*storage.Policy, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    policy, ok := f.policies[bucket]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "bucket not found"}
+    }
+    return policy, nil
+}
+
+func (f *fakeStorage) GetBucket(ctx context.Context, bucket string) (*storage.Bucket, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    b, ok := f.buckets[bucket]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "bucket not found"}
+    }
+    return b, nil
+}
+
+// fakeFilestore implements gcp.FilestoreAPI with canned instances keyed by full resource name
+type fakeFilestore struct {
+    instances map[string]*file.Instance
+    err       error
+}
+
+func (f *fakeFilestore) GetInstance(ctx context.Context, name string) (*file.Instance, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    instance, ok := f.instances[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "instance not found"}
+    }
+    return instance, nil
+}
+
+// fakeKMS implements gcp.KMSAPI with canned keys and key IAM policies keyed by full resource name
+type fakeKMS struct {
+    keys      map[string]*cloudkms.CryptoKey
+    policies  map[string]*cloudkms.Policy
+    err       error
+    policyErr error
+}
+
+func (f *fakeKMS) GetCryptoKey(ctx context.Context, name string) (*cloudkms.CryptoKey, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    key, ok := f.keys[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "key not found"}
+    }
+    return key, nil
+}
+
+func (f *fakeKMS) GetCryptoKeyIamPolicy(ctx context.Context, name string) (*cloudkms.Policy, error) {
+    if f.policyErr != nil {
+        return nil, f.policyErr
+    }
+    if policy, ok := f.policies[name]; ok {
+        return policy, nil
+    }
+    return &cloudkms.Policy{}, nil
+}
+
+// fakeTags implements gcp.TagsAPI with canned tag bindings keyed by parent resource name
+type fakeTags struct {
+    bindings map[string][]*crmv3.TagBinding
+    err      error
+}
+
+func (f *fakeTags) ListTagBindings(ctx context.Context, parent string) ([]*crmv3.TagBinding, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    return f.bindings[parent], nil
+}
+
+// fakeIAMDeny implements gcp.IAMDenyAPI with canned deny policies keyed by attachment point
+// Listed policies keep their rules, so GetDenyPolicy looks them up by name
+type fakeIAMDeny struct {
+    policies map[string][]*iamv2.GoogleIamV2Policy
+    listErrs map[string]error
+}
+
+func (f *fakeIAMDeny) ListDenyPolicies(ctx context.Context, attachmentPoint string) ([]*iamv2.GoogleIamV2Policy, error) {
+    if err := f.listErrs[attachmentPoint]; err != nil {
+        return nil, err
+    }
+    return f.policies[attachmentPoint], nil
+}
+
+func (f *fakeIAMDeny) GetDenyPolicy(ctx context.Context, name string) (*iamv2.GoogleIamV2Policy, error) {
+    for _, policies := range f.policies {
+        for _, p := range policies {
+            if p.Name == name {
+                return p, nil
+            }
+        }
+    }
+    return nil, &googleapi.Error{Code: 404, Message: "policy not found"}
+}
+
+// fakeIAMCredentials implements gcp.IAMCredentialsAPI, issuing a token unless err is set
+type fakeIAMCredentials struct {
+    err    error
+    name   string
+    scopes []string
+}
+
+func (f *fakeIAMCredentials) GenerateAccessToken(ctx context.Context, name string, scopes []string, lifetime string) (*iamcredentials.GenerateAccessTokenResponse, error) {
+    f.name, f.scopes = name, scopes
+    if f.err != nil {
+        return nil, f.err
+    }
+    return &iamcredentials.GenerateAccessTokenResponse{AccessToken: "ya29.secret", ExpireTime: "2026-01-15T10:35:00Z"}, nil
+}
+
+// fakeServiceNetworking implements gcp.ServiceNetworkingAPI with canned connections keyed by network
+type fakeServiceNetworking struct {
+    connections map[string][]*servicenetworking.Connection
+    err         error
+}
+
+func (f *fakeServiceNetworking) ListConnections(ctx context.Context, network string) ([]*servicenetworking.Connection, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    return f.connections[network], nil
+}
+
+// fakeDNS implements gcp.DNSAPI with canned server policies keyed by policy name
+type fakeDNS struct {
+    policies map[string]*dns.Policy
+    err      error
+}
+
+func (f *fakeDNS) GetPolicy(ctx context.Context, project, name string) (*dns.Policy, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    policy, ok := f.policies[name]
+    if !ok {
+        return nil, &googleapi.Error{Code: 404, Message: "policy not found"}
+    }
+    return policy, nil
+}
+
+// fakeMetadata implements gcp.MetadataAPI with a canned project
+type fakeMetadata struct {
+    onGCE   bool
+    project string
+    err     error
+}
+
+func (f *fakeMetadata) OnGCE() bool {
+    return f.onGCE
+}
+
+func (f *fakeMetadata) ProjectID(ctx context.Context) (string, error) {
+    if f.err != nil {
+        return "", f.err
+    }
+    return f.project, nil
+}
+
+// fakeLogging implements gcp.LoggingAPI with canned log sinks
+type fakeLogging struct {
+    sinks []*logging.LogSink
+    err   error
+}
+
+func (f *fakeLogging) ListSinks(ctx context.Context, parent string) ([]*logging.LogSink, error) {
+    if f.err != nil {
+        return nil, f.err
+    }
+    return f.sinks, nil
+}
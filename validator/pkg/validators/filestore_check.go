@@ -0,0 +1,167 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "strings"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the Filestore instance lookup
+    filestoreCheckTimeout = 30 * time.Second
+
+    // State of a Filestore instance that is serving
+    filestoreStateReady = "READY"
+)
+
+// FilestoreCheckValidator checks that the Filestore instance workloads mount exists and is READY
+type FilestoreCheckValidator struct{}
+
+// init registers the FilestoreCheckValidator with the global validator registry
+func init() {
+    validator.Register(&FilestoreCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *FilestoreCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "filestore-check",
+        Description: "Verify the configured Filestore instance exists and is READY",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure file API is available
+        Tags:        []string{"post-mvp", "storage"},
+    }
+}
+
+// RequiredAPIs declares the APIs filestore-check calls so api-enabled checks them
+func (v *FilestoreCheckValidator) RequiredAPIs() []string {
+    return []string{"file.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless FILESTORE_INSTANCE is set
+func (v *FilestoreCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("FILESTORE_INSTANCE")
+}
+
+// Validate fetches the Filestore instance and checks its state
+func (v *FilestoreCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name, err := filestoreInstanceName(vctx)
+    if err != nil {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: err.Error(),
+            Details: map[string]interface{}{
+                "instance":   vctx.Config.FilestoreInstance,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set FILESTORE_LOCATION to the instance's zone or region, or GCP_REGION",
+            },
+        }
+    }
+
+    slog.Info("Checking Filestore instance", "instance", name)
+
+    ctx, cancel := context.WithTimeout(ctx, filestoreCheckTimeout)
+    defer cancel()
+
+    filestoreSvc, err := vctx.GetFilestoreAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Filestore client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonFilestoreClientError),
+            Message: fmt.Sprintf("Failed to get Filestore client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    instance, err := filestoreSvc.GetInstance(ctx, name)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonFilestoreNotFound,
+                Message: fmt.Sprintf("Filestore instance %s does not exist", name),
+                Details: map[string]interface{}{
+                    "instance":   name,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Check FILESTORE_INSTANCE and FILESTORE_LOCATION, or list instances with: gcloud filestore instances list",
+                },
+            }
+        }
+
+        slog.Error("Failed to get Filestore instance",
+            "error", err.Error(),
+            "instance", name,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonFilestoreCheckFailed),
+            Message: fmt.Sprintf("Failed to get Filestore instance %s: %v", name, err),
+            Details: map[string]interface{}{
+                "instance":   name,
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "instance":   name,
+        "state":      instance.State,
+        "tier":       instance.Tier,
+        "project_id": vctx.Config.ProjectID,
+    }
+
+    if instance.State != filestoreStateReady {
+        slog.Warn("Filestore instance is not ready", "instance", name, "state", instance.State)
+        if instance.StatusMessage != "" {
+            details["status_message"] = instance.StatusMessage
+        }
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonFilestoreNotReady,
+            Message: fmt.Sprintf("Filestore instance %s is %s, not %s", name, instance.State, filestoreStateReady),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Filestore instance %s is %s", name, instance.State)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonFilestoreReady,
+        Message: message,
+        Details: details,
+    }
+}
+
+// filestoreInstanceName returns the full resource name of FILESTORE_INSTANCE
+// A bare instance ID is placed in FILESTORE_LOCATION, falling back to GCP_REGION
+func filestoreInstanceName(vctx *validator.Context) (string, error) {
+    cfg := vctx.Config
+    if strings.HasPrefix(cfg.FilestoreInstance, "projects/") {
+        return cfg.FilestoreInstance, nil
+    }
+    location := cfg.FilestoreLocation
+    if location == "" {
+        location = cfg.GCPRegion
+    }
+    if location == "" {
+        return "", fmt.Errorf("FILESTORE_LOCATION or GCP_REGION is required to find Filestore instance %s", cfg.FilestoreInstance)
+    }
+    return fmt.Sprintf("projects/%s/locations/%s/instances/%s", cfg.ProjectID, location, cfg.FilestoreInstance), nil
+}
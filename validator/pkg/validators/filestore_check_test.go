@@ -0,0 +1,108 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    file "google.golang.org/api/file/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("FilestoreCheckValidator", func() {
+    var (
+        v             *validators.FilestoreCheckValidator
+        vctx          *validator.Context
+        filestoreFake *fakeFilestore
+    )
+
+    const instanceName = "projects/test-project/locations/us-central1-a/instances/shared"
+
+    BeforeEach(func() {
+        v = &validators.FilestoreCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("FILESTORE_INSTANCE", "shared")
+        GinkgoT().Setenv("FILESTORE_LOCATION", "us-central1-a")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        filestoreFake = &fakeFilestore{instances: map[string]*file.Instance{
+            instanceName: {Name: instanceName, State: "READY", Tier: "BASIC_HDD"},
+        }}
+        vctx.SetFilestoreAPI(filestoreFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without FILESTORE_INSTANCE", func() {
+            vctx.Config.FilestoreInstance = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the instance is READY", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("FilestoreReady"))
+            Expect(result.Details).To(HaveKeyWithValue("tier", "BASIC_HDD"))
+        })
+
+        It("should accept a full resource name", func() {
+            vctx.Config.FilestoreInstance = instanceName
+            vctx.Config.FilestoreLocation = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should fall back to GCP_REGION for the location", func() {
+            vctx.Config.FilestoreLocation = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("FilestoreNotFound"))
+            Expect(result.Details).To(HaveKeyWithValue("instance", "projects/test-project/locations/us-central1/instances/shared"))
+        })
+
+        It("should fail without any location", func() {
+            vctx.Config.FilestoreLocation = ""
+            vctx.Config.GCPRegion = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("RegionNotConfigured"))
+        })
+
+        It("should fail when the instance is not READY", func() {
+            filestoreFake.instances[instanceName].State = "REPAIRING"
+            filestoreFake.instances[instanceName].StatusMessage = "maintenance in progress"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("FilestoreNotReady"))
+            Expect(result.Details).To(HaveKeyWithValue("state", "REPAIRING"))
+            Expect(result.Details).To(HaveKeyWithValue("status_message", "maintenance in progress"))
+        })
+
+        It("should fail when the lookup errors", func() {
+            filestoreFake.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
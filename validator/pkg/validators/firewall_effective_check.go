@@ -0,0 +1,288 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "net/netip"
+    "path"
+    "slices"
+    "strconv"
+    "strings"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "validator/pkg/config"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the firewall rule listing
+    firewallEffectiveCheckTimeout = 30 * time.Second
+
+    // Priority of the implied deny-all ingress rule every VPC network has
+    impliedDenyPriority = 65535
+)
+
+// firewallProtocolNumbers maps protocol names to the IP protocol numbers rules may use instead
+var firewallProtocolNumbers = map[string]string{
+    "icmp": "1",
+    "tcp":  "6",
+    "udp":  "17",
+    "esp":  "50",
+    "ah":   "51",
+    "sctp": "132",
+}
+
+// FirewallEffectiveCheckValidator checks that the REQUIRED_FIREWALL_FLOWS ingress flows would be
+// allowed to instances tagged FIREWALL_TARGET_TAG, evaluating allow and deny rules by priority the
+// way VPC firewalls do instead of only checking that some rule exists
+type FirewallEffectiveCheckValidator struct{}
+
+// firewallVerdict is the outcome of evaluating one flow against the firewall rules
+type firewallVerdict struct {
+    allowed  bool
+    rule     string // Deciding rule; empty for the implied deny
+    priority int64
+}
+
+// String describes the verdict for the result details, e.g. "blocked by deny-ssh (priority 900)"
+func (v firewallVerdict) String() string {
+    action := "blocked"
+    if v.allowed {
+        action = "allowed"
+    }
+    if v.rule == "" {
+        return action + " by the implied deny ingress rule"
+    }
+    return fmt.Sprintf("%s by %s (priority %d)", action, v.rule, v.priority)
+}
+
+// init registers the FirewallEffectiveCheckValidator with the global validator registry
+func init() {
+    validator.Register(&FirewallEffectiveCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *FirewallEffectiveCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "firewall-effective-check",
+        Description: "Verify the required ingress flows are allowed to the cluster's network tag",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs firewall-effective-check calls so api-enabled checks them
+func (v *FirewallEffectiveCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless both FIREWALL_TARGET_TAG and REQUIRED_FIREWALL_FLOWS are set
+func (v *FirewallEffectiveCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("FIREWALL_TARGET_TAG") && vctx.HasConfig("REQUIRED_FIREWALL_FLOWS")
+}
+
+// Validate lists the project's firewall rules and evaluates every required flow against those
+// that apply to the target tag, on VPC_NAME when set
+func (v *FirewallEffectiveCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    tag := vctx.Config.FirewallTargetTag
+    network := vpcNetwork(vctx, v.Metadata().Name, "")
+    flows := vctx.Config.RequiredFirewallFlows
+    slog.Info("Checking effective firewall rules", "target_tag", tag, "network", network, "flows", len(flows))
+
+    ctx, cancel := context.WithTimeout(ctx, firewallEffectiveCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    firewalls, err := computeSvc.ListFirewalls(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to list firewall rules",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonFirewallCheckFailed),
+            Message: fmt.Sprintf("Failed to list firewall rules: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    var applicable []*compute.Firewall
+    for _, fw := range firewalls {
+        if firewallAppliesTo(fw, network, tag) {
+            applicable = append(applicable, fw)
+        }
+    }
+
+    // Flow -> how it was decided
+    verdicts := make(map[string]string, len(flows))
+    var blocked []string
+    for _, flow := range flows {
+        verdict := evaluateFlow(applicable, flow)
+        verdicts[flow.String()] = verdict.String()
+        if !verdict.allowed {
+            blocked = append(blocked, flow.String())
+        }
+    }
+
+    details := map[string]interface{}{
+        "target_tag":       tag,
+        "flows":            verdicts,
+        "rules_considered": len(applicable),
+        "project_id":       vctx.Config.ProjectID,
+    }
+    if network != "" {
+        details["network"] = network
+    }
+
+    if len(blocked) > 0 {
+        slog.Warn("Required traffic is blocked by the firewall", "target_tag", tag, "blocked", blocked)
+        details["blocked_flows"] = blocked
+        details["hint"] = "Add an ingress allow rule targeting tag " + tag +
+            " with a lower priority number than any deny rule covering the flow"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonTrafficBlocked,
+            Message: fmt.Sprintf("%d of %d required flow(s) to tag %s are blocked: %s", len(blocked), len(flows), tag, strings.Join(blocked, ", ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("All %d required flow(s) to tag %s are allowed", len(flows), tag)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonTrafficAllowed,
+        Message: message,
+        Details: details,
+    }
+}
+
+// firewallAppliesTo reports whether an enabled ingress rule on network (any network when empty)
+// targets instances carrying tag; rules without targets apply to every instance
+// Rules targeting only service accounts are ignored, as the tag says nothing about the instance's account
+func firewallAppliesTo(fw *compute.Firewall, network, tag string) bool {
+    if fw.Disabled || (fw.Direction != "" && fw.Direction != "INGRESS") {
+        return false
+    }
+    if network != "" && path.Base(fw.Network) != network {
+        return false
+    }
+    if len(fw.TargetTags) == 0 && len(fw.TargetServiceAccounts) == 0 {
+        return true
+    }
+    return slices.Contains(fw.TargetTags, tag)
+}
+
+// evaluateFlow returns the verdict of the highest-priority rule matching flow (lowest number wins,
+// deny beats allow at equal priority), or the implied deny when no rule matches
+func evaluateFlow(firewalls []*compute.Firewall, flow config.FirewallFlow) firewallVerdict {
+    verdict := firewallVerdict{priority: impliedDenyPriority + 1}
+    for _, fw := range firewalls {
+        deny := len(fw.Denied) > 0
+        if fw.Priority > verdict.priority || (fw.Priority == verdict.priority && (!deny || !verdict.allowed)) {
+            continue
+        }
+        if !firewallMatchesFlow(fw, flow, deny) {
+            continue
+        }
+        verdict = firewallVerdict{allowed: !deny, rule: fw.Name, priority: fw.Priority}
+    }
+    if verdict.rule == "" {
+        verdict.priority = impliedDenyPriority
+    }
+    return verdict
+}
+
+// firewallMatchesFlow reports whether fw covers the flow's source, protocol and port
+// An allow rule must cover the whole source range, while a deny rule blocking any part of it counts
+func firewallMatchesFlow(fw *compute.Firewall, flow config.FirewallFlow, deny bool) bool {
+    if !firewallMatchesSource(fw, flow.Source, deny) {
+        return false
+    }
+    if deny {
+        for _, d := range fw.Denied {
+            if protocolMatches(d.IPProtocol, d.Ports, flow) {
+                return true
+            }
+        }
+        return false
+    }
+    for _, a := range fw.Allowed {
+        if protocolMatches(a.IPProtocol, a.Ports, flow) {
+            return true
+        }
+    }
+    return false
+}
+
+// firewallMatchesSource compares the rule's source ranges with the flow's source
+// A rule with no sources at all applies to 0.0.0.0/0; source tags and service accounts name
+// instances rather than ranges and never match a flow
+func firewallMatchesSource(fw *compute.Firewall, source netip.Prefix, deny bool) bool {
+    ranges := fw.SourceRanges
+    if len(ranges) == 0 && len(fw.SourceTags) == 0 && len(fw.SourceServiceAccounts) == 0 {
+        ranges = []string{"0.0.0.0/0"}
+    }
+    for _, r := range ranges {
+        prefix, err := netip.ParsePrefix(r)
+        if err != nil {
+            addr, addrErr := netip.ParseAddr(r)
+            if addrErr != nil {
+                continue
+            }
+            prefix = netip.PrefixFrom(addr, addr.BitLen())
+        }
+        if deny && prefix.Overlaps(source) {
+            return true
+        }
+        if prefix.Bits() <= source.Bits() && prefix.Contains(source.Addr()) {
+            return true
+        }
+    }
+    return false
+}
+
+// protocolMatches reports whether a rule's protocol and port list cover the flow
+// "all" covers every protocol and an empty port list covers every port
+func protocolMatches(protocol string, ports []string, flow config.FirewallFlow) bool {
+    protocol = strings.ToLower(protocol)
+    if protocol != "all" && protocol != flow.Protocol && protocol != firewallProtocolNumbers[flow.Protocol] {
+        return false
+    }
+    if len(ports) == 0 || flow.Port == 0 {
+        return true
+    }
+    for _, p := range ports {
+        low, high, isRange := strings.Cut(p, "-")
+        if !isRange {
+            high = low
+        }
+        lo, errLo := strconv.Atoi(low)
+        hi, errHi := strconv.Atoi(high)
+        if errLo == nil && errHi == nil && flow.Port >= lo && flow.Port <= hi {
+            return true
+        }
+    }
+    return false
+}
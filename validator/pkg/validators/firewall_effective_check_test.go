@@ -0,0 +1,147 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("FirewallEffectiveCheckValidator", func() {
+    var (
+        v           *validators.FirewallEffectiveCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    const networkURL = "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/cluster-vpc"
+
+    BeforeEach(func() {
+        v = &validators.FirewallEffectiveCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("VPC_NAME", "cluster-vpc")
+        GinkgoT().Setenv("FIREWALL_TARGET_TAG", "cluster-node")
+        GinkgoT().Setenv("REQUIRED_FIREWALL_FLOWS", "tcp:6443,tcp:22@10.0.0.0/8")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{firewalls: []*compute.Firewall{
+            {
+                Name:         "allow-api",
+                Network:      networkURL,
+                Direction:    "INGRESS",
+                Priority:     1000,
+                SourceRanges: []string{"0.0.0.0/0"},
+                TargetTags:   []string{"cluster-node"},
+                Allowed:      []*compute.FirewallAllowed{{IPProtocol: "tcp", Ports: []string{"6443"}}},
+            },
+            {
+                Name:         "allow-internal",
+                Network:      networkURL,
+                Direction:    "INGRESS",
+                Priority:     1000,
+                SourceRanges: []string{"10.0.0.0/8"},
+                Allowed:      []*compute.FirewallAllowed{{IPProtocol: "all"}},
+            },
+        }}
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without flows", func() {
+            vctx.Config.RequiredFirewallFlows = nil
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+
+        It("should not be enabled without a target tag", func() {
+            vctx.Config.FirewallTargetTag = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when every flow is allowed", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("TrafficAllowed"))
+            Expect(result.Details["flows"]).To(HaveKeyWithValue("tcp:22@10.0.0.0/8", "allowed by allow-internal (priority 1000)"))
+        })
+
+        It("should block flows no rule allows", func() {
+            vctx.Config.FirewallTargetTag = "other-node"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("TrafficBlocked"))
+            Expect(result.Details).To(HaveKeyWithValue("blocked_flows", []string{"tcp:6443@0.0.0.0/0"}))
+            Expect(result.Details["flows"]).To(HaveKeyWithValue("tcp:6443@0.0.0.0/0", "blocked by the implied deny ingress rule"))
+        })
+
+        It("should let a higher-priority deny rule win", func() {
+            computeFake.firewalls = append(computeFake.firewalls, &compute.Firewall{
+                Name:         "deny-ssh",
+                Network:      networkURL,
+                Priority:     900,
+                SourceRanges: []string{"10.1.0.0/16"},
+                TargetTags:   []string{"cluster-node"},
+                Denied:       []*compute.FirewallDenied{{IPProtocol: "6", Ports: []string{"20-25"}}},
+            })
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("TrafficBlocked"))
+            Expect(result.Details).To(HaveKeyWithValue("blocked_flows", []string{"tcp:22@10.0.0.0/8"}))
+            Expect(result.Details["flows"]).To(HaveKeyWithValue("tcp:22@10.0.0.0/8", "blocked by deny-ssh (priority 900)"))
+        })
+
+        It("should let deny win over allow at equal priority", func() {
+            computeFake.firewalls = append(computeFake.firewalls, &compute.Firewall{
+                Name:     "deny-all",
+                Network:  networkURL,
+                Priority: 1000,
+                Denied:   []*compute.FirewallDenied{{IPProtocol: "all"}},
+            })
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("TrafficBlocked"))
+            Expect(result.Details["blocked_flows"]).To(HaveLen(2))
+        })
+
+        It("should ignore disabled rules and rules on other networks", func() {
+            computeFake.firewalls[0].Disabled = true
+            computeFake.firewalls = append(computeFake.firewalls, &compute.Firewall{
+                Name:       "other-vpc-api",
+                Network:    "projects/test-project/global/networks/other-vpc",
+                Priority:   100,
+                TargetTags: []string{"cluster-node"},
+                Allowed:    []*compute.FirewallAllowed{{IPProtocol: "tcp"}},
+            })
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("TrafficBlocked"))
+            Expect(result.Details).To(HaveKeyWithValue("blocked_flows", []string{"tcp:6443@0.0.0.0/0"}))
+        })
+
+        It("should fail when the listing errors", func() {
+            computeFake.listErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
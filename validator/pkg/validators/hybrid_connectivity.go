@@ -0,0 +1,166 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "path"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the VPN tunnel and Interconnect attachment listings
+    hybridConnectivityRequestTimeout = 1 * time.Minute
+
+    // Status of a Cloud VPN tunnel that is carrying traffic
+    vpnTunnelStatusEstablished = "ESTABLISHED"
+    // State of an Interconnect attachment that is usable
+    interconnectAttachmentStateActive = "ACTIVE"
+)
+
+// HybridConnectivityValidator checks that a hybrid cluster can reach on-premises networks
+// At least one Cloud VPN tunnel must be ESTABLISHED or one Interconnect attachment ACTIVE,
+// restricted to GCP_REGION when it is set
+type HybridConnectivityValidator struct{}
+
+// init registers the HybridConnectivityValidator with the global validator registry
+func init() {
+    validator.Register(&HybridConnectivityValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *HybridConnectivityValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "hybrid-connectivity-check",
+        Description: "Verify a Cloud VPN tunnel or Interconnect attachment is up for hybrid clusters",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs hybrid-connectivity-check calls so api-enabled checks them
+func (v *HybridConnectivityValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRE_HYBRID_CONNECTIVITY is set
+func (v *HybridConnectivityValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRE_HYBRID_CONNECTIVITY")
+}
+
+// Validate lists VPN tunnels and Interconnect attachments and looks for one that is up
+func (v *HybridConnectivityValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    region := vctx.Config.GCPRegion
+    slog.Info("Checking hybrid connectivity", "region", region)
+
+    ctx, cancel := context.WithTimeout(ctx, hybridConnectivityRequestTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    tunnels, err := computeSvc.ListVpnTunnels(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        return hybridLookupFailure(vctx, "VPN tunnels", err)
+    }
+    attachments, err := computeSvc.ListInterconnectAttachments(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        return hybridLookupFailure(vctx, "Interconnect attachments", err)
+    }
+
+    // Region fields are full resource URLs; an unset GCP_REGION accepts any region
+    inRegion := func(regionURL string) bool {
+        return region == "" || path.Base(regionURL) == region
+    }
+
+    tunnelStates := map[string]string{}
+    var upTunnels []string
+    for _, t := range tunnels {
+        if !inRegion(t.Region) {
+            continue
+        }
+        tunnelStates[t.Name] = t.Status
+        if t.Status == vpnTunnelStatusEstablished {
+            upTunnels = append(upTunnels, t.Name)
+        }
+    }
+
+    attachmentStates := map[string]string{}
+    var upAttachments []string
+    for _, a := range attachments {
+        if !inRegion(a.Region) {
+            continue
+        }
+        attachmentStates[a.Name] = a.State
+        if a.State == interconnectAttachmentStateActive {
+            upAttachments = append(upAttachments, a.Name)
+        }
+    }
+
+    details := map[string]interface{}{
+        "vpn_tunnels":              tunnelStates,
+        "interconnect_attachments": attachmentStates,
+        "project_id":               vctx.Config.ProjectID,
+    }
+    if region != "" {
+        details["region"] = region
+    }
+
+    if len(upTunnels) == 0 && len(upAttachments) == 0 {
+        slog.Warn("No established hybrid connectivity found",
+            "region", region,
+            "vpn_tunnels", len(tunnelStates),
+            "interconnect_attachments", len(attachmentStates))
+        details["hint"] = "Create a Cloud VPN tunnel or Interconnect attachment to the on-premises network before provisioning a hybrid cluster"
+        message := fmt.Sprintf("No ESTABLISHED VPN tunnel or ACTIVE Interconnect attachment found (%d tunnel(s), %d attachment(s) checked)",
+            len(tunnelStates), len(attachmentStates))
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonNoHybridConnectivity,
+            Message: message,
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Hybrid connectivity available: %d established VPN tunnel(s), %d active Interconnect attachment(s)",
+        len(upTunnels), len(upAttachments))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonHybridConnectivityAvailable,
+        Message: message,
+        Details: details,
+    }
+}
+
+// hybridLookupFailure builds the failure result for a failed VPN tunnel or attachment listing
+func hybridLookupFailure(vctx *validator.Context, kind string, err error) *validator.Result {
+    slog.Error("Failed to list "+kind,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonHybridConnectivityCheckFailed),
+        Message: fmt.Sprintf("Failed to list %s: %v", kind, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,112 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("HybridConnectivityValidator", func() {
+    var (
+        v          *validators.HybridConnectivityValidator
+        vctx       *validator.Context
+        computeAPI *fakeCompute
+    )
+
+    const regionURL = "https://www.googleapis.com/compute/v1/projects/test-project/regions/"
+
+    BeforeEach(func() {
+        v = &validators.HybridConnectivityValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("REQUIRE_HYBRID_CONNECTIVITY", "true")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeAPI = &fakeCompute{}
+        vctx.SetComputeAPI(computeAPI)
+    })
+
+    Describe("Enabled", func() {
+        It("should be enabled when REQUIRE_HYBRID_CONNECTIVITY is set", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+        })
+
+        It("should not be enabled when REQUIRE_HYBRID_CONNECTIVITY is false", func() {
+            vctx.Config.RequireHybridConnectivity = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed with an established VPN tunnel in the region", func() {
+            computeAPI.vpnTunnels = []*compute.VpnTunnel{
+                {Name: "onprem-1", Region: regionURL + "us-central1", Status: "ESTABLISHED"},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("HybridConnectivityAvailable"))
+            Expect(result.Details).To(HaveKeyWithValue("vpn_tunnels", map[string]string{"onprem-1": "ESTABLISHED"}))
+        })
+
+        It("should succeed with an active Interconnect attachment in the region", func() {
+            computeAPI.attachments = []*compute.InterconnectAttachment{
+                {Name: "dc-attachment", Region: regionURL + "us-central1", State: "ACTIVE"},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should fail when nothing in the region is up, listing what was found", func() {
+            computeAPI.vpnTunnels = []*compute.VpnTunnel{
+                {Name: "onprem-1", Region: regionURL + "us-central1", Status: "NO_INCOMING_PACKETS"},
+                {Name: "other-region", Region: regionURL + "europe-west1", Status: "ESTABLISHED"},
+            }
+            computeAPI.attachments = []*compute.InterconnectAttachment{
+                {Name: "dc-attachment", Region: regionURL + "us-central1", State: "PENDING_PARTNER"},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("NoHybridConnectivity"))
+            Expect(result.Details).To(HaveKeyWithValue("vpn_tunnels", map[string]string{"onprem-1": "NO_INCOMING_PACKETS"}))
+            Expect(result.Details).To(HaveKeyWithValue("interconnect_attachments", map[string]string{"dc-attachment": "PENDING_PARTNER"}))
+        })
+
+        It("should accept any region when GCP_REGION is unset", func() {
+            vctx.Config.GCPRegion = ""
+            computeAPI.vpnTunnels = []*compute.VpnTunnel{
+                {Name: "other-region", Region: regionURL + "europe-west1", Status: "ESTABLISHED"},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should fail when listing fails", func() {
+            computeAPI.hybridErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
@@ -0,0 +1,321 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "slices"
+    "strings"
+    "time"
+
+    iamv2 "google.golang.org/api/iam/v2"
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the ancestry lookup and the deny policy listings of every ancestor
+    iamDenyCheckTimeout = 60 * time.Second
+
+    // Principal set matching every identity; deny rules on it affect everyone
+    iamDenyPublicPrincipal = "principalSet://goog/public:all"
+)
+
+// iamDenyAttachmentTypes maps Resource Manager ancestor types to their collection in an attachment point
+var iamDenyAttachmentTypes = map[string]string{
+    "project":      "projects",
+    "folder":       "folders",
+    "organization": "organizations",
+}
+
+// IAMDenyCheckValidator looks for IAM deny policies on the project, its folders and its organization
+// that could block IAM_DENY_PRINCIPAL from IAM_DENY_PERMISSIONS; deny rules override every allow
+// grant, which makes them the cause of 403s that the IAM policy cannot explain
+// Findings are warnings: group membership and deny conditions cannot be evaluated here
+type IAMDenyCheckValidator struct{}
+
+// iamDenyMatch is a deny rule that could affect the principal
+type iamDenyMatch struct {
+    Policy          string   `json:"policy"`
+    AttachedTo      string   `json:"attached_to"`
+    Description     string   `json:"description,omitempty"`
+    Permissions     []string `json:"permissions"`
+    DeniedPrincipal string   `json:"denied_principal"`
+    Condition       string   `json:"condition,omitempty"`
+}
+
+// init registers the IAMDenyCheckValidator with the global validator registry
+func init() {
+    validator.Register(&IAMDenyCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *IAMDenyCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "iam-deny-check",
+        Description: "Warn about IAM deny policies that could block the installer principal",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure the IAM API is available
+        Tags:        []string{"post-mvp", "iam"},
+    }
+}
+
+// RequiredAPIs declares the APIs iam-deny-check calls so api-enabled checks them
+func (v *IAMDenyCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com", "iam.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless CHECK_IAM_DENY is set
+func (v *IAMDenyCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CHECK_IAM_DENY")
+}
+
+// Validate reads the deny policies attached to every ancestor of the project and matches their rules
+// Ancestors whose policies cannot be read (typically folders and organizations outside the
+// validator's reach) are listed in details.unchecked instead of failing the check
+func (v *IAMDenyCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    principal := denyPrincipal(vctx.Config.IAMDenyPrincipal)
+    var permissions []string
+    for _, p := range vctx.Config.IAMDenyPermissions {
+        permissions = append(permissions, denyPermission(p))
+    }
+    slog.Info("Checking IAM deny policies", "principal", principal, "permissions", len(permissions))
+
+    ctx, cancel := context.WithTimeout(ctx, iamDenyCheckTimeout)
+    defer cancel()
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+    ancestry, err := crm.GetAncestry(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        return iamDenyLookupFailure(vctx, "ancestry of project "+vctx.Config.ProjectID, err)
+    }
+
+    denySvc, err := vctx.GetIAMDenyAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get IAM v2 client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMClientError),
+            Message: fmt.Sprintf("Failed to get IAM v2 client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    var matches []iamDenyMatch
+    var unchecked []string
+    checked := 0
+    for _, ancestor := range ancestry {
+        if ancestor.ResourceId == nil || iamDenyAttachmentTypes[ancestor.ResourceId.Type] == "" {
+            continue
+        }
+        attachmentPoint := fmt.Sprintf("cloudresourcemanager.googleapis.com/%s/%s",
+            iamDenyAttachmentTypes[ancestor.ResourceId.Type], ancestor.ResourceId.Id)
+
+        policies, err := readDenyPolicies(ctx, denySvc, attachmentPoint)
+        if err != nil {
+            // The project's own policies are the minimum this check must see
+            if ancestor.ResourceId.Type == "project" {
+                return iamDenyLookupFailure(vctx, "deny policies of "+attachmentPoint, err)
+            }
+            slog.Warn("Cannot read deny policies, skipping", "attachment_point", attachmentPoint, "error", err.Error())
+            unchecked = append(unchecked, attachmentPoint)
+            continue
+        }
+        checked++
+        for _, policy := range policies {
+            matches = append(matches, matchDenyPolicy(policy, attachmentPoint, principal, permissions)...)
+        }
+    }
+
+    details := map[string]interface{}{
+        "resources_checked": checked,
+        "project_id":        vctx.Config.ProjectID,
+    }
+    if principal != "" {
+        details["principal"] = principal
+    }
+    if len(unchecked) > 0 {
+        details["unchecked"] = unchecked
+    }
+
+    if len(matches) > 0 {
+        first := matches[0]
+        slog.Warn("Deny rules could block the principal", "rules", len(matches), "first_policy", first.Policy)
+        details["deny_rules"] = matches
+        details["hint"] = "Review the rules with: gcloud iam policies get <policy> --attachment-point=<attachment point> --kind=denypolicies; " +
+            "add the principal to exceptionPrincipals if it must not be denied"
+        return &validator.Result{
+            Status: validator.StatusWarning,
+            Reason: validator.ReasonPotentialIAMDeny,
+            Message: fmt.Sprintf("%d deny rule(s) could block the principal, e.g. policy %s on %s denies %s to %s",
+                len(matches), first.Policy, first.AttachedTo, strings.Join(first.Permissions, ", "), first.DeniedPrincipal),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("No deny rule affecting the principal on %d resource(s)", checked)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonNoIAMDeny,
+        Message: message,
+        Details: details,
+    }
+}
+
+// readDenyPolicies lists the deny policies of an attachment point and reads their rules,
+// which the listing omits
+func readDenyPolicies(ctx context.Context, svc gcp.IAMDenyAPI, attachmentPoint string) ([]*iamv2.GoogleIamV2Policy, error) {
+    listed, err := svc.ListDenyPolicies(ctx, attachmentPoint)
+    if err != nil {
+        return nil, err
+    }
+    policies := make([]*iamv2.GoogleIamV2Policy, 0, len(listed))
+    for _, p := range listed {
+        policy, err := svc.GetDenyPolicy(ctx, p.Name)
+        if err != nil {
+            return nil, err
+        }
+        policies = append(policies, policy)
+    }
+    return policies, nil
+}
+
+// matchDenyPolicy returns the rules of policy that could deny the principal (any principal when
+// empty) one of permissions (any permission when empty)
+func matchDenyPolicy(policy *iamv2.GoogleIamV2Policy, attachmentPoint, principal string, permissions []string) []iamDenyMatch {
+    var matches []iamDenyMatch
+    for _, rule := range policy.Rules {
+        deny := rule.DenyRule
+        if deny == nil {
+            continue
+        }
+        denied, ok := denyRulePrincipal(deny, principal)
+        if !ok {
+            continue
+        }
+        perms := deniedPermissions(deny, permissions)
+        if len(perms) == 0 {
+            continue
+        }
+        match := iamDenyMatch{
+            Policy:          policy.Name,
+            AttachedTo:      attachmentPoint,
+            Description:     rule.Description,
+            Permissions:     perms,
+            DeniedPrincipal: denied,
+        }
+        if deny.DenialCondition != nil {
+            match.Condition = deny.DenialCondition.Expression
+        }
+        matches = append(matches, match)
+    }
+    return matches
+}
+
+// denyRulePrincipal returns the denied principal through which the rule could affect principal
+// Principal sets other than public:all (groups, domains, pools) count, as their membership is unknown
+func denyRulePrincipal(deny *iamv2.GoogleIamV2DenyRule, principal string) (string, bool) {
+    if principal != "" && slices.Contains(deny.ExceptionPrincipals, principal) {
+        return "", false
+    }
+    for _, p := range deny.DeniedPrincipals {
+        if principal == "" || p == principal || p == iamDenyPublicPrincipal || strings.HasPrefix(p, "principalSet://") {
+            return p, true
+        }
+    }
+    return "", false
+}
+
+// deniedPermissions returns the permissions (in v2 form) the rule denies and does not except:
+// those of permissions it covers, or all its denied permissions when permissions is empty
+func deniedPermissions(deny *iamv2.GoogleIamV2DenyRule, permissions []string) []string {
+    if len(permissions) == 0 {
+        return deny.DeniedPermissions
+    }
+    var matched []string
+    for _, want := range permissions {
+        covered := slices.ContainsFunc(deny.DeniedPermissions, func(p string) bool { return denyPermissionCovers(p, want) })
+        excepted := slices.ContainsFunc(deny.ExceptionPermissions, func(p string) bool { return denyPermissionCovers(p, want) })
+        if covered && !excepted {
+            matched = append(matched, want)
+        }
+    }
+    return matched
+}
+
+// denyPermissionCovers reports whether a deny rule permission, exact or with a "<service>/*" or
+// "<service>/<resource>.*" wildcard, covers permission
+func denyPermissionCovers(pattern, permission string) bool {
+    if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+        return strings.HasPrefix(permission, prefix)
+    }
+    return pattern == permission
+}
+
+// denyPermission converts an IAM v1 permission ("compute.instances.create") to the v2 form deny
+// rules use ("compute.googleapis.com/instances.create"); v2 permissions are returned unchanged
+func denyPermission(permission string) string {
+    if strings.Contains(permission, "/") {
+        return permission
+    }
+    service, rest, ok := strings.Cut(permission, ".")
+    if !ok {
+        return permission
+    }
+    return service + ".googleapis.com/" + rest
+}
+
+// denyPrincipal converts an IAM v1 member ("serviceAccount:<email>") to the v2 principal identifier
+// deny rules use; members of other types are returned unchanged
+func denyPrincipal(member string) string {
+    kind, email, ok := strings.Cut(member, ":")
+    if !ok {
+        return member
+    }
+    switch kind {
+    case "serviceAccount":
+        return "principal://iam.googleapis.com/projects/-/serviceAccounts/" + email
+    case "user":
+        return "principal://goog/subject/" + email
+    case "group":
+        return "principalSet://goog/group/" + email
+    }
+    return member
+}
+
+// iamDenyLookupFailure builds the failure result for a failed ancestry or project deny policy lookup
+func iamDenyLookupFailure(vctx *validator.Context, what string, err error) *validator.Result {
+    slog.Error("Failed to get "+what,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonIAMDenyCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s: %v", what, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+            "hint":       "Grant iam.denypolicies.list and iam.denypolicies.get (roles/iam.denyReviewer) to the validator's service account",
+        },
+    }
+}
@@ -0,0 +1,149 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+    iamv2 "google.golang.org/api/iam/v2"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("IAMDenyCheckValidator", func() {
+    var (
+        v        *validators.IAMDenyCheckValidator
+        vctx     *validator.Context
+        denyFake *fakeIAMDeny
+        crm      *fakeResourceManager
+    )
+
+    const (
+        projectPoint = "cloudresourcemanager.googleapis.com/projects/test-project"
+        orgPoint     = "cloudresourcemanager.googleapis.com/organizations/456"
+        installer    = "principal://iam.googleapis.com/projects/-/serviceAccounts/installer@test-project.iam.gserviceaccount.com"
+    )
+
+    denyPolicy := func(name string, rule *iamv2.GoogleIamV2DenyRule) *iamv2.GoogleIamV2Policy {
+        return &iamv2.GoogleIamV2Policy{
+            Name:  name,
+            Rules: []*iamv2.GoogleIamV2PolicyRule{{Description: "test rule", DenyRule: rule}},
+        }
+    }
+
+    BeforeEach(func() {
+        v = &validators.IAMDenyCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("CHECK_IAM_DENY", "true")
+        GinkgoT().Setenv("IAM_DENY_PRINCIPAL", "installer@test-project.iam.gserviceaccount.com")
+        GinkgoT().Setenv("IAM_DENY_PERMISSIONS", "compute.instances.create,iam.serviceAccounts.actAs")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelError,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        crm = &fakeResourceManager{ancestry: []*cloudresourcemanager.Ancestor{
+            {ResourceId: &cloudresourcemanager.ResourceId{Type: "project", Id: "test-project"}},
+            {ResourceId: &cloudresourcemanager.ResourceId{Type: "organization", Id: "456"}},
+        }}
+        vctx.SetResourceManagerAPI(crm)
+
+        denyFake = &fakeIAMDeny{policies: map[string][]*iamv2.GoogleIamV2Policy{
+            projectPoint: {denyPolicy("policies/project/denypolicies/no-storage", &iamv2.GoogleIamV2DenyRule{
+                DeniedPrincipals:  []string{installer},
+                DeniedPermissions: []string{"storage.googleapis.com/buckets.delete"},
+            })},
+        }}
+        vctx.SetIAMDenyAPI(denyFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without CHECK_IAM_DENY", func() {
+            vctx.Config.CheckIAMDeny = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when no rule denies a required permission", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("NoIAMDeny"))
+            Expect(result.Details).To(HaveKeyWithValue("resources_checked", 2))
+        })
+
+        It("should warn about an inherited rule denying the principal", func() {
+            denyFake.policies[orgPoint] = []*iamv2.GoogleIamV2Policy{
+                denyPolicy("policies/org/denypolicies/no-compute", &iamv2.GoogleIamV2DenyRule{
+                    DeniedPrincipals:  []string{installer},
+                    DeniedPermissions: []string{"compute.googleapis.com/instances.*"},
+                }),
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("PotentialIAMDeny"))
+            Expect(result.Message).To(ContainSubstring("policies/org/denypolicies/no-compute"))
+            Expect(result.Details["deny_rules"]).To(HaveLen(1))
+        })
+
+        It("should treat principal sets as possibly including the principal", func() {
+            denyFake.policies[orgPoint] = []*iamv2.GoogleIamV2Policy{
+                denyPolicy("policies/org/denypolicies/group", &iamv2.GoogleIamV2DenyRule{
+                    DeniedPrincipals:  []string{"principalSet://goog/group/admins@example.com"},
+                    DeniedPermissions: []string{"iam.googleapis.com/serviceAccounts.actAs"},
+                    DenialCondition:   &iamv2.GoogleTypeExpr{Expression: "resource.matchTag('456/env', 'prod')"},
+                }),
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("PotentialIAMDeny"))
+            Expect(result.Message).To(ContainSubstring("principalSet://goog/group/admins@example.com"))
+        })
+
+        It("should ignore rules excepting the principal or the permission", func() {
+            denyFake.policies[orgPoint] = []*iamv2.GoogleIamV2Policy{
+                denyPolicy("policies/org/denypolicies/excepted", &iamv2.GoogleIamV2DenyRule{
+                    DeniedPrincipals:    []string{"principalSet://goog/public:all"},
+                    ExceptionPrincipals: []string{installer},
+                    DeniedPermissions:   []string{"compute.googleapis.com/*"},
+                }),
+                denyPolicy("policies/org/denypolicies/excepted-permission", &iamv2.GoogleIamV2DenyRule{
+                    DeniedPrincipals:     []string{installer},
+                    DeniedPermissions:    []string{"compute.googleapis.com/*"},
+                    ExceptionPermissions: []string{"compute.googleapis.com/instances.create"},
+                }),
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should skip ancestors whose policies cannot be read", func() {
+            denyFake.listErrs = map[string]error{orgPoint: &googleapi.Error{Code: 403, Message: "forbidden"}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("unchecked", []string{orgPoint}))
+        })
+
+        It("should fail when the project's policies cannot be read", func() {
+            denyFake.listErrs = map[string]error{projectPoint: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
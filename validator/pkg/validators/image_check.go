@@ -0,0 +1,173 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "strings"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+// Timeout for the image lookup
+const imageCheckTimeout = 30 * time.Second
+
+// ImageCheckValidator checks that the installer's pinned SOURCE_IMAGE resolves and is not deprecated
+// A DEPRECATED image still works and only warns; OBSOLETE and DELETED images fail
+type ImageCheckValidator struct{}
+
+// init registers the ImageCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ImageCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ImageCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "image-check",
+        Description: "Verify the configured source image or image family resolves and is not deprecated",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "compute"},
+    }
+}
+
+// RequiredAPIs declares the APIs image-check calls so api-enabled checks them
+func (v *ImageCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless SOURCE_IMAGE is set
+func (v *ImageCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("SOURCE_IMAGE")
+}
+
+// Describe names the image that will be checked
+func (v *ImageCheckValidator) Describe(vctx *validator.Context) string {
+    return fmt.Sprintf("will verify source image %s exists and is not deprecated", vctx.Config.SourceImage)
+}
+
+// Validate resolves the image (the family's latest image for family references) and checks its deprecation state
+func (v *ImageCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    source := vctx.Config.SourceImage
+    project, name, family := parseSourceImage(source, vctx.Config.ProjectID)
+    slog.Info("Checking source image", "image", source, "project", project, "family", family)
+
+    ctx, cancel := context.WithTimeout(ctx, imageCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    image, err := getSourceImage(ctx, computeSvc, project, name, family)
+    if err != nil {
+        if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+            hint := fmt.Sprintf("Check SOURCE_IMAGE, or list images with: gcloud compute images list --project=%s", project)
+            if family {
+                hint += " (a family only resolves while it has a non-deprecated image)"
+            }
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonImageNotFound,
+                Message: fmt.Sprintf("Source image %s does not exist", source),
+                Details: map[string]interface{}{
+                    "image":         source,
+                    "image_project": project,
+                    "project_id":    vctx.Config.ProjectID,
+                    "hint":          hint,
+                },
+            }
+        }
+        slog.Error("Failed to get source image",
+            "error", err.Error(),
+            "image", source)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonImageCheckFailed),
+            Message: fmt.Sprintf("Failed to get source image %s: %v", source, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant compute.images.get on the image project to the validator's service account",
+            },
+        }
+    }
+
+    // The self-link records exactly which image a family resolved to
+    details := map[string]interface{}{
+        "image":         source,
+        "image_project": project,
+        "self_link":     image.SelfLink,
+        "status":        image.Status,
+        "project_id":    vctx.Config.ProjectID,
+    }
+    if image.Family != "" {
+        details["family"] = image.Family
+    }
+
+    if image.Deprecated != nil && image.Deprecated.State != "" && image.Deprecated.State != "ACTIVE" {
+        state := image.Deprecated.State
+        details["deprecation_state"] = state
+        details["hint"] = "Pin the installer to a current image"
+        if image.Deprecated.Replacement != "" {
+            details["replacement"] = image.Deprecated.Replacement
+            details["hint"] = "Pin the installer to the replacement image " + image.Deprecated.Replacement
+        }
+
+        status := validator.StatusWarning
+        if unusableDeprecationStates[state] {
+            status = validator.StatusFailure
+        }
+        slog.Warn("Source image is deprecated", "image", source, "state", state)
+        return &validator.Result{
+            Status:  status,
+            Reason:  validator.ReasonImageDeprecated,
+            Message: fmt.Sprintf("Source image %s (%s) is %s", source, image.SelfLink, state),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Source image %s resolves to %s", source, image.SelfLink)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonImageAvailable,
+        Message: message,
+        Details: details,
+    }
+}
+
+// getSourceImage fetches an image parsed by parseSourceImage, resolving families to their latest image
+func getSourceImage(ctx context.Context, computeSvc gcp.ComputeAPI, project, name string, family bool) (*compute.Image, error) {
+    if family {
+        return computeSvc.GetImageFromFamily(ctx, project, name)
+    }
+    return computeSvc.GetImage(ctx, project, name)
+}
+
+// parseSourceImage splits SOURCE_IMAGE into its project and image or family name
+// "<project>/<family>" names an image family; anything else is read like an instance template's
+// source image (full URL, "projects/<p>/global/images/[family/]<name>" or a bare name in defaultProject)
+func parseSourceImage(source, defaultProject string) (project, name string, family bool) {
+    if p, f, ok := strings.Cut(source, "/"); ok && !strings.Contains(f, "/") &&
+        p != "projects" && p != "global" && p != "images" {
+        return p, f, true
+    }
+    return parseImageRef(source, defaultProject)
+}
@@ -0,0 +1,110 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ImageCheckValidator", func() {
+    var (
+        v           *validators.ImageCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    const selfLink = "https://www.googleapis.com/compute/v1/projects/rhcos-cloud/global/images/rhcos-414-x86-64"
+
+    BeforeEach(func() {
+        v = &validators.ImageCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("SOURCE_IMAGE", "rhcos-cloud/rhcos-414")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        image := &compute.Image{Name: "rhcos-414-x86-64", Family: "rhcos-414", SelfLink: selfLink, Status: "READY"}
+        computeFake = &fakeCompute{images: map[string]*compute.Image{
+            "rhcos-cloud/family/rhcos-414":  image,
+            "rhcos-cloud/rhcos-414-x86-64":  image,
+            "test-project/custom-installer": {Name: "custom-installer", SelfLink: "projects/test-project/global/images/custom-installer", Status: "READY"},
+        }}
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    It("should not be enabled without SOURCE_IMAGE", func() {
+        vctx.Config.SourceImage = ""
+        Expect(v.Enabled(vctx)).To(BeFalse())
+    })
+
+    It("should resolve a project/family reference to its latest image", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("ImageAvailable"))
+        Expect(result.Details).To(HaveKeyWithValue("self_link", selfLink))
+        Expect(result.Details).To(HaveKeyWithValue("family", "rhcos-414"))
+    })
+
+    DescribeTable("should resolve image references",
+        func(source string) {
+            vctx.Config.SourceImage = source
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        },
+        Entry("self-link", selfLink),
+        Entry("family path", "projects/rhcos-cloud/global/images/family/rhcos-414"),
+        Entry("bare name in PROJECT_ID", "custom-installer"),
+    )
+
+    It("should report a missing image", func() {
+        vctx.Config.SourceImage = "rhcos-cloud/rhcos-399"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("ImageNotFound"))
+    })
+
+    It("should warn about a DEPRECATED image", func() {
+        computeFake.images["rhcos-cloud/family/rhcos-414"].Deprecated = &compute.DeprecationStatus{
+            State:       "DEPRECATED",
+            Replacement: "projects/rhcos-cloud/global/images/rhcos-415-x86-64",
+        }
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusWarning))
+        Expect(result.Reason).To(Equal("ImageDeprecated"))
+        Expect(result.Details).To(HaveKeyWithValue("replacement", "projects/rhcos-cloud/global/images/rhcos-415-x86-64"))
+        Expect(result.Details).To(HaveKeyWithValue("self_link", selfLink))
+    })
+
+    It("should fail for an OBSOLETE image", func() {
+        computeFake.images["rhcos-cloud/family/rhcos-414"].Deprecated = &compute.DeprecationStatus{State: "OBSOLETE"}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("ImageDeprecated"))
+    })
+
+    It("should fail when the lookup errors", func() {
+        computeFake.imageErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
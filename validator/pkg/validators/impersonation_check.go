@@ -0,0 +1,137 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "time"
+
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the token request
+    impersonationCheckTimeout = 30 * time.Second
+
+    // Lifetime of the probe token; it is discarded, so the shortest practical lifetime
+    impersonationTokenLifetime = "300s"
+
+    // Scope of the probe token, read-only so it grants nothing the installer would need
+    impersonationTokenScope = "https://www.googleapis.com/auth/cloud-platform.read-only"
+)
+
+// ImpersonationCheckValidator checks that the validator's identity can impersonate IMPERSONATION_TARGET_SA
+// It mints a short-lived token through the IAM Credentials API, which is exactly what the installer does,
+// and discards it; a 403 means the caller lacks roles/iam.serviceAccountTokenCreator on the target
+type ImpersonationCheckValidator struct{}
+
+// init registers the ImpersonationCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ImpersonationCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ImpersonationCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "impersonation-check",
+        Description: "Verify the caller can impersonate the target service account by minting a short-lived token",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure the IAM Credentials API is available
+        Tags:        []string{"post-mvp", "iam"},
+    }
+}
+
+// RequiredAPIs declares the APIs impersonation-check calls so api-enabled checks them
+func (v *ImpersonationCheckValidator) RequiredAPIs() []string {
+    return []string{"iamcredentials.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless IMPERSONATION_TARGET_SA is set
+func (v *ImpersonationCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("IMPERSONATION_TARGET_SA")
+}
+
+// Describe names the service account that will be impersonated
+func (v *ImpersonationCheckValidator) Describe(vctx *validator.Context) string {
+    return fmt.Sprintf("will mint a %s read-only token as %s to verify the caller can impersonate it",
+        impersonationTokenLifetime, vctx.Config.ImpersonationTargetSA)
+}
+
+// Validate requests an access token for the target service account and reports whether it was issued
+func (v *ImpersonationCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    target := vctx.Config.ImpersonationTargetSA
+    slog.Info("Checking service account impersonation", "target", target)
+
+    ctx, cancel := context.WithTimeout(ctx, impersonationCheckTimeout)
+    defer cancel()
+
+    credentials, err := vctx.GetIAMCredentialsAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get IAM Credentials client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMClientError),
+            Message: fmt.Sprintf("Failed to get IAM Credentials client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    // The "-" wildcard lets IAM find the service account's project from its email
+    name := "projects/-/serviceAccounts/" + target
+    token, err := credentials.GenerateAccessToken(ctx, name, []string{impersonationTokenScope}, impersonationTokenLifetime)
+    if err != nil {
+        details := map[string]interface{}{
+            "target_service_account": target,
+            "project_id":             vctx.Config.ProjectID,
+        }
+        switch gcp.ClassifyError(err) {
+        case gcp.ErrorClassAuth:
+            details["hint"] = fmt.Sprintf("Grant roles/iam.serviceAccountTokenCreator on %s to the validator's identity", target)
+            slog.Warn("Service account impersonation denied", "target", target, "error", err.Error())
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonImpersonationDenied,
+                Message: fmt.Sprintf("Caller cannot impersonate %s: %v", target, err),
+                Details: details,
+            }
+        case gcp.ErrorClassNotFound:
+            details["hint"] = "Check IMPERSONATION_TARGET_SA, or list service accounts with: gcloud iam service-accounts list"
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonImpersonationTargetNotFound,
+                Message: fmt.Sprintf("Service account %s does not exist", target),
+                Details: details,
+            }
+        }
+        slog.Error("Failed to generate access token",
+            "error", err.Error(),
+            "target", target)
+        details["error_type"] = fmt.Sprintf("%T", err)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonImpersonationCheckFailed),
+            Message: fmt.Sprintf("Failed to generate an access token for %s: %v", target, err),
+            Details: details,
+        }
+    }
+
+    // Only the expiry is reported; the token itself must never reach logs or results
+    message := fmt.Sprintf("Caller can impersonate %s", target)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonImpersonationAllowed,
+        Message: message,
+        Details: map[string]interface{}{
+            "target_service_account": target,
+            "token_expire_time":      token.ExpireTime,
+            "project_id":             vctx.Config.ProjectID,
+        },
+    }
+}
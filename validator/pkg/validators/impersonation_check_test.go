@@ -0,0 +1,84 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ImpersonationCheckValidator", func() {
+    var (
+        v           *validators.ImpersonationCheckValidator
+        vctx        *validator.Context
+        credentials *fakeIAMCredentials
+    )
+
+    const target = "installer@test-project.iam.gserviceaccount.com"
+
+    BeforeEach(func() {
+        v = &validators.ImpersonationCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("IMPERSONATION_TARGET_SA", target)
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        credentials = &fakeIAMCredentials{}
+        vctx.SetIAMCredentialsAPI(credentials)
+    })
+
+    It("should not be enabled without IMPERSONATION_TARGET_SA", func() {
+        vctx.Config.ImpersonationTargetSA = ""
+        Expect(v.Enabled(vctx)).To(BeFalse())
+    })
+
+    It("should pass when a token is issued, without reporting the token", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("ImpersonationAllowed"))
+        Expect(result.Details).To(HaveKeyWithValue("token_expire_time", "2026-01-15T10:35:00Z"))
+        Expect(result.Details).NotTo(ContainElement("ya29.secret"))
+
+        Expect(credentials.name).To(Equal("projects/-/serviceAccounts/" + target))
+        Expect(credentials.scopes).To(Equal([]string{"https://www.googleapis.com/auth/cloud-platform.read-only"}))
+    })
+
+    It("should report ImpersonationDenied for a 403", func() {
+        credentials.err = &googleapi.Error{Code: 403, Message: "Permission 'iam.serviceAccounts.getAccessToken' denied"}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("ImpersonationDenied"))
+        Expect(result.Details["hint"]).To(ContainSubstring("roles/iam.serviceAccountTokenCreator"))
+    })
+
+    It("should report a missing target service account", func() {
+        credentials.err = &googleapi.Error{Code: 404, Message: "Not found; Gaia id not found for email"}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("ImpersonationTargetNotFound"))
+    })
+
+    It("should fail with the fallback reason for other errors", func() {
+        credentials.err = &googleapi.Error{Code: 503}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("HTTP_503"))
+    })
+})
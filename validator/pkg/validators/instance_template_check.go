@@ -0,0 +1,258 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "path"
+    "strings"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the template, machine type and image lookups
+    instanceTemplateCheckTimeout = 1 * time.Minute
+
+    // Status of an image that can be used to create disks
+    imageStatusReady = "READY"
+)
+
+// unusableDeprecationStates are deprecation states that make an image or machine type unusable
+// DEPRECATED resources still work and are not reported
+var unusableDeprecationStates = map[string]bool{
+    "OBSOLETE": true,
+    "DELETED":  true,
+}
+
+// InstanceTemplateCheckValidator checks that INSTANCE_TEMPLATE exists and that the machine type
+// and boot images it references are usable
+type InstanceTemplateCheckValidator struct{}
+
+// init registers the InstanceTemplateCheckValidator with the global validator registry
+func init() {
+    validator.Register(&InstanceTemplateCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *InstanceTemplateCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "instance-template-check",
+        Description: "Verify the configured instance template exists and its machine type and images are available",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "compute"},
+    }
+}
+
+// RequiredAPIs declares the APIs instance-template-check calls so api-enabled checks them
+func (v *InstanceTemplateCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless INSTANCE_TEMPLATE is set
+func (v *InstanceTemplateCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("INSTANCE_TEMPLATE")
+}
+
+// Validate fetches the template, then checks its machine type in every zone of GCP_REGION
+// and each disk's source image; every problem found is listed in the failure
+func (v *InstanceTemplateCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    cfg := vctx.Config
+    name := cfg.InstanceTemplate
+    slog.Info("Checking instance template", "template", name)
+
+    ctx, cancel := context.WithTimeout(ctx, instanceTemplateCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", cfg.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": cfg.ProjectID,
+            },
+        }
+    }
+
+    tpl, err := computeSvc.GetInstanceTemplate(ctx, cfg.ProjectID, name)
+    if err != nil {
+        if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonInstanceTemplateNotFound,
+                Message: fmt.Sprintf("Instance template %s does not exist", name),
+                Details: map[string]interface{}{
+                    "template":   name,
+                    "project_id": cfg.ProjectID,
+                    "hint":       "Check INSTANCE_TEMPLATE, or list templates with: gcloud compute instance-templates list",
+                },
+            }
+        }
+        return instanceTemplateLookupFailure(vctx, "instance template "+name, err)
+    }
+
+    details := map[string]interface{}{
+        "template":   name,
+        "project_id": cfg.ProjectID,
+    }
+    var problems []string
+
+    if tpl.Properties != nil && tpl.Properties.MachineType != "" {
+        machineType := path.Base(tpl.Properties.MachineType)
+        details["machine_type"] = machineType
+        found, err := v.checkMachineType(ctx, vctx, computeSvc, machineType, details)
+        if err != nil {
+            return instanceTemplateLookupFailure(vctx, "machine type "+machineType, err)
+        }
+        problems = append(problems, found...)
+    }
+
+    var images []string
+    if tpl.Properties != nil {
+        for _, disk := range tpl.Properties.Disks {
+            if disk.InitializeParams == nil || disk.InitializeParams.SourceImage == "" {
+                continue
+            }
+            source := disk.InitializeParams.SourceImage
+            images = append(images, source)
+            problem, err := checkTemplateImage(ctx, computeSvc, cfg.ProjectID, source)
+            if err != nil {
+                return instanceTemplateLookupFailure(vctx, "image "+source, err)
+            }
+            if problem != "" {
+                problems = append(problems, problem)
+            }
+        }
+    }
+    details["images"] = images
+
+    if len(problems) > 0 {
+        slog.Warn("Instance template is not usable", "template", name, "problems", problems)
+        details["problems"] = problems
+        details["hint"] = "Recreate the template with an available machine type and image; instance templates cannot be edited"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonInstanceTemplateInvalid,
+            Message: fmt.Sprintf("Instance template %s is not usable: %s", name, strings.Join(problems, "; ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Instance template %s exists and its machine type and %d image(s) are available", name, len(images))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonInstanceTemplateValid,
+        Message: message,
+        Details: details,
+    }
+}
+
+// checkMachineType looks the machine type up in every zone of GCP_REGION and returns a problem
+// per zone that lacks it or an unusable deprecation; without GCP_REGION it is not checked
+func (v *InstanceTemplateCheckValidator) checkMachineType(ctx context.Context, vctx *validator.Context, computeSvc gcp.ComputeAPI, machineType string, details map[string]interface{}) ([]string, error) {
+    cfg := vctx.Config
+    if cfg.GCPRegion == "" {
+        details["machine_type_checked"] = false
+        return nil, nil
+    }
+
+    r, err := computeSvc.GetRegion(ctx, cfg.ProjectID, cfg.GCPRegion)
+    if err != nil {
+        return nil, err
+    }
+
+    var problems, missingZones []string
+    for _, z := range r.Zones {
+        // Region zones are full resource URLs
+        zone := path.Base(z)
+        mt, err := computeSvc.GetMachineType(ctx, cfg.ProjectID, zone, machineType)
+        if err != nil {
+            if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+                missingZones = append(missingZones, zone)
+                continue
+            }
+            return nil, err
+        }
+        if mt.Deprecated != nil && unusableDeprecationStates[mt.Deprecated.State] {
+            problems = append(problems, fmt.Sprintf("machine type %s is %s in zone %s", machineType, mt.Deprecated.State, zone))
+        }
+    }
+    details["machine_type_checked"] = true
+    if len(missingZones) > 0 {
+        details["machine_type_unavailable_zones"] = missingZones
+        problems = append(problems, fmt.Sprintf("machine type %s is not offered in %s", machineType, strings.Join(missingZones, ", ")))
+    }
+    return problems, nil
+}
+
+// checkTemplateImage resolves a disk's source image and returns a problem when it is missing,
+// not READY or obsolete; a lookup error other than 404 is returned as err
+func checkTemplateImage(ctx context.Context, computeSvc gcp.ComputeAPI, defaultProject, source string) (string, error) {
+    project, name, family := parseImageRef(source, defaultProject)
+
+    var image *compute.Image
+    var err error
+    if family {
+        image, err = computeSvc.GetImageFromFamily(ctx, project, name)
+    } else {
+        image, err = computeSvc.GetImage(ctx, project, name)
+    }
+    if err != nil {
+        if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+            return fmt.Sprintf("image %s does not exist", source), nil
+        }
+        return "", err
+    }
+
+    if image.Deprecated != nil && unusableDeprecationStates[image.Deprecated.State] {
+        return fmt.Sprintf("image %s is %s", source, image.Deprecated.State), nil
+    }
+    if image.Status != "" && image.Status != imageStatusReady {
+        return fmt.Sprintf("image %s is %s, not %s", source, image.Status, imageStatusReady), nil
+    }
+    return "", nil
+}
+
+// parseImageRef splits a source image reference into its project and image or family name
+// Accepts full URLs, "projects/<p>/global/images/[family/]<name>", "global/images/[family/]<name>"
+// and bare image names; references without a project use defaultProject
+func parseImageRef(ref, defaultProject string) (project, name string, family bool) {
+    ref = strings.TrimPrefix(ref, "https://www.googleapis.com/compute/v1/")
+    project = defaultProject
+    if rest, ok := strings.CutPrefix(ref, "projects/"); ok {
+        project, ref, _ = strings.Cut(rest, "/")
+    }
+    ref = strings.TrimPrefix(ref, "global/")
+    ref = strings.TrimPrefix(ref, "images/")
+    if name, ok := strings.CutPrefix(ref, "family/"); ok {
+        return project, name, true
+    }
+    return project, ref, false
+}
+
+// instanceTemplateLookupFailure builds the failure result for a failed template, machine type or image lookup
+func instanceTemplateLookupFailure(vctx *validator.Context, what string, err error) *validator.Result {
+    slog.Error("Failed to get "+what,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonInstanceTemplateCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s: %v", what, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
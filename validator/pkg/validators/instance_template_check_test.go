@@ -0,0 +1,140 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("InstanceTemplateCheckValidator", func() {
+    var (
+        v           *validators.InstanceTemplateCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+        template    *compute.InstanceTemplate
+    )
+
+    BeforeEach(func() {
+        v = &validators.InstanceTemplateCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("INSTANCE_TEMPLATE", "workers")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        template = &compute.InstanceTemplate{
+            Name: "workers",
+            Properties: &compute.InstanceProperties{
+                MachineType: "n2-standard-4",
+                Disks: []*compute.AttachedDisk{
+                    {InitializeParams: &compute.AttachedDiskInitializeParams{
+                        SourceImage: "https://www.googleapis.com/compute/v1/projects/rhcos-cloud/global/images/rhcos-418",
+                    }},
+                    {InitializeParams: &compute.AttachedDiskInitializeParams{
+                        SourceImage: "global/images/family/data",
+                    }},
+                },
+            },
+        }
+        computeFake = &fakeCompute{
+            templates: map[string]*compute.InstanceTemplate{"workers": template},
+            regions: map[string]*compute.Region{
+                "us-central1": {Name: "us-central1", Zones: []string{
+                    "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a",
+                    "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-b",
+                }},
+            },
+            machineTypes: map[string][]string{
+                "us-central1-a": {"n2-standard-4"},
+                "us-central1-b": {"n2-standard-4"},
+            },
+            images: map[string]*compute.Image{
+                "rhcos-cloud/rhcos-418":    {Name: "rhcos-418", Status: "READY"},
+                "test-project/family/data": {Name: "data-v2", Status: "READY"},
+            },
+        }
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without INSTANCE_TEMPLATE", func() {
+            vctx.Config.InstanceTemplate = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the machine type and images are available", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("InstanceTemplateValid"))
+            Expect(result.Details).To(HaveKeyWithValue("machine_type", "n2-standard-4"))
+            Expect(result.Details).To(HaveKeyWithValue("machine_type_checked", true))
+        })
+
+        It("should fail when the template does not exist", func() {
+            vctx.Config.InstanceTemplate = "missing"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("InstanceTemplateNotFound"))
+        })
+
+        It("should list every problem with the template", func() {
+            computeFake.machineTypes["us-central1-b"] = nil
+            computeFake.images["rhcos-cloud/rhcos-418"].Deprecated = &compute.DeprecationStatus{State: "OBSOLETE"}
+            delete(computeFake.images, "test-project/family/data")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("InstanceTemplateInvalid"))
+            Expect(result.Details).To(HaveKeyWithValue("machine_type_unavailable_zones", []string{"us-central1-b"}))
+            Expect(result.Details["problems"]).To(ConsistOf(
+                "machine type n2-standard-4 is not offered in us-central1-b",
+                "image https://www.googleapis.com/compute/v1/projects/rhcos-cloud/global/images/rhcos-418 is OBSOLETE",
+                "image global/images/family/data does not exist",
+            ))
+        })
+
+        It("should fail for an image that is not READY", func() {
+            computeFake.images["rhcos-cloud/rhcos-418"].Status = "PENDING"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("InstanceTemplateInvalid"))
+            Expect(result.Message).To(ContainSubstring("is PENDING, not READY"))
+        })
+
+        It("should skip the machine type check without GCP_REGION", func() {
+            vctx.Config.GCPRegion = ""
+            computeFake.machineTypes = nil
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("machine_type_checked", false))
+        })
+
+        It("should fail when the template lookup errors", func() {
+            computeFake.templateErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
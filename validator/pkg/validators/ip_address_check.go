@@ -0,0 +1,228 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "strings"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the address and region lookups
+    ipAddressCheckTimeout = 1 * time.Minute
+
+    // Status of a static address that is reserved but not attached to a resource
+    addressStatusReserved = "RESERVED"
+
+    // Regional quota metric for static external addresses
+    staticAddressesQuotaMetric = "STATIC_ADDRESSES"
+)
+
+// IPAddressCheckValidator checks that the cluster's static IP addresses are reserved and free
+// With REQUIRED_ADDRESSES it checks the named addresses; otherwise it checks that
+// REQUIRED_IP_ADDRESSES addresses can be had from unused reservations plus free quota
+type IPAddressCheckValidator struct{}
+
+// init registers the IPAddressCheckValidator with the global validator registry
+func init() {
+    validator.Register(&IPAddressCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *IPAddressCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "ip-address-check",
+        Description: "Verify the required static IP addresses are reserved and not in use",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "network", "quota"},
+    }
+}
+
+// RequiredAPIs declares the APIs ip-address-check calls so api-enabled checks them
+func (v *IPAddressCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_ADDRESSES or REQUIRED_IP_ADDRESSES is set
+func (v *IPAddressCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRED_ADDRESSES") || vctx.HasConfig("REQUIRED_IP_ADDRESSES")
+}
+
+// Validate checks the named addresses when REQUIRED_ADDRESSES is set, else the address count
+func (v *IPAddressCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    region := vctx.Config.GCPRegion
+    if region == "" {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: "GCP_REGION is required to check static IP addresses",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set GCP_REGION to the region the cluster will be created in",
+            },
+        }
+    }
+
+    ctx, cancel := context.WithTimeout(ctx, ipAddressCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    if len(vctx.Config.RequiredAddresses) > 0 {
+        return v.checkNamedAddresses(ctx, vctx, computeSvc, region)
+    }
+    return v.checkAddressCount(ctx, vctx, computeSvc, region)
+}
+
+// checkNamedAddresses requires every REQUIRED_ADDRESSES entry to exist in the region with status RESERVED
+func (v *IPAddressCheckValidator) checkNamedAddresses(ctx context.Context, vctx *validator.Context, computeSvc gcp.ComputeAPI, region string) *validator.Result {
+    names := vctx.Config.RequiredAddresses
+    slog.Info("Checking reserved static IP addresses", "region", region, "addresses", names)
+
+    // Address name -> its status, or NOT_FOUND when it does not exist
+    statuses := make(map[string]string, len(names))
+    var unavailable []string
+    for _, name := range names {
+        addr, err := computeSvc.GetAddress(ctx, vctx.Config.ProjectID, region, name)
+        if err != nil {
+            var apiErr *googleapi.Error
+            if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+                statuses[name] = "NOT_FOUND"
+                unavailable = append(unavailable, name)
+                continue
+            }
+            return ipAddressLookupFailure(vctx, "address "+name, err)
+        }
+        statuses[name] = addr.Status
+        if addr.Status != addressStatusReserved {
+            unavailable = append(unavailable, name)
+        }
+    }
+
+    details := map[string]interface{}{
+        "region":     region,
+        "addresses":  statuses,
+        "project_id": vctx.Config.ProjectID,
+    }
+
+    if len(unavailable) > 0 {
+        slog.Warn("Static IP addresses are not available", "unavailable", unavailable)
+        details["unavailable_addresses"] = unavailable
+        details["hint"] = "Reserve missing addresses with: gcloud compute addresses create <name> --region=" + region +
+            "; addresses IN_USE are already attached to another resource"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonAddressNotAvailable,
+            Message: fmt.Sprintf("%d of %d static IP address(es) in %s are missing or in use: %s", len(unavailable), len(names), region, strings.Join(unavailable, ", ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("All %d static IP address(es) are reserved in %s", len(names), region)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAddressesAvailable,
+        Message: message,
+        Details: details,
+    }
+}
+
+// checkAddressCount requires REQUIRED_IP_ADDRESSES addresses from unused reservations in the
+// region plus the remaining STATIC_ADDRESSES quota
+func (v *IPAddressCheckValidator) checkAddressCount(ctx context.Context, vctx *validator.Context, computeSvc gcp.ComputeAPI, region string) *validator.Result {
+    required := vctx.Config.RequiredIPAddresses
+    slog.Info("Checking static IP address availability", "region", region, "required", required)
+
+    addresses, err := computeSvc.ListAddresses(ctx, vctx.Config.ProjectID, region)
+    if err != nil {
+        return ipAddressLookupFailure(vctx, "addresses in "+region, err)
+    }
+    reserved := 0
+    for _, a := range addresses {
+        if a.Status == addressStatusReserved {
+            reserved++
+        }
+    }
+
+    r, err := computeSvc.GetRegion(ctx, vctx.Config.ProjectID, region)
+    if err != nil {
+        return ipAddressLookupFailure(vctx, "region "+region, err)
+    }
+    headroom := 0.0
+    if q := findQuota(r.Quotas, staticAddressesQuotaMetric); q != nil {
+        headroom = q.Limit - q.Usage
+    }
+
+    available := reserved + int(headroom)
+    details := map[string]interface{}{
+        "region":          region,
+        "required":        required,
+        "available":       available,
+        "reserved_unused": reserved,
+        "quota_metric":    staticAddressesQuotaMetric,
+        "quota_available": headroom,
+        "project_id":      vctx.Config.ProjectID,
+    }
+
+    if available < required {
+        slog.Warn("Insufficient static IP addresses",
+            "required", required,
+            "available", available)
+        details["hint"] = "Release unused addresses or request a quota increase for " + staticAddressesQuotaMetric + " in " + region
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonAddressNotAvailable,
+            Message: fmt.Sprintf("%d static IP address(es) available in %s (%d reserved and unused, %.0f quota), %d required", available, region, reserved, headroom, required),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("%d static IP address(es) available in %s (required: %d)", available, region, required)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonAddressesAvailable,
+        Message: message,
+        Details: details,
+    }
+}
+
+// ipAddressLookupFailure builds the failure result for a failed address or region lookup
+func ipAddressLookupFailure(vctx *validator.Context, what string, err error) *validator.Result {
+    slog.Error("Failed to get "+what,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonIPAddressCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s: %v", what, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,125 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("IPAddressCheckValidator", func() {
+    var (
+        v           *validators.IPAddressCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    BeforeEach(func() {
+        v = &validators.IPAddressCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("REQUIRED_ADDRESSES", "api-ip, ingress-ip")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{
+            addresses: []*compute.Address{
+                {Name: "api-ip", Status: "RESERVED"},
+                {Name: "ingress-ip", Status: "RESERVED"},
+                {Name: "old-ip", Status: "IN_USE"},
+            },
+            regions: map[string]*compute.Region{
+                "us-central1": {
+                    Name:   "us-central1",
+                    Quotas: []*compute.Quota{{Metric: "STATIC_ADDRESSES", Limit: 8, Usage: 7}},
+                },
+            },
+        }
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should be enabled by REQUIRED_IP_ADDRESSES alone", func() {
+            vctx.Config.RequiredAddresses = nil
+            vctx.Config.RequiredIPAddresses = 2
+            Expect(v.Enabled(vctx)).To(BeTrue())
+        })
+
+        It("should not be enabled when neither is set", func() {
+            vctx.Config.RequiredAddresses = nil
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should fail without GCP_REGION", func() {
+            vctx.Config.GCPRegion = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("RegionNotConfigured"))
+        })
+
+        Context("with named addresses", func() {
+            It("should succeed when every address is reserved", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.Reason).To(Equal("AddressesAvailable"))
+            })
+
+            It("should fail for addresses that are missing or in use", func() {
+                vctx.Config.RequiredAddresses = []string{"api-ip", "old-ip", "missing-ip"}
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("AddressNotAvailable"))
+                Expect(result.Details).To(HaveKeyWithValue("unavailable_addresses", []string{"old-ip", "missing-ip"}))
+                Expect(result.Details["addresses"]).To(HaveKeyWithValue("missing-ip", "NOT_FOUND"))
+            })
+
+            It("should fail when the lookup errors", func() {
+                computeFake.listErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("forbidden"))
+            })
+        })
+
+        Context("with an address count", func() {
+            BeforeEach(func() {
+                vctx.Config.RequiredAddresses = nil
+                vctx.Config.RequiredIPAddresses = 3
+            })
+
+            It("should count unused reservations and free quota", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.Details).To(HaveKeyWithValue("reserved_unused", 2))
+                Expect(result.Details).To(HaveKeyWithValue("available", 3))
+            })
+
+            It("should fail when too few addresses can be had", func() {
+                vctx.Config.RequiredIPAddresses = 4
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("AddressNotAvailable"))
+            })
+        })
+    })
+})
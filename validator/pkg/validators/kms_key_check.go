@@ -0,0 +1,254 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "slices"
+    "strings"
+    "time"
+
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the key, key IAM policy and project IAM policy lookups
+    kmsKeyCheckTimeout = 30 * time.Second
+
+    // State of a key version that can encrypt and decrypt
+    kmsKeyVersionEnabled = "ENABLED"
+
+    // Role the Compute Engine service agent needs to use a key for disk encryption
+    kmsEncrypterDecrypterRole = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+)
+
+// KMSKeyCheckValidator checks that the CMEK key KMS_KEY_NAME exists, its primary version is
+// ENABLED, and the Compute Engine service agent can encrypt and decrypt with it
+// The role may be granted on the key or inherited from the key's project, so both policies are consulted
+type KMSKeyCheckValidator struct{}
+
+// init registers the KMSKeyCheckValidator with the global validator registry
+func init() {
+    validator.Register(&KMSKeyCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *KMSKeyCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "kms-key-check",
+        Description: "Verify the CMEK key exists, is ENABLED, and the Compute service agent can use it",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure cloudkms API is available
+        Tags:        []string{"post-mvp", "iam", "storage"},
+    }
+}
+
+// RequiredAPIs declares the APIs kms-key-check calls so api-enabled checks them
+func (v *KMSKeyCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudkms.googleapis.com", "cloudresourcemanager.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless KMS_KEY_NAME is set
+func (v *KMSKeyCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("KMS_KEY_NAME")
+}
+
+// Validate fetches the key and its IAM policies and checks the Compute service agent's role
+func (v *KMSKeyCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := vctx.Config.KMSKeyName
+    keyProject, ok := kmsKeyProject(name)
+    if !ok {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonKMSKeyNotFound,
+            Message: fmt.Sprintf("KMS_KEY_NAME %q is not a key resource name", name),
+            Details: map[string]interface{}{
+                "key":        name,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Use projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>",
+            },
+        }
+    }
+
+    slog.Info("Checking KMS key", "key", name)
+
+    ctx, cancel := context.WithTimeout(ctx, kmsKeyCheckTimeout)
+    defer cancel()
+
+    kmsSvc, err := vctx.GetKMSAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud KMS client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonKMSClientError),
+            Message: fmt.Sprintf("Failed to get Cloud KMS client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    key, err := kmsSvc.GetCryptoKey(ctx, name)
+    if err != nil {
+        if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonKMSKeyNotFound,
+                Message: fmt.Sprintf("KMS key %s does not exist", name),
+                Details: map[string]interface{}{
+                    "key":        name,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Check KMS_KEY_NAME, or list keys with: gcloud kms keys list --keyring=<ring> --location=<location>",
+                },
+            }
+        }
+        return kmsLookupFailure(vctx, "KMS key "+name, err)
+    }
+
+    details := map[string]interface{}{
+        "key":        name,
+        "purpose":    key.Purpose,
+        "project_id": vctx.Config.ProjectID,
+    }
+
+    if key.Primary == nil || key.Primary.State != kmsKeyVersionEnabled {
+        state := "NONE"
+        if key.Primary != nil {
+            state = key.Primary.State
+        }
+        slog.Warn("KMS key primary version is not enabled", "key", name, "state", state)
+        details["primary_state"] = state
+        details["hint"] = "Enable or restore the primary key version, or set a new primary with: gcloud kms keys set-primary-version"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonKMSKeyDisabled,
+            Message: fmt.Sprintf("KMS key %s primary version is %s, not %s", name, state, kmsKeyVersionEnabled),
+            Details: details,
+        }
+    }
+    details["primary_state"] = key.Primary.State
+
+    projectNumber, err := vctx.GetProjectNumber(ctx)
+    if err != nil {
+        slog.Error("Failed to resolve project number",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonProjectNumberLookupFailed),
+            Message: fmt.Sprintf("Failed to resolve project number for the Compute service agent: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+    member := "serviceAccount:" + serviceAgentEmail("compute.googleapis.com", projectNumber)
+    details["service_agent"] = member
+
+    keyPolicy, err := kmsSvc.GetCryptoKeyIamPolicy(ctx, name)
+    if err != nil {
+        return kmsLookupFailure(vctx, "IAM policy of KMS key "+name, err)
+    }
+    for _, b := range keyPolicy.Bindings {
+        if b.Role == kmsEncrypterDecrypterRole && slices.Contains(b.Members, member) {
+            return kmsKeyReady(name, "key", details)
+        }
+    }
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+    projectPolicy, err := crm.GetIamPolicy(ctx, keyProject)
+    if err != nil {
+        slog.Error("Failed to get project IAM policy",
+            "error", err.Error(),
+            "project_id", keyProject)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMPolicyLookupFailed),
+            Message: fmt.Sprintf("Failed to get IAM policy of project %s: %v", keyProject, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant resourcemanager.projects.getIamPolicy on the key's project to the validator's service account",
+            },
+        }
+    }
+    for _, b := range projectPolicy.Bindings {
+        if b.Role == kmsEncrypterDecrypterRole && slices.Contains(b.Members, member) {
+            return kmsKeyReady(name, "project", details)
+        }
+    }
+
+    slog.Warn("Compute service agent cannot use KMS key", "key", name, "member", member)
+    details["missing_role"] = kmsEncrypterDecrypterRole
+    details["hint"] = fmt.Sprintf("Grant it with: gcloud kms keys add-iam-policy-binding %s --member=%s --role=%s",
+        name, member, kmsEncrypterDecrypterRole)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  validator.ReasonKMSKeyIAMMissing,
+        Message: fmt.Sprintf("Compute service agent lacks %s on KMS key %s", kmsEncrypterDecrypterRole, name),
+        Details: details,
+    }
+}
+
+// kmsKeyReady builds the success result; grantedOn says whether the role came from the key or its project
+func kmsKeyReady(name, grantedOn string, details map[string]interface{}) *validator.Result {
+    details["granted_on"] = grantedOn
+    message := fmt.Sprintf("KMS key %s is enabled and usable by the Compute service agent", name)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonKMSKeyReady,
+        Message: message,
+        Details: details,
+    }
+}
+
+// kmsKeyProject returns the project of a key resource name
+// projects/<project>/locations/<location>/keyRings/<ring>/cryptoKeys/<key>
+func kmsKeyProject(name string) (string, bool) {
+    parts := strings.Split(name, "/")
+    if len(parts) != 8 || parts[0] != "projects" || parts[2] != "locations" || parts[4] != "keyRings" || parts[6] != "cryptoKeys" {
+        return "", false
+    }
+    for _, p := range parts {
+        if p == "" {
+            return "", false
+        }
+    }
+    return parts[1], true
+}
+
+// kmsLookupFailure builds the failure result for a failed key or key IAM policy lookup
+func kmsLookupFailure(vctx *validator.Context, what string, err error) *validator.Result {
+    slog.Error("Failed to get "+what,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonKMSKeyCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s: %v", what, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,143 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudkms/v1"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("KMSKeyCheckValidator", func() {
+    var (
+        v       *validators.KMSKeyCheckValidator
+        vctx    *validator.Context
+        kmsFake *fakeKMS
+        crm     *fakeResourceManager
+    )
+
+    const (
+        keyName      = "projects/kms-project/locations/us-central1/keyRings/cluster/cryptoKeys/disks"
+        computeAgent = "serviceAccount:service-123@compute-system.iam.gserviceaccount.com"
+        role         = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+    )
+
+    BeforeEach(func() {
+        v = &validators.KMSKeyCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("KMS_KEY_NAME", keyName)
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        kmsFake = &fakeKMS{
+            keys: map[string]*cloudkms.CryptoKey{
+                keyName: {
+                    Name:    keyName,
+                    Purpose: "ENCRYPT_DECRYPT",
+                    Primary: &cloudkms.CryptoKeyVersion{State: "ENABLED"},
+                },
+            },
+            policies: map[string]*cloudkms.Policy{
+                keyName: {Bindings: []*cloudkms.Binding{{Role: role, Members: []string{computeAgent}}}},
+            },
+        }
+        vctx.SetKMSAPI(kmsFake)
+
+        crm = &fakeResourceManager{
+            project: &cloudresourcemanager.Project{ProjectId: "test-project", ProjectNumber: 123},
+        }
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without KMS_KEY_NAME", func() {
+            vctx.Config.KMSKeyName = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the agent holds the role on the key", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("KMSKeyReady"))
+            Expect(result.Details).To(HaveKeyWithValue("granted_on", "key"))
+            Expect(result.Details).To(HaveKeyWithValue("service_agent", computeAgent))
+        })
+
+        It("should accept the role inherited from the key's project", func() {
+            kmsFake.policies = nil
+            crm.policy = &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+                {Role: role, Members: []string{computeAgent}},
+            }}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("granted_on", "project"))
+        })
+
+        It("should fail when the agent lacks the role", func() {
+            kmsFake.policies = nil
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("KMSKeyIAMMissing"))
+            Expect(result.Details).To(HaveKeyWithValue("missing_role", role))
+        })
+
+        It("should fail when the key does not exist", func() {
+            vctx.Config.KMSKeyName = "projects/kms-project/locations/us-central1/keyRings/cluster/cryptoKeys/missing"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("KMSKeyNotFound"))
+        })
+
+        It("should fail for a malformed key name", func() {
+            vctx.Config.KMSKeyName = "disks"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("KMSKeyNotFound"))
+        })
+
+        It("should fail when the primary version is not enabled", func() {
+            kmsFake.keys[keyName].Primary.State = "DISABLED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("KMSKeyDisabled"))
+            Expect(result.Details).To(HaveKeyWithValue("primary_state", "DISABLED"))
+        })
+
+        It("should fail when the key has no primary version", func() {
+            kmsFake.keys[keyName].Primary = nil
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("KMSKeyDisabled"))
+            Expect(result.Details).To(HaveKeyWithValue("primary_state", "NONE"))
+        })
+
+        It("should fail when the lookup errors", func() {
+            kmsFake.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
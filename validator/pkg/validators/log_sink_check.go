@@ -0,0 +1,165 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "time"
+
+    logging "google.golang.org/api/logging/v2"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for listing the project's log sinks, all pages included
+    logSinkCheckTimeout = 30 * time.Second
+)
+
+// LogSinkCheckValidator checks that the project log sink REQUIRED_LOG_SINK exists, is enabled and,
+// when LOG_SINK_DESTINATION is set, exports to that destination, as compliance policies may require
+type LogSinkCheckValidator struct{}
+
+// init registers the LogSinkCheckValidator with the global validator registry
+func init() {
+    validator.Register(&LogSinkCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *LogSinkCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "log-sink-check",
+        Description: "Verify the required Cloud Logging sink exists, is enabled and exports to the expected destination",
+        RunAfter:    []string{"api-enabled"}, // Listing sinks requires logging.googleapis.com
+        Tags:        []string{"post-mvp", "logging"},
+    }
+}
+
+// RequiredAPIs declares the APIs log-sink-check calls so api-enabled checks them
+func (v *LogSinkCheckValidator) RequiredAPIs() []string {
+    return []string{"logging.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_LOG_SINK is set
+func (v *LogSinkCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRED_LOG_SINK")
+}
+
+// Describe names the sink and, when set, the destination that will be checked
+func (v *LogSinkCheckValidator) Describe(vctx *validator.Context) string {
+    if vctx.Config.LogSinkDestination == "" {
+        return fmt.Sprintf("will verify log sink %s exists and is enabled in project %s",
+            vctx.Config.RequiredLogSink, vctx.Config.ProjectID)
+    }
+    return fmt.Sprintf("will verify log sink %s exists, is enabled and exports to %s in project %s",
+        vctx.Config.RequiredLogSink, vctx.Config.LogSinkDestination, vctx.Config.ProjectID)
+}
+
+// Validate lists the project's log sinks and checks the required one
+func (v *LogSinkCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := vctx.Config.RequiredLogSink
+    slog.Info("Checking log sink", "sink", name, "expected_destination", vctx.Config.LogSinkDestination)
+
+    ctx, cancel := context.WithTimeout(ctx, logSinkCheckTimeout)
+    defer cancel()
+
+    loggingSvc, err := vctx.GetLoggingAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Logging client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonLoggingClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Logging client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    sinks, err := loggingSvc.ListSinks(ctx, fmt.Sprintf("projects/%s", vctx.Config.ProjectID))
+    if err != nil {
+        slog.Error("Failed to list log sinks",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonLogSinkCheckFailed),
+            Message: fmt.Sprintf("Failed to list log sinks: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant logging.sinks.list (roles/logging.viewer) to the validator's service account",
+            },
+        }
+    }
+
+    var sink *logging.LogSink
+    sinkNames := make([]string, 0, len(sinks))
+    for _, s := range sinks {
+        sinkNames = append(sinkNames, s.Name)
+        if s.Name == name {
+            sink = s
+        }
+    }
+
+    if sink == nil {
+        slog.Warn("Required log sink not found", "sink", name, "sinks", sinkNames)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonLogSinkMissing,
+            Message: fmt.Sprintf("Log sink %s does not exist in project %s", name, vctx.Config.ProjectID),
+            Details: map[string]interface{}{
+                "sink":       name,
+                "sinks":      sinkNames,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Create it with: gcloud logging sinks create <name> <destination> --log-filter=<filter>",
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "sink":        name,
+        "destination": sink.Destination,
+        "filter":      sink.Filter,
+        "disabled":    sink.Disabled,
+        "project_id":  vctx.Config.ProjectID,
+    }
+
+    if expected := vctx.Config.LogSinkDestination; expected != "" && sink.Destination != expected {
+        details["expected_destination"] = expected
+        details["hint"] = "Point the sink at the expected destination with: gcloud logging sinks update <name> <destination>"
+        slog.Warn("Log sink exports to an unexpected destination",
+            "sink", name,
+            "destination", sink.Destination,
+            "expected_destination", expected)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonLogSinkWrongDestination,
+            Message: fmt.Sprintf("Log sink %s exports to %s, expected %s", name, sink.Destination, expected),
+            Details: details,
+        }
+    }
+
+    if sink.Disabled {
+        details["hint"] = "Enable the sink with: gcloud logging sinks update <name> --no-disabled"
+        slog.Warn("Log sink is disabled", "sink", name)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonLogSinkDisabled,
+            Message: fmt.Sprintf("Log sink %s is disabled and exports nothing", name),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Log sink %s exports to %s", name, sink.Destination)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonLogSinkValid,
+        Message: message,
+        Details: details,
+    }
+}
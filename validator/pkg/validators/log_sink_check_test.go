@@ -0,0 +1,104 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/googleapi"
+    logging "google.golang.org/api/logging/v2"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("LogSinkCheckValidator", func() {
+    var (
+        v    *validators.LogSinkCheckValidator
+        vctx *validator.Context
+        logs *fakeLogging
+    )
+
+    BeforeEach(func() {
+        v = &validators.LogSinkCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("REQUIRED_LOG_SINK", "audit-export")
+        GinkgoT().Setenv("LOG_SINK_DESTINATION", "storage.googleapis.com/audit-logs")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        logs = &fakeLogging{sinks: []*logging.LogSink{
+            {Name: "_Default", Destination: "logging.googleapis.com/projects/test-project/locations/global/buckets/_Default"},
+            {Name: "audit-export", Destination: "storage.googleapis.com/audit-logs", Filter: `logName:"cloudaudit.googleapis.com"`},
+        }}
+        vctx.SetLoggingAPI(logs)
+    })
+
+    Describe("Enabled", func() {
+        It("should follow REQUIRED_LOG_SINK", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.RequiredLogSink = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    It("should pass when the sink exports to the expected destination", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("LogSinkValid"))
+        Expect(result.Details).To(HaveKeyWithValue("destination", "storage.googleapis.com/audit-logs"))
+    })
+
+    It("should only check existence without LOG_SINK_DESTINATION", func() {
+        vctx.Config.LogSinkDestination = ""
+        logs.sinks[1].Destination = "bigquery.googleapis.com/projects/test-project/datasets/audit"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+    })
+
+    It("should fail when the sink does not exist", func() {
+        vctx.Config.RequiredLogSink = "missing-sink"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("LogSinkMissing"))
+        Expect(result.Details["sinks"]).To(Equal([]string{"_Default", "audit-export"}))
+    })
+
+    It("should fail when the sink exports elsewhere", func() {
+        logs.sinks[1].Destination = "storage.googleapis.com/other-bucket"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("LogSinkWrongDestination"))
+        Expect(result.Details).To(HaveKeyWithValue("destination", "storage.googleapis.com/other-bucket"))
+        Expect(result.Details).To(HaveKeyWithValue("expected_destination", "storage.googleapis.com/audit-logs"))
+    })
+
+    It("should fail when the sink is disabled", func() {
+        logs.sinks[1].Disabled = true
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("LogSinkDisabled"))
+    })
+
+    It("should fail when the sinks cannot be listed", func() {
+        logs.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
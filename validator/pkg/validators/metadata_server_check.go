@@ -0,0 +1,93 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for reading the project from the metadata server, which answers locally within milliseconds
+    metadataServerTimeout = 5 * time.Second
+)
+
+// MetadataServerCheckValidator warns when the project reported by the Compute Engine metadata server differs
+// from PROJECT_ID. Validating another project from a pod can be intended, but is often a misconfiguration
+// It only runs on Compute Engine and GKE; local runs have no metadata server
+type MetadataServerCheckValidator struct{}
+
+// init registers the MetadataServerCheckValidator with the global validator registry
+func init() {
+    validator.Register(&MetadataServerCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *MetadataServerCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "metadata-server-check",
+        Description: "Warn when the project reported by the Compute Engine metadata server differs from PROJECT_ID",
+        RunAfter:    []string{}, // No dependencies - reads the local metadata server at Level 0
+        Tags:        []string{"post-mvp", "auth"},
+    }
+}
+
+// Enabled drops the validator from the plan unless the process runs on Compute Engine or GKE
+func (v *MetadataServerCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.GetMetadataAPI().OnGCE()
+}
+
+// Validate reads computeMetadata/v1/project/project-id and compares it with PROJECT_ID
+func (v *MetadataServerCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking the project reported by the metadata server")
+
+    ctx, cancel := context.WithTimeout(ctx, metadataServerTimeout)
+    defer cancel()
+
+    metadataProject, err := vctx.GetMetadataAPI().ProjectID(ctx)
+    if err != nil {
+        // The probe found a metadata server but it does not answer, e.g. blocked by a network policy
+        slog.Warn("Metadata server is unreachable, skipping",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonMetadataServerUnreachable,
+            Message: fmt.Sprintf("Could not read the project from the metadata server: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "project_id":       vctx.Config.ProjectID,
+        "metadata_project": metadataProject,
+    }
+
+    if metadataProject != vctx.Config.ProjectID {
+        slog.Warn("Metadata server reports a different project",
+            "project_id", vctx.Config.ProjectID,
+            "metadata_project", metadataProject)
+        details["hint"] = "Validating another project than the one the pod runs in is fine for cross-project setups; otherwise check PROJECT_ID"
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonMetadataProjectMismatch,
+            Message: fmt.Sprintf("PROJECT_ID is %s but the validator runs in project %s", vctx.Config.ProjectID, metadataProject),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("The validator runs in project %s", metadataProject)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonMetadataProjectMatches,
+        Message: message,
+        Details: details,
+    }
+}
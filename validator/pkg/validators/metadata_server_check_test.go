@@ -0,0 +1,73 @@
+package validators_test
+
+import (
+    "context"
+    "errors"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("MetadataServerCheckValidator", func() {
+    var (
+        v        *validators.MetadataServerCheckValidator
+        vctx     *validator.Context
+        metadata *fakeMetadata
+    )
+
+    BeforeEach(func() {
+        v = &validators.MetadataServerCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        metadata = &fakeMetadata{onGCE: true, project: "test-project"}
+        vctx.SetMetadataAPI(metadata)
+    })
+
+    Describe("Enabled", func() {
+        It("should only be enabled on Compute Engine or GKE", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            metadata.onGCE = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the metadata server reports PROJECT_ID", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("MetadataProjectMatches"))
+        })
+
+        It("should warn when the metadata server reports another project", func() {
+            metadata.project = "host-project"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("MetadataProjectMismatch"))
+            Expect(result.Details).To(HaveKeyWithValue("metadata_project", "host-project"))
+        })
+
+        It("should skip rather than fail when the metadata server is unreachable", func() {
+            metadata.err = errors.New("dial tcp 169.254.169.254:80: i/o timeout")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+            Expect(result.Reason).To(Equal("MetadataServerUnreachable"))
+        })
+    })
+})
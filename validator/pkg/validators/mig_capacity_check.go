@@ -0,0 +1,188 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "math"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for reading the regional quotas
+    migCapacityTimeout = 30 * time.Second
+    // migRegionalSizeLimit is the most instances GCP allows in one regional managed instance group
+    migRegionalSizeLimit = 2000
+)
+
+// migCapacityLimit is one bound on how many instances the node pool can scale to
+type migCapacityLimit struct {
+    Source    string // e.g. "INSTANCES", "CPUS" or "MIG_MAX_SIZE"
+    Instances int    // Instances that still fit within the bound
+}
+
+// MIGCapacityCheckValidator warns when a MIG-based autoscaling node pool scaled to MIG_NODE_COUNT would leave
+// less than MIG_HEADROOM_PERCENT of the regional capacity free. quota-check verifies the initial
+// cluster fits; this validator looks at the scaling headroom, bounded by the regional INSTANCES and
+// CPUS quotas and by the MIG size limit
+type MIGCapacityCheckValidator struct{}
+
+// init registers the MIGCapacityCheckValidator with the global validator registry
+func init() {
+    validator.Register(&MIGCapacityCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *MIGCapacityCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "mig-capacity-check",
+        Description: "Warn when the planned node count leaves too little regional instance capacity for autoscaling",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure GCP access works
+        Tags:        []string{"post-mvp", "quota"},
+    }
+}
+
+// RequiredAPIs declares the APIs mig-capacity-check calls so api-enabled checks them
+func (v *MIGCapacityCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless MIG_NODE_COUNT is set
+func (v *MIGCapacityCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("MIG_NODE_COUNT")
+}
+
+// Describe names the projected node count and the headroom that will be required
+func (v *MIGCapacityCheckValidator) Describe(vctx *validator.Context) string {
+    return fmt.Sprintf("will verify %d node(s) leave %d%% of the instance capacity free in project %s, region %s",
+        vctx.Config.MIGNodeCount, vctx.Config.MIGHeadroomPercent, vctx.Config.ProjectID, vctx.Config.GCPRegion)
+}
+
+// Validate compares the projected instances with the tightest regional capacity limit
+func (v *MIGCapacityCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    cfg := vctx.Config
+    slog.Info("Checking MIG capacity headroom", "node_count", cfg.MIGNodeCount, "region", cfg.GCPRegion)
+
+    if cfg.GCPRegion == "" {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: "GCP_REGION is required to check MIG capacity",
+            Details: map[string]interface{}{
+                "project_id": cfg.ProjectID,
+                "hint":       "Set GCP_REGION to the region the node pool will be created in",
+            },
+        }
+    }
+
+    ctx, cancel := context.WithTimeout(ctx, migCapacityTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", cfg.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": cfg.ProjectID,
+            },
+        }
+    }
+
+    region, err := computeSvc.GetRegion(ctx, cfg.ProjectID, cfg.GCPRegion)
+    if err != nil {
+        slog.Error("Failed to get regional quotas",
+            "error", err.Error(),
+            "project_id", cfg.ProjectID,
+            "region", cfg.GCPRegion)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonMIGCapacityCheckFailed),
+            Message: fmt.Sprintf("Failed to get regional quotas: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": cfg.ProjectID,
+                "region":     cfg.GCPRegion,
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "projected_instances": cfg.MIGNodeCount,
+        "headroom_percent":    cfg.MIGHeadroomPercent,
+        "project_id":          cfg.ProjectID,
+        "region":              cfg.GCPRegion,
+    }
+
+    maxSize := cfg.MIGMaxSize
+    if maxSize == 0 {
+        maxSize = migRegionalSizeLimit
+    }
+    limits := []migCapacityLimit{{Source: "MIG_MAX_SIZE", Instances: maxSize}}
+    if q := findQuota(region.Quotas, "INSTANCES"); q != nil {
+        limits = append(limits, migCapacityLimit{Source: "INSTANCES", Instances: int(q.Limit - q.Usage)})
+    }
+    if cfg.MIGNodeVCPUs > 0 {
+        if q := findQuota(region.Quotas, "CPUS"); q != nil {
+            available := q.Limit - q.Usage
+            limits = append(limits, migCapacityLimit{Source: "CPUS", Instances: int(math.Floor(available / float64(cfg.MIGNodeVCPUs)))})
+            details["projected_vcpus"] = cfg.MIGNodeCount * cfg.MIGNodeVCPUs
+            details["available_vcpus"] = available
+        } else {
+            slog.Warn("Quota metric not reported by GCP, skipping", "metric", "CPUS", "region", cfg.GCPRegion)
+        }
+    }
+
+    // The tightest limit decides; keeping the headroom free leaves room for surge upgrades and other workloads
+    tightest := limits[0]
+    for _, l := range limits[1:] {
+        if l.Instances < tightest.Instances {
+            tightest = l
+        }
+    }
+    usable := tightest.Instances * (100 - cfg.MIGHeadroomPercent) / 100
+
+    capacity := make(map[string]int, len(limits))
+    for _, l := range limits {
+        capacity[l.Source] = l.Instances
+    }
+    details["available_instances"] = tightest.Instances
+    details["usable_instances"] = usable
+    details["limiting_factor"] = tightest.Source
+    details["capacity_by_limit"] = capacity
+
+    if cfg.MIGNodeCount > usable {
+        slog.Warn("MIG capacity headroom is tight",
+            "projected_instances", cfg.MIGNodeCount,
+            "available_instances", tightest.Instances,
+            "limiting_factor", tightest.Source)
+        details["hint"] = fmt.Sprintf("Request a %s quota increase in region %s or lower the node pool's maximum size", tightest.Source, cfg.GCPRegion)
+        if tightest.Source == "MIG_MAX_SIZE" {
+            details["hint"] = "Raise MIG_MAX_SIZE, split the node pool across several MIGs or lower its maximum size"
+        }
+        return &validator.Result{
+            Status: validator.StatusWarning,
+            Reason: validator.ReasonMIGCapacityTight,
+            Message: fmt.Sprintf("Scaling to %d node(s) leaves less than %d%% of the %d instance(s) allowed by %s free",
+                cfg.MIGNodeCount, cfg.MIGHeadroomPercent, tightest.Instances, tightest.Source),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Scaling to %d node(s) fits within %d usable instance(s), limited by %s", cfg.MIGNodeCount, usable, tightest.Source)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonMIGCapacitySufficient,
+        Message: message,
+        Details: details,
+    }
+}
@@ -0,0 +1,121 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("MIGCapacityCheckValidator", func() {
+    var (
+        v      *validators.MIGCapacityCheckValidator
+        vctx   *validator.Context
+        region *compute.Region
+        fake   *fakeCompute
+    )
+
+    BeforeEach(func() {
+        v = &validators.MIGCapacityCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("MIG_NODE_COUNT", "50")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        region = &compute.Region{Quotas: []*compute.Quota{
+            {Metric: "INSTANCES", Limit: 200, Usage: 20},
+            {Metric: "CPUS", Limit: 1000, Usage: 200},
+        }}
+        fake = &fakeCompute{regions: map[string]*compute.Region{"us-central1": region}}
+        vctx.SetComputeAPI(fake)
+    })
+
+    Describe("Enabled", func() {
+        It("should follow MIG_NODE_COUNT", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.MIGNodeCount = 0
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    It("should pass when the projected nodes leave enough headroom", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("MIGCapacitySufficient"))
+        Expect(result.Details).To(HaveKeyWithValue("projected_instances", 50))
+        Expect(result.Details).To(HaveKeyWithValue("available_instances", 180))
+        Expect(result.Details).To(HaveKeyWithValue("usable_instances", 162))
+        Expect(result.Details).To(HaveKeyWithValue("limiting_factor", "INSTANCES"))
+    })
+
+    It("should warn when the projected nodes eat into the headroom", func() {
+        vctx.Config.MIGNodeCount = 170
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusWarning))
+        Expect(result.Reason).To(Equal("MIGCapacityTight"))
+        Expect(result.Details).To(HaveKeyWithValue("projected_instances", 170))
+        Expect(result.Details).To(HaveKeyWithValue("available_instances", 180))
+    })
+
+    It("should count nodes against the CPUS quota when vCPUs per node are set", func() {
+        vctx.Config.MIGNodeVCPUs = 16
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusWarning))
+        Expect(result.Reason).To(Equal("MIGCapacityTight"))
+        Expect(result.Details).To(HaveKeyWithValue("limiting_factor", "CPUS"))
+        Expect(result.Details).To(HaveKeyWithValue("available_instances", 50))
+        Expect(result.Details).To(HaveKeyWithValue("projected_vcpus", 800))
+        Expect(result.Details).To(HaveKeyWithValue("available_vcpus", 800.0))
+    })
+
+    It("should honor a configured MIG size limit", func() {
+        vctx.Config.MIGMaxSize = 40
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusWarning))
+        Expect(result.Details).To(HaveKeyWithValue("limiting_factor", "MIG_MAX_SIZE"))
+        Expect(result.Details["hint"]).To(ContainSubstring("MIG_MAX_SIZE"))
+    })
+
+    It("should fall back to the MIG size limit when no quota is reported", func() {
+        region.Quotas = nil
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Details).To(HaveKeyWithValue("available_instances", 2000))
+    })
+
+    It("should fail without GCP_REGION", func() {
+        vctx.Config.GCPRegion = ""
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("RegionNotConfigured"))
+    })
+
+    It("should fail when the regional quotas cannot be read", func() {
+        fake.regionErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
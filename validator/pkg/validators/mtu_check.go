@@ -0,0 +1,151 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the VPC network lookup
+    mtuCheckRequestTimeout = 30 * time.Second
+
+    // MTU GCP applies to networks that do not report one
+    defaultNetworkMTU = 1460
+)
+
+// MTUCheckValidator checks that the VPC network MTU is at least REQUIRED_MTU
+// MTU problems degrade rather than break a cluster, so a mismatch is a warning
+type MTUCheckValidator struct{}
+
+// init registers the MTUCheckValidator with the global validator registry
+func init() {
+    validator.Register(&MTUCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *MTUCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "mtu-check",
+        Description: "Verify the VPC network MTU meets REQUIRED_MTU",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs mtu-check calls so api-enabled checks them
+func (v *MTUCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless both VPC_NAME and REQUIRED_MTU are set
+func (v *MTUCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasValidatorConfig(v.Metadata().Name, "VPC_NAME") && vctx.HasConfig("REQUIRED_MTU")
+}
+
+// Describe names the network and MTU that will be checked
+func (v *MTUCheckValidator) Describe(vctx *validator.Context) string {
+    return fmt.Sprintf("will verify VPC network %s in project %s has an MTU of at least %d",
+        vpcNetwork(vctx, v.Metadata().Name, ""), vctx.Config.ProjectID, vctx.Config.RequiredMTU)
+}
+
+// Validate fetches the VPC network and compares its MTU with the required minimum
+func (v *MTUCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := vpcNetwork(vctx, v.Metadata().Name, "")
+    required := int64(vctx.Config.RequiredMTU)
+    slog.Info("Checking VPC network MTU", "network", name, "required_mtu", required)
+
+    ctx, cancel := context.WithTimeout(ctx, mtuCheckRequestTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    network, err := computeSvc.GetNetwork(ctx, vctx.Config.ProjectID, name)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonNetworkNotFound,
+                Message: fmt.Sprintf("VPC network %s does not exist", name),
+                Details: map[string]interface{}{
+                    "network":    name,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Check VPC_NAME or create the network with: gcloud compute networks create <name>",
+                },
+            }
+        }
+
+        slog.Error("Failed to get VPC network",
+            "error", err.Error(),
+            "network", name,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonMTUCheckFailed),
+            Message: fmt.Sprintf("Failed to get VPC network %s: %v", name, err),
+            Details: map[string]interface{}{
+                "network":    name,
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    mtu := network.Mtu
+    if mtu == 0 {
+        mtu = defaultNetworkMTU
+    }
+
+    details := map[string]interface{}{
+        "network":      name,
+        "self_link":    network.SelfLink,
+        "mtu":          mtu,
+        "required_mtu": required,
+        "project_id":   vctx.Config.ProjectID,
+    }
+
+    if mtu < required {
+        slog.Warn("VPC network MTU is below the required value",
+            "network", name,
+            "mtu", mtu,
+            "required_mtu", required)
+        details["hint"] = "Raise it with: gcloud compute networks update <name> --mtu=<value>"
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonMTUMismatch,
+            Message: fmt.Sprintf("VPC network %s has MTU %d, below the required %d", name, mtu, required),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("VPC network %s has MTU %d (required: %d)", name, mtu, required)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonMTUSufficient,
+        Message: message,
+        Details: details,
+    }
+}
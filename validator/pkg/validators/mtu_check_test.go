@@ -0,0 +1,102 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("MTUCheckValidator", func() {
+    var (
+        v           *validators.MTUCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    const selfLink = "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/cluster-vpc"
+
+    BeforeEach(func() {
+        v = &validators.MTUCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("VPC_NAME", "cluster-vpc")
+        GinkgoT().Setenv("REQUIRED_MTU", "1460")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{networks: []*compute.Network{
+            {Name: "cluster-vpc", Mtu: 1500, SelfLink: selfLink},
+        }}
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without REQUIRED_MTU", func() {
+            vctx.Config.RequiredMTU = 0
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+
+        It("should not be enabled without VPC_NAME", func() {
+            vctx.Config.VPCName = ""
+            GinkgoT().Setenv("VPC_NAME", "")
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the MTU meets the requirement", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("self_link", selfLink))
+        })
+
+        It("should warn with the actual and expected MTU when it is too low", func() {
+            vctx.Config.RequiredMTU = 8896
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("MTUMismatch"))
+            Expect(result.Details).To(HaveKeyWithValue("mtu", int64(1500)))
+            Expect(result.Details).To(HaveKeyWithValue("required_mtu", int64(8896)))
+        })
+
+        It("should treat an unreported MTU as the GCP default", func() {
+            computeFake.networks[0].Mtu = 0
+            vctx.Config.RequiredMTU = 1500
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Details).To(HaveKeyWithValue("mtu", int64(1460)))
+        })
+
+        It("should fail when the network does not exist", func() {
+            vctx.Config.VPCName = "missing-vpc"
+            GinkgoT().Setenv("VPC_NAME", "missing-vpc")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("NetworkNotFound"))
+        })
+
+        It("should prefer a validator-scoped VPC_NAME", func() {
+            GinkgoT().Setenv("VALIDATOR_MTU_CHECK_VPC_NAME", "missing-vpc")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("NetworkNotFound"))
+        })
+    })
+})
@@ -0,0 +1,147 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "time"
+
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the project parent lookup
+    orgHierarchyRequestTimeout = 30 * time.Second
+)
+
+// OrgHierarchyValidator checks that the project lives under the expected folder or organization
+type OrgHierarchyValidator struct{}
+
+// init registers the OrgHierarchyValidator with the global validator registry
+func init() {
+    validator.Register(&OrgHierarchyValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *OrgHierarchyValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "org-hierarchy-check",
+        Description: "Verify the project's parent folder or organization matches EXPECTED_PARENT",
+        RunAfter:    []string{"api-enabled"}, // Requires cloudresourcemanager.googleapis.com
+        Tags:        []string{"post-mvp", "resource-manager"},
+        // A project's parent rarely changes; batch runs against the same project reuse the result
+        Cacheable: true,
+    }
+}
+
+// RequiredAPIs declares the APIs org-hierarchy-check calls so api-enabled checks them
+func (v *OrgHierarchyValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com"}
+}
+
+// formatParent renders a v1 ResourceId as "folders/<id>" or "organizations/<id>"
+func formatParent(parent *cloudresourcemanager.ResourceId) string {
+    if parent == nil {
+        return ""
+    }
+    switch parent.Type {
+    case "folder":
+        return "folders/" + parent.Id
+    case "organization":
+        return "organizations/" + parent.Id
+    default:
+        return parent.Type + "s/" + parent.Id
+    }
+}
+
+// Enabled drops the validator from the plan unless an expected parent is configured
+func (v *OrgHierarchyValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasValidatorConfig(v.Metadata().Name, "EXPECTED_PARENT")
+}
+
+// CacheKey keys cached results by the expected parent, so runs expecting different parents never share a verdict
+func (v *OrgHierarchyValidator) CacheKey(vctx *validator.Context) string {
+    return vctx.Config.ValidatorString(v.Metadata().Name, "EXPECTED_PARENT", vctx.Config.ExpectedParent)
+}
+
+// Validate fetches the project's parent and compares it against the configured expectation
+func (v *OrgHierarchyValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    // VALIDATOR_ORG_HIERARCHY_CHECK_EXPECTED_PARENT overrides the generic EXPECTED_PARENT
+    expected := vctx.Config.ValidatorString(v.Metadata().Name, "EXPECTED_PARENT", vctx.Config.ExpectedParent)
+    if expected == "" {
+        slog.Info("EXPECTED_PARENT not set, skipping organization hierarchy check")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonExpectedParentNotConfigured,
+            Message: "No expected parent configured, organization hierarchy not checked",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    slog.Info("Checking project parent", "expected_parent", expected)
+
+    ctx, cancel := context.WithTimeout(ctx, orgHierarchyRequestTimeout)
+    defer cancel()
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    project, err := crm.GetProject(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to get project",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonProjectLookupFailed),
+            Message: fmt.Sprintf("Failed to get project %s: %v", vctx.Config.ProjectID, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    actual := formatParent(project.Parent)
+    if actual != expected {
+        slog.Warn("Project is under an unexpected parent", "expected_parent", expected, "actual_parent", actual)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonWrongParent,
+            Message: fmt.Sprintf("Project parent is %q, expected %q", actual, expected),
+            Details: map[string]interface{}{
+                "expected_parent": expected,
+                "actual_parent":   actual,
+                "project_id":      vctx.Config.ProjectID,
+                "hint":            "Move the project with: gcloud beta projects move <project> --folder=<id>",
+            },
+        }
+    }
+
+    slog.Info("Project parent matches", "parent", actual)
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonParentMatches,
+        Message: fmt.Sprintf("Project is under %s", actual),
+        Details: map[string]interface{}{
+            "parent":     actual,
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
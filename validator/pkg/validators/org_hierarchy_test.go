@@ -0,0 +1,123 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("OrgHierarchyValidator", func() {
+    var (
+        v    *validators.OrgHierarchyValidator
+        vctx *validator.Context
+        crm  *fakeResourceManager
+    )
+
+    BeforeEach(func() {
+        v = &validators.OrgHierarchyValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        crm = &fakeResourceManager{project: &cloudresourcemanager.Project{
+            ProjectId: "test-project",
+            Parent:    &cloudresourcemanager.ResourceId{Type: "folder", Id: "123"},
+        }}
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Metadata", func() {
+        It("should return correct metadata", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("org-hierarchy-check"))
+            Expect(meta.RunAfter).To(ConsistOf("api-enabled"))
+        })
+    })
+
+    Describe("Enabled", func() {
+        It("should drop out of the plan when EXPECTED_PARENT is unset", func() {
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+
+        It("should be enabled by the generic or the validator-scoped setting", func() {
+            vctx.Config.ExpectedParent = "folders/123"
+            Expect(v.Enabled(vctx)).To(BeTrue())
+
+            vctx.Config.ExpectedParent = ""
+            GinkgoT().Setenv("VALIDATOR_ORG_HIERARCHY_CHECK_EXPECTED_PARENT", "folders/123")
+            Expect(v.Enabled(vctx)).To(BeTrue())
+        })
+    })
+
+    Describe("CacheKey", func() {
+        It("should key cached results by the effective expected parent", func() {
+            vctx.Config.ExpectedParent = "folders/123"
+            Expect(v.CacheKey(vctx)).To(Equal("folders/123"))
+
+            GinkgoT().Setenv("VALIDATOR_ORG_HIERARCHY_CHECK_EXPECTED_PARENT", "organizations/456")
+            Expect(v.CacheKey(vctx)).To(Equal("organizations/456"))
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should skip when EXPECTED_PARENT is unset", func() {
+            crm.err = &googleapi.Error{Code: 500}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+            Expect(result.Reason).To(Equal("ExpectedParentNotConfigured"))
+        })
+
+        It("should succeed when the parent matches", func() {
+            vctx.Config.ExpectedParent = "folders/123"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ParentMatches"))
+        })
+
+        It("should fail with the actual parent when it differs", func() {
+            vctx.Config.ExpectedParent = "organizations/456"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("WrongParent"))
+            Expect(result.Details).To(HaveKeyWithValue("actual_parent", "folders/123"))
+            Expect(result.Details).To(HaveKeyWithValue("expected_parent", "organizations/456"))
+        })
+
+        It("should prefer the validator-scoped EXPECTED_PARENT", func() {
+            vctx.Config.ExpectedParent = "organizations/456"
+            GinkgoT().Setenv("VALIDATOR_ORG_HIERARCHY_CHECK_EXPECTED_PARENT", "folders/123")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ParentMatches"))
+        })
+
+        It("should fail when the project lookup fails", func() {
+            vctx.Config.ExpectedParent = "folders/123"
+            crm.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
@@ -0,0 +1,225 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "path"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/servicenetworking/v1"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the address, project number and connection lookups
+    privateServiceAccessCheckTimeout = 30 * time.Second
+
+    // Service producer of the private service access peering used by Cloud SQL, Memorystore and others
+    serviceNetworkingService = "services/servicenetworking.googleapis.com"
+
+    // Purpose of global addresses allocated as private service access ranges
+    addressPurposeVPCPeering = "VPC_PEERING"
+)
+
+// PrivateServiceAccessCheckValidator checks that the VPC network has private service access:
+// an allocated VPC_PEERING range and a Service Networking connection using it
+// Managed services such as Cloud SQL and Memorystore cannot get private IPs without both
+type PrivateServiceAccessCheckValidator struct{}
+
+// init registers the PrivateServiceAccessCheckValidator with the global validator registry
+func init() {
+    validator.Register(&PrivateServiceAccessCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *PrivateServiceAccessCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "private-service-access-check",
+        Description: "Verify the VPC network has an allocated range and a servicenetworking.googleapis.com peering for managed services",
+        RunAfter:    []string{"api-enabled"}, // Requires compute and servicenetworking APIs
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs private-service-access-check calls so api-enabled checks them
+func (v *PrivateServiceAccessCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com", "compute.googleapis.com", "servicenetworking.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless CHECK_PRIVATE_SERVICE_ACCESS is set
+func (v *PrivateServiceAccessCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CHECK_PRIVATE_SERVICE_ACCESS")
+}
+
+// Describe names the network that will be checked
+func (v *PrivateServiceAccessCheckValidator) Describe(vctx *validator.Context) string {
+    network := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    return fmt.Sprintf("will verify VPC network %s in project %s has an allocated VPC_PEERING range and a servicenetworking.googleapis.com peering",
+        network, vctx.Config.ProjectID)
+}
+
+// Validate looks up the network's allocated peering ranges and its Service Networking connection
+func (v *PrivateServiceAccessCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    network := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    slog.Info("Checking private service access", "network", network)
+
+    ctx, cancel := context.WithTimeout(ctx, privateServiceAccessCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    addresses, err := computeSvc.ListGlobalAddresses(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to list global addresses",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonPrivateServiceAccessCheckFailed),
+            Message: fmt.Sprintf("Failed to list global addresses: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+    allocated := allocatedPeeringRanges(addresses, network)
+
+    // Service Networking names networks by project number, not project ID
+    projectNumber, err := vctx.GetProjectNumber(ctx)
+    if err != nil {
+        slog.Error("Failed to resolve project number",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonProjectNumberLookupFailed),
+            Message: fmt.Sprintf("Failed to resolve project number for the Service Networking lookup: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    networking, err := vctx.GetServiceNetworkingAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Service Networking client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonServiceNetworkingClientError),
+            Message: fmt.Sprintf("Failed to get Service Networking client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    networkName := fmt.Sprintf("projects/%d/global/networks/%s", projectNumber, network)
+    connections, err := networking.ListConnections(ctx, networkName)
+    if err != nil {
+        slog.Error("Failed to list Service Networking connections",
+            "error", err.Error(),
+            "network", network)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonPrivateServiceAccessCheckFailed),
+            Message: fmt.Sprintf("Failed to list Service Networking connections of %s: %v", network, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "network":    network,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Enable servicenetworking.googleapis.com and grant servicenetworking.services.get to the validator's service account",
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "network":          network,
+        "allocated_ranges": allocated,
+        "project_id":       vctx.Config.ProjectID,
+    }
+
+    peering := ""
+    if c := findServiceNetworkingConnection(connections); c != nil {
+        peering = c.Peering
+        details["peering"] = c.Peering
+        details["reserved_peering_ranges"] = c.ReservedPeeringRanges
+    }
+
+    if len(allocated) == 0 || peering == "" {
+        var missing string
+        switch {
+        case len(allocated) == 0 && peering == "":
+            missing = "no allocated VPC_PEERING range and no servicenetworking.googleapis.com peering"
+            details["hint"] = "Allocate a range with: gcloud compute addresses create <name> --global --purpose=VPC_PEERING " +
+                "--prefix-length=16 --network=" + network + ", then run: gcloud services vpc-peerings connect " +
+                "--service=servicenetworking.googleapis.com --ranges=<name> --network=" + network
+        case len(allocated) == 0:
+            missing = "no allocated VPC_PEERING range"
+            details["hint"] = "Allocate a range with: gcloud compute addresses create <name> --global --purpose=VPC_PEERING --network=" + network
+        default:
+            missing = "no servicenetworking.googleapis.com peering"
+            details["hint"] = "Connect the allocated ranges with: gcloud services vpc-peerings connect " +
+                "--service=servicenetworking.googleapis.com --ranges=<names> --network=" + network
+        }
+        slog.Warn("Private service access not configured", "network", network, "missing", missing)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonNoPrivateServiceAccess,
+            Message: fmt.Sprintf("VPC network %s has %s", network, missing),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("VPC network %s has private service access through peering %s", network, peering)
+    slog.Info(message, "allocated_ranges", allocated)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonPrivateServiceAccessConfigured,
+        Message: message,
+        Details: details,
+    }
+}
+
+// findServiceNetworkingConnection returns the servicenetworking.googleapis.com connection, or nil
+func findServiceNetworkingConnection(connections []*servicenetworking.Connection) *servicenetworking.Connection {
+    for _, c := range connections {
+        if c.Service == serviceNetworkingService {
+            return c
+        }
+    }
+    return nil
+}
+
+// allocatedPeeringRanges returns the VPC_PEERING global addresses of network as "<name> (<address>/<prefix>)"
+func allocatedPeeringRanges(addresses []*compute.Address, network string) []string {
+    var ranges []string
+    for _, a := range addresses {
+        if a.Purpose != addressPurposeVPCPeering || path.Base(a.Network) != network {
+            continue
+        }
+        ranges = append(ranges, fmt.Sprintf("%s (%s/%d)", a.Name, a.Address, a.PrefixLength))
+    }
+    return ranges
+}
@@ -0,0 +1,115 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+    "google.golang.org/api/servicenetworking/v1"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("PrivateServiceAccessCheckValidator", func() {
+    var (
+        v           *validators.PrivateServiceAccessCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+        networking  *fakeServiceNetworking
+    )
+
+    const networkName = "projects/123456789/global/networks/cluster-vpc"
+
+    BeforeEach(func() {
+        v = &validators.PrivateServiceAccessCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("CHECK_PRIVATE_SERVICE_ACCESS", "true")
+        GinkgoT().Setenv("VPC_NAME", "cluster-vpc")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+        vctx.ProjectNumber = 123456789
+
+        computeFake = &fakeCompute{globalAddresses: []*compute.Address{
+            {
+                Name:         "google-managed-services-cluster-vpc",
+                Purpose:      "VPC_PEERING",
+                Address:      "10.100.0.0",
+                PrefixLength: 16,
+                Network:      "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/cluster-vpc",
+            },
+            {Name: "lb-ip", Address: "34.1.2.3", Network: ""},
+            {Name: "other-vpc-range", Purpose: "VPC_PEERING", Network: "projects/test-project/global/networks/other"},
+        }}
+        vctx.SetComputeAPI(computeFake)
+
+        networking = &fakeServiceNetworking{connections: map[string][]*servicenetworking.Connection{
+            networkName: {{
+                Service:               "services/servicenetworking.googleapis.com",
+                Peering:               "servicenetworking-googleapis-com",
+                ReservedPeeringRanges: []string{"google-managed-services-cluster-vpc"},
+            }},
+        }}
+        vctx.SetServiceNetworkingAPI(networking)
+    })
+
+    It("should not be enabled without CHECK_PRIVATE_SERVICE_ACCESS", func() {
+        vctx.Config.CheckPrivateServiceAccess = false
+        Expect(v.Enabled(vctx)).To(BeFalse())
+    })
+
+    It("should pass with an allocated range and the peering", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("PrivateServiceAccessConfigured"))
+        Expect(result.Details).To(HaveKeyWithValue("allocated_ranges", []string{"google-managed-services-cluster-vpc (10.100.0.0/16)"}))
+        Expect(result.Details).To(HaveKeyWithValue("peering", "servicenetworking-googleapis-com"))
+        Expect(result.Details).To(HaveKeyWithValue("reserved_peering_ranges", []string{"google-managed-services-cluster-vpc"}))
+    })
+
+    It("should fail without the servicenetworking peering", func() {
+        networking.connections = nil
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("NoPrivateServiceAccess"))
+        Expect(result.Message).To(ContainSubstring("no servicenetworking.googleapis.com peering"))
+    })
+
+    It("should fail without an allocated range on the network", func() {
+        computeFake.globalAddresses = computeFake.globalAddresses[1:]
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("NoPrivateServiceAccess"))
+        Expect(result.Message).To(ContainSubstring("no allocated VPC_PEERING range"))
+    })
+
+    It("should ignore connections of other service producers", func() {
+        networking.connections[networkName][0].Service = "services/cloud-sql.googleapis.com"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("NoPrivateServiceAccess"))
+    })
+
+    It("should fail when the connections cannot be listed", func() {
+        networking.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
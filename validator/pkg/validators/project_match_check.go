@@ -0,0 +1,105 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "strings"
+
+    "validator/pkg/validator"
+)
+
+// ProjectMatchCheckValidator warns when the Application Default Credentials belong to another project
+// "Right credentials, wrong project" otherwise shows up as confusing partial failures further on
+// A different quota project can be intentional, so a mismatch is a warning rather than a failure
+type ProjectMatchCheckValidator struct{}
+
+// init registers the ProjectMatchCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ProjectMatchCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ProjectMatchCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "project-match-check",
+        Description: "Warn when the credentials' project or quota project differs from PROJECT_ID",
+        RunAfter:    []string{}, // No dependencies - reads local credentials at Level 0
+        Tags:        []string{"mvp", "auth"},
+    }
+}
+
+// Validate compares the credentials' project and quota project, where discoverable, with PROJECT_ID
+func (v *ProjectMatchCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking the project of the credentials")
+
+    projects, err := vctx.GetCredentialProjects(ctx)
+    if err != nil {
+        slog.Error("Failed to read credential projects",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonCredentialLookupFailed,
+            Message: fmt.Sprintf("Failed to read the Application Default Credentials (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "project_id": vctx.Config.ProjectID,
+    }
+    if projects.ProjectID != "" {
+        details["credential_project"] = projects.ProjectID
+    }
+    if projects.QuotaProjectID != "" {
+        details["quota_project"] = projects.QuotaProjectID
+    }
+
+    if projects.ProjectID == "" && projects.QuotaProjectID == "" {
+        // Workload Identity Federation configs usually name no project
+        message := "The credentials name no project to compare with PROJECT_ID"
+        slog.Info(message)
+        return &validator.Result{
+            Status:  validator.StatusSuccess,
+            Reason:  validator.ReasonCredentialProjectUnknown,
+            Message: message,
+            Details: details,
+        }
+    }
+
+    var mismatches []string
+    if projects.ProjectID != "" && projects.ProjectID != vctx.Config.ProjectID {
+        mismatches = append(mismatches, fmt.Sprintf("credentials belong to project %s", projects.ProjectID))
+    }
+    if projects.QuotaProjectID != "" && projects.QuotaProjectID != vctx.Config.ProjectID {
+        mismatches = append(mismatches, fmt.Sprintf("quota is billed to project %s", projects.QuotaProjectID))
+    }
+
+    if len(mismatches) > 0 {
+        slog.Warn("Credentials are tied to a different project",
+            "project_id", vctx.Config.ProjectID,
+            "credential_project", projects.ProjectID,
+            "quota_project", projects.QuotaProjectID)
+        details["hint"] = "Check GOOGLE_APPLICATION_CREDENTIALS and PROJECT_ID point at the same project; a separate quota project is fine when intended"
+        return &validator.Result{
+            Status:  validator.StatusWarning,
+            Reason:  validator.ReasonProjectMismatch,
+            Message: fmt.Sprintf("PROJECT_ID is %s but the %s", vctx.Config.ProjectID, strings.Join(mismatches, " and the ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Credentials are tied to project %s", vctx.Config.ProjectID)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonProjectMatches,
+        Message: message,
+        Details: details,
+    }
+}
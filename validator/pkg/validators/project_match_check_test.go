@@ -0,0 +1,91 @@
+package validators_test
+
+import (
+    "context"
+    "errors"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+
+    "validator/pkg/config"
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ProjectMatchCheckValidator", func() {
+    var (
+        v    *validators.ProjectMatchCheckValidator
+        vctx *validator.Context
+    )
+
+    BeforeEach(func() {
+        v = &validators.ProjectMatchCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the credentials belong to PROJECT_ID", func() {
+            vctx.SetCredentialProjects(&gcp.CredentialProjects{ProjectID: "test-project", QuotaProjectID: "test-project"})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ProjectMatches"))
+        })
+
+        It("should warn when the credentials belong to another project", func() {
+            vctx.SetCredentialProjects(&gcp.CredentialProjects{ProjectID: "other-project"})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Reason).To(Equal("ProjectMismatch"))
+            Expect(result.Message).To(ContainSubstring("credentials belong to project other-project"))
+            Expect(result.Details).To(HaveKeyWithValue("credential_project", "other-project"))
+        })
+
+        It("should warn about a different quota project", func() {
+            vctx.SetCredentialProjects(&gcp.CredentialProjects{ProjectID: "test-project", QuotaProjectID: "billing-project"})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusWarning))
+            Expect(result.Message).To(ContainSubstring("quota is billed to project billing-project"))
+            Expect(result.Details).To(HaveKeyWithValue("quota_project", "billing-project"))
+        })
+
+        It("should succeed when the credentials name no project", func() {
+            vctx.SetCredentialProjects(&gcp.CredentialProjects{})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("CredentialProjectUnknown"))
+        })
+
+        It("should fail when the credentials cannot be read", func() {
+            vctx = validator.NewContextWithFactory(vctx.Config, &failingCredentialsFactory{})
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("CredentialLookupFailed"))
+        })
+    })
+})
+
+// failingCredentialsFactory is a gcp.ClientFactory whose credential lookup always fails
+type failingCredentialsFactory struct {
+    *gcp.ClientFactory
+}
+
+func (f *failingCredentialsFactory) DefaultCredentialProjects(ctx context.Context) (*gcp.CredentialProjects, error) {
+    return nil, errors.Join(gcp.ErrCredentials, errors.New("no credentials"))
+}
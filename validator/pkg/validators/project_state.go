@@ -0,0 +1,116 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the project lifecycle lookup
+    projectStateRequestTimeout = 30 * time.Second
+
+    // Lifecycle state of a project that can be provisioned into
+    projectStateActive = "ACTIVE"
+)
+
+// ProjectStateValidator checks that the project is ACTIVE, not pending deletion
+// A cheap Level 0 gate: provisioning into a DELETE_REQUESTED project is doomed
+type ProjectStateValidator struct{}
+
+// init registers the ProjectStateValidator with the global validator registry
+func init() {
+    validator.Register(&ProjectStateValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ProjectStateValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "project-state-check",
+        Description: "Verify the project lifecycle state is ACTIVE (not scheduled for deletion)",
+        RunAfter:    []string{}, // No dependencies - runs at Level 0 as an early gate
+        Tags:        []string{"mvp", "project"},
+        Weight:      3, // Nothing can be provisioned in a project that is not ACTIVE
+    }
+}
+
+// RequiredAPIs declares the APIs project-state-check calls so api-enabled checks them
+func (v *ProjectStateValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com"}
+}
+
+// Validate fetches the project and checks its lifecycle state
+// The project number is shared via the Context so later validators skip their own lookup
+func (v *ProjectStateValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking project lifecycle state")
+
+    ctx, cancel := context.WithTimeout(ctx, projectStateRequestTimeout)
+    defer cancel()
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    project, err := crm.GetProject(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to get project",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonProjectLookupFailed),
+            Message: fmt.Sprintf("Failed to get project %s: %v", vctx.Config.ProjectID, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    vctx.SetProjectNumber(project.ProjectNumber)
+
+    if project.LifecycleState != projectStateActive {
+        slog.Warn("Project is not active",
+            "project_id", vctx.Config.ProjectID,
+            "lifecycle_state", project.LifecycleState)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonProjectNotActive,
+            Message: fmt.Sprintf("Project %s is %s, expected %s", vctx.Config.ProjectID, project.LifecycleState, projectStateActive),
+            Details: map[string]interface{}{
+                "lifecycle_state": project.LifecycleState,
+                "project_id":      vctx.Config.ProjectID,
+                "hint":            "Restore a DELETE_REQUESTED project with: gcloud projects undelete <project-id>",
+            },
+        }
+    }
+
+    message := fmt.Sprintf("Project %s is ACTIVE", vctx.Config.ProjectID)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonProjectActive,
+        Message: message,
+        Details: map[string]interface{}{
+            "lifecycle_state": project.LifecycleState,
+            "project_number":  project.ProjectNumber,
+            "project_id":      vctx.Config.ProjectID,
+        },
+    }
+}
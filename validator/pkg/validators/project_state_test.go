@@ -0,0 +1,85 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ProjectStateValidator", func() {
+    var (
+        v    *validators.ProjectStateValidator
+        vctx *validator.Context
+        crm  *fakeResourceManager
+    )
+
+    BeforeEach(func() {
+        v = &validators.ProjectStateValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        crm = &fakeResourceManager{project: &cloudresourcemanager.Project{
+            ProjectId:      "test-project",
+            ProjectNumber:  123456789,
+            LifecycleState: "ACTIVE",
+        }}
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Metadata", func() {
+        It("should run at Level 0", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("project-state-check"))
+            Expect(meta.RunAfter).To(BeEmpty())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed for an ACTIVE project and share its number", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ProjectActive"))
+            Expect(vctx.ProjectNumber).To(Equal(int64(123456789)))
+
+            // Later lookups are served from the shared state
+            crm.err = &googleapi.Error{Code: 500}
+            number, err := vctx.GetProjectNumber(context.Background())
+            Expect(err).NotTo(HaveOccurred())
+            Expect(number).To(Equal(int64(123456789)))
+        })
+
+        It("should fail with the actual state when the project is not ACTIVE", func() {
+            crm.project.LifecycleState = "DELETE_REQUESTED"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("ProjectNotActive"))
+            Expect(result.Details).To(HaveKeyWithValue("lifecycle_state", "DELETE_REQUESTED"))
+        })
+
+        It("should fail when the project lookup fails", func() {
+            crm.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
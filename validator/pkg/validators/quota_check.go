@@ -2,13 +2,54 @@ package validators
 
 import (
     "context"
+    "fmt"
     "log/slog"
+    "sort"
+    "strings"
+    "time"
 
+    "google.golang.org/api/compute/v1"
     "validator/pkg/validator"
 )
 
+const (
+    // Timeout for overall quota validation
+    quotaValidationTimeout = 1 * time.Minute
+    // Timeout for individual quota lookup requests
+    quotaRequestTimeout = 30 * time.Second
+)
+
+// quotaScope identifies where GCP enforces a quota metric
+type quotaScope string
+
+const (
+    quotaScopeGlobal   quotaScope = "global"   // Reported by Projects.Get
+    quotaScopeRegional quotaScope = "regional" // Reported by Regions.Get
+)
+
+// quotaMetricScopes maps Compute Engine quota metrics to the scope they are enforced at
+// Reading a regional limit from the project (or vice versa) reports the wrong headroom
+var quotaMetricScopes = map[string]quotaScope{
+    "CPUS":             quotaScopeRegional,
+    "DISKS_TOTAL_GB":   quotaScopeRegional,
+    "SSD_TOTAL_GB":     quotaScopeRegional,
+    "IN_USE_ADDRESSES": quotaScopeRegional,
+    "STATIC_ADDRESSES": quotaScopeRegional,
+    "CPUS_ALL_REGIONS": quotaScopeGlobal,
+    "NETWORKS":         quotaScopeGlobal,
+    "SUBNETWORKS":      quotaScopeGlobal,
+    "FIREWALLS":        quotaScopeGlobal,
+    "ROUTERS":          quotaScopeGlobal,
+}
+
+// quotaRequirement is the amount of a single quota metric required for cluster creation
+type quotaRequirement struct {
+    Metric   string
+    Scope    quotaScope
+    Required float64
+}
+
 // QuotaCheckValidator verifies sufficient GCP quota is available
-// TODO: Implement actual quota checking logic
 type QuotaCheckValidator struct{}
 
 // init registers the QuotaCheckValidator with the global validator registry
@@ -20,67 +61,226 @@ func init() {
 func (v *QuotaCheckValidator) Metadata() validator.ValidatorMetadata {
     return validator.ValidatorMetadata{
         Name:        "quota-check",
-        Description: "Verify sufficient GCP quota is available (stub - requires implementation)",
+        Description: "Verify sufficient GCP quota is available at the global and regional scopes",
         RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure GCP access works
-        Tags:        []string{"post-mvp", "quota", "stub"},
+        Tags:        []string{"post-mvp", "quota"},
     }
 }
 
-// Validate performs the actual validation logic (currently a stub returning success)
+// RequiredAPIs declares the APIs quota-check calls so api-enabled checks them
+func (v *QuotaCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless at least one quota requirement is configured
+func (v *QuotaCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRED_VCPUS") ||
+        vctx.HasConfig("REQUIRED_DISK_GB") ||
+        vctx.HasConfig("REQUIRED_IP_ADDRESSES")
+}
+
+// Describe lists the quota headroom that will be required
+func (v *QuotaCheckValidator) Describe(vctx *validator.Context) string {
+    requirements := quotaRequirements(vctx)
+    needs := make([]string, 0, len(requirements))
+    for _, r := range requirements {
+        needs = append(needs, fmt.Sprintf("%s >= %g (%s)", r.Metric, r.Required, r.Scope))
+    }
+    return fmt.Sprintf("will verify quota headroom %s in project %s, region %s",
+        strings.Join(needs, ", "), vctx.Config.ProjectID, vctx.Config.GCPRegion)
+}
+
+// quotaRequirements builds the list of required quota metrics from configuration
+// vCPUs are bound both by the regional CPUS quota and the global CPUS_ALL_REGIONS quota
+func quotaRequirements(vctx *validator.Context) []quotaRequirement {
+    cfg := vctx.Config
+    var reqs []quotaRequirement
+    add := func(metric string, required int) {
+        if required > 0 {
+            reqs = append(reqs, quotaRequirement{
+                Metric:   metric,
+                Scope:    quotaMetricScopes[metric],
+                Required: float64(required),
+            })
+        }
+    }
+    add("CPUS", cfg.RequiredVCPUs)
+    add("CPUS_ALL_REGIONS", cfg.RequiredVCPUs)
+    add("DISKS_TOTAL_GB", cfg.RequiredDiskGB)
+    add("IN_USE_ADDRESSES", cfg.RequiredIPAddresses)
+    return reqs
+}
+
+// Validate checks each required quota metric against its limit at the correct scope
 func (v *QuotaCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
-    slog.Info("Running quota check validator (stub implementation)")
-
-    // TODO: Implement actual quota validation
-    // This should check:
-    // 1. Compute Engine quota (CPUs, disk, IPs, etc.)
-    // 2. Use the Compute API to get quota information
-    // 3. Compare against required resources for cluster creation
-    //
-    // Example implementation structure:
-    //
-    // // Get Compute service from context (lazy initialization with least privilege)
-    // computeSvc, err := vctx.GetComputeService(ctx)
-    // if err != nil {
-    //     return &validator.Result{
-    //         Status:  validator.StatusFailure,
-    //         Reason:  "ComputeClientError",
-    //         Message: fmt.Sprintf("Failed to get Compute client: %v", err),
-    //     }
-    // }
-    //
-    // // Get project quota
-    // project, err := computeSvc.Projects.Get(vctx.Config.ProjectID).Context(ctx).Do()
-    // if err != nil {
-    //     return &validator.Result{
-    //         Status:  validator.StatusFailure,
-    //         Reason:  "QuotaCheckFailed",
-    //         Message: fmt.Sprintf("Failed to get project quota: %v", err),
-    //     }
-    // }
-    //
-    // // Check specific quotas
-    // for _, quota := range project.Quotas {
-    //     if quota.Metric == "CPUS" && quota.Limit-quota.Usage < requiredCPUs {
-    //         return &validator.Result{
-    //             Status:  validator.StatusFailure,
-    //             Reason:  "InsufficientQuota",
-    //             Message: fmt.Sprintf("Insufficient CPU quota: available=%d, required=%d",
-    //                 int(quota.Limit-quota.Usage), requiredCPUs),
-    //         }
-    //     }
-    // }
-
-    slog.Warn("Quota check not yet implemented - returning success by default")
+    slog.Info("Checking GCP quota availability")
+
+    reqs := quotaRequirements(vctx)
+    if len(reqs) == 0 {
+        slog.Info("No quota requirements configured, skipping quota check")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonNoQuotaRequirements,
+            Message: "No quota requirements configured",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set REQUIRED_VCPUS, REQUIRED_DISK_GB or REQUIRED_IP_ADDRESSES to enable quota checks",
+            },
+        }
+    }
+
+    needsGlobal, needsRegional := false, false
+    for _, r := range reqs {
+        switch r.Scope {
+        case quotaScopeGlobal:
+            needsGlobal = true
+        case quotaScopeRegional:
+            needsRegional = true
+        }
+    }
+
+    region := vctx.Config.GCPRegion
+    if needsRegional && region == "" {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: "GCP_REGION is required to check regional quotas",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set GCP_REGION to the region the cluster will be created in",
+            },
+        }
+    }
+
+    // Add timeout for overall validation
+    ctx, cancel := context.WithTimeout(ctx, quotaValidationTimeout)
+    defer cancel()
+
+    // Get Compute API from context (lazy initialization with least privilege)
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    // Collect quotas per scope, only querying the scopes that are needed
+    quotas := map[quotaScope][]*compute.Quota{}
+    if needsGlobal {
+        reqCtx, reqCancel := context.WithTimeout(ctx, quotaRequestTimeout)
+        project, err := computeSvc.GetProject(reqCtx, vctx.Config.ProjectID)
+        reqCancel()
+        if err != nil {
+            return quotaLookupFailure(vctx, quotaScopeGlobal, err)
+        }
+        quotas[quotaScopeGlobal] = project.Quotas
+    }
+    if needsRegional {
+        reqCtx, reqCancel := context.WithTimeout(ctx, quotaRequestTimeout)
+        r, err := computeSvc.GetRegion(reqCtx, vctx.Config.ProjectID, region)
+        reqCancel()
+        if err != nil {
+            return quotaLookupFailure(vctx, quotaScopeRegional, err)
+        }
+        quotas[quotaScopeRegional] = r.Quotas
+    }
+
+    // Compare each requirement against the quota reported at its scope
+    var shortfalls []map[string]interface{}
+    checked := []string{}
+    for _, req := range reqs {
+        q := findQuota(quotas[req.Scope], req.Metric)
+        if q == nil {
+            slog.Warn("Quota metric not reported by GCP, skipping", "metric", req.Metric, "scope", req.Scope)
+            continue
+        }
+        checked = append(checked, req.Metric)
+
+        available := q.Limit - q.Usage
+        slog.Debug("Quota metric", "metric", req.Metric, "scope", req.Scope,
+            "limit", q.Limit, "usage", q.Usage, "required", req.Required)
+        if available < req.Required {
+            shortfall := map[string]interface{}{
+                "metric":    req.Metric,
+                "scope":     string(req.Scope),
+                "required":  req.Required,
+                "available": available,
+                "limit":     q.Limit,
+                "usage":     q.Usage,
+            }
+            if req.Scope == quotaScopeRegional {
+                shortfall["region"] = region
+            }
+            shortfalls = append(shortfalls, shortfall)
+        }
+    }
+    sort.Strings(checked)
+
+    if len(shortfalls) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonInsufficientQuota,
+            Message: fmt.Sprintf("%d quota metric(s) have insufficient headroom", len(shortfalls)),
+            Details: map[string]interface{}{
+                "shortfalls":      shortfalls,
+                "checked_metrics": checked,
+                "project_id":      vctx.Config.ProjectID,
+                "region":          region,
+                "hint":            "Request a quota increase in the GCP console (IAM & Admin > Quotas)",
+            },
+        }
+    }
+
+    message := fmt.Sprintf("All %d quota metric(s) have sufficient headroom", len(checked))
+    slog.Info(message)
 
     return &validator.Result{
         Status:  validator.StatusSuccess,
-        Reason:  "QuotaCheckStub",
-        Message: "Quota check validation not yet implemented (stub returning success)",
+        Reason:  validator.ReasonQuotaSufficient,
+        Message: message,
+        Details: map[string]interface{}{
+            "checked_metrics": checked,
+            "project_id":      vctx.Config.ProjectID,
+            "region":          region,
+        },
+    }
+}
+
+// findQuota returns the quota entry for a metric, or nil if GCP did not report it
+func findQuota(quotas []*compute.Quota, metric string) *compute.Quota {
+    for _, q := range quotas {
+        if q != nil && q.Metric == metric {
+            return q
+        }
+    }
+    return nil
+}
+
+// quotaLookupFailure builds the failure result for a failed project or region lookup
+func quotaLookupFailure(vctx *validator.Context, scope quotaScope, err error) *validator.Result {
+    slog.Error("Failed to get quota",
+        "scope", scope,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID,
+        "region", vctx.Config.GCPRegion)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonQuotaCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s quota: %v", scope, err),
         Details: map[string]interface{}{
-            "stub":        true,
-            "implemented": false,
-            "project_id":  vctx.Config.ProjectID,
-            "note":        "This validator needs to be implemented to check actual GCP quotas",
+            "scope":      string(scope),
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+            "region":     vctx.Config.GCPRegion,
         },
     }
 }
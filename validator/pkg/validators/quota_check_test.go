@@ -7,6 +7,8 @@ import (
 
     . "github.com/onsi/ginkgo/v2"
     . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
 
     "validator/pkg/config"
     "validator/pkg/validator"
@@ -40,11 +42,9 @@ var _ = Describe("QuotaCheckValidator", func() {
             meta := v.Metadata()
             Expect(meta.Name).To(Equal("quota-check"))
             Expect(meta.Description).To(ContainSubstring("quota"))
-            Expect(meta.Description).To(ContainSubstring("stub"))
             Expect(meta.RunAfter).To(ConsistOf("api-enabled")) // Depends on api-enabled
             Expect(meta.Tags).To(ContainElement("post-mvp"))
             Expect(meta.Tags).To(ContainElement("quota"))
-            Expect(meta.Tags).To(ContainElement("stub"))
         })
 
         It("should depend on api-enabled (Level 1)", func() {
@@ -77,25 +77,119 @@ var _ = Describe("QuotaCheckValidator", func() {
             })
         })
 
+        Context("when no quota requirements are configured", func() {
+            It("should drop out of the plan", func() {
+                vctx.Config.RequiredVCPUs = 0
+                vctx.Config.RequiredDiskGB = 0
+                vctx.Config.RequiredIPAddresses = 0
+                Expect(v.Enabled(vctx)).To(BeFalse())
+            })
+
+            It("should be enabled once any requirement is set", func() {
+                vctx.Config.RequiredDiskGB = 100
+                Expect(v.Enabled(vctx)).To(BeTrue())
+            })
+        })
+
     })
 
     Describe("Validate", func() {
-        It("should return success with stub message", func() {
-            ctx := context.Background()
-            result := v.Validate(ctx, vctx)
-            Expect(result).NotTo(BeNil())
-            Expect(result.Status).To(Equal(validator.StatusSuccess))
-            Expect(result.Reason).To(Equal("QuotaCheckStub"))
-            Expect(result.Message).To(ContainSubstring("not yet implemented"))
+        Context("with no quota requirements configured", func() {
+            It("should skip without contacting GCP", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result).NotTo(BeNil())
+                Expect(result.Status).To(Equal(validator.StatusSkipped))
+                Expect(result.Reason).To(Equal("NoQuotaRequirements"))
+            })
+        })
+
+        Context("with regional requirements but no region", func() {
+            BeforeEach(func() {
+                vctx.Config.RequiredVCPUs = 8
+                vctx.Config.GCPRegion = ""
+            })
+
+            It("should fail with RegionNotConfigured", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("RegionNotConfigured"))
+            })
         })
 
-        It("should include stub metadata in details", func() {
-            ctx := context.Background()
-            result := v.Validate(ctx, vctx)
-            Expect(result.Details).To(HaveKey("stub"))
-            Expect(result.Details["stub"]).To(BeTrue())
-            Expect(result.Details).To(HaveKey("implemented"))
-            Expect(result.Details["implemented"]).To(BeFalse())
+        Context("with a fake Compute API", func() {
+            var fake *fakeCompute
+
+            BeforeEach(func() {
+                vctx.Config.GCPRegion = "us-central1"
+                vctx.Config.RequiredVCPUs = 8
+                fake = &fakeCompute{
+                    project: &compute.Project{Quotas: []*compute.Quota{
+                        {Metric: "CPUS", Limit: 1000, Usage: 0},
+                        {Metric: "CPUS_ALL_REGIONS", Limit: 32, Usage: 0},
+                    }},
+                    regions: map[string]*compute.Region{
+                        "us-central1": {Name: "us-central1", Quotas: []*compute.Quota{
+                            {Metric: "CPUS", Limit: 24, Usage: 4},
+                        }},
+                    },
+                }
+                vctx.SetComputeAPI(fake)
+            })
+
+            It("should succeed when both scopes have headroom", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.Reason).To(Equal("QuotaSufficient"))
+                Expect(result.Details["checked_metrics"]).To(ConsistOf("CPUS", "CPUS_ALL_REGIONS"))
+            })
+
+            It("should read CPUS from the region rather than the project", func() {
+                fake.regions["us-central1"].Quotas[0].Usage = 20
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("InsufficientQuota"))
+
+                shortfalls, ok := result.Details["shortfalls"].([]map[string]interface{})
+                Expect(ok).To(BeTrue())
+                Expect(shortfalls).To(HaveLen(1))
+                Expect(shortfalls[0]).To(HaveKeyWithValue("metric", "CPUS"))
+                Expect(shortfalls[0]).To(HaveKeyWithValue("scope", "regional"))
+                Expect(shortfalls[0]).To(HaveKeyWithValue("region", "us-central1"))
+            })
+
+            It("should report global shortfalls with global scope", func() {
+                fake.project.Quotas[1].Usage = 30
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+
+                shortfalls := result.Details["shortfalls"].([]map[string]interface{})
+                Expect(shortfalls).To(HaveLen(1))
+                Expect(shortfalls[0]).To(HaveKeyWithValue("metric", "CPUS_ALL_REGIONS"))
+                Expect(shortfalls[0]).To(HaveKeyWithValue("scope", "global"))
+                Expect(shortfalls[0]).NotTo(HaveKey("region"))
+            })
+
+            It("should aggregate shortfalls across scopes", func() {
+                vctx.Config.RequiredDiskGB = 500
+                fake.regions["us-central1"].Quotas = append(fake.regions["us-central1"].Quotas,
+                    &compute.Quota{Metric: "DISKS_TOTAL_GB", Limit: 100, Usage: 0})
+                fake.project.Quotas[1].Usage = 30
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Details["shortfalls"]).To(HaveLen(2))
+            })
+
+            It("should fail when the region lookup fails", func() {
+                fake.regionErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("forbidden"))
+                Expect(result.Details).To(HaveKeyWithValue("scope", "regional"))
+            })
         })
     })
 })
@@ -0,0 +1,229 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "path"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for overall region validation
+    regionValidationTimeout = 1 * time.Minute
+    // Timeout for individual region and zone lookups
+    regionRequestTimeout = 30 * time.Second
+
+    // Status reported by Compute Engine for a region or zone that is available
+    computeStatusUp = "UP"
+)
+
+// RegionCheckValidator verifies the configured region exists and is up
+// A cheap gate that gives a clearer error than a failure deep in quota-check
+type RegionCheckValidator struct{}
+
+// init registers the RegionCheckValidator with the global validator registry
+func init() {
+    validator.Register(&RegionCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *RegionCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "region-check",
+        Description: "Verify the configured Compute Engine region exists and is UP",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure GCP access works
+        Tags:        []string{"post-mvp", "compute"},
+    }
+}
+
+// RequiredAPIs declares the APIs region-check calls so api-enabled checks them
+func (v *RegionCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless a region is configured
+func (v *RegionCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("GCP_REGION")
+}
+
+// Describe names the region that will be checked
+func (v *RegionCheckValidator) Describe(vctx *validator.Context) string {
+    return fmt.Sprintf("will verify region %s exists and is UP for project %s", vctx.Config.GCPRegion, vctx.Config.ProjectID)
+}
+
+// Validate looks up the region and, when CHECK_REGION_ZONES is set, each of its zones
+func (v *RegionCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    region := vctx.Config.GCPRegion
+    if region == "" {
+        slog.Info("GCP_REGION not set, skipping region check")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: "No GCP region configured",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set GCP_REGION to the region the cluster will be created in",
+            },
+        }
+    }
+
+    slog.Info("Checking Compute Engine region", "region", region)
+
+    // Add timeout for overall validation
+    ctx, cancel := context.WithTimeout(ctx, regionValidationTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    reqCtx, reqCancel := context.WithTimeout(ctx, regionRequestTimeout)
+    r, err := computeSvc.GetRegion(reqCtx, vctx.Config.ProjectID, region)
+    reqCancel()
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonInvalidRegion,
+                Message: fmt.Sprintf("Region %s does not exist", region),
+                Details: map[string]interface{}{
+                    "region":     region,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "List valid regions with: gcloud compute regions list",
+                },
+            }
+        }
+        return regionLookupFailure(vctx, "region", region, err)
+    }
+
+    if r.Status != computeStatusUp {
+        slog.Warn("Region is not UP", "region", region, "status", r.Status)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionDown,
+            Message: fmt.Sprintf("Region %s is %s", region, r.Status),
+            Details: map[string]interface{}{
+                "region":     region,
+                "status":     r.Status,
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    // Zone names are reported as full resource URLs
+    zones := make([]string, 0, len(r.Zones))
+    for _, z := range r.Zones {
+        zones = append(zones, path.Base(z))
+    }
+
+    if !vctx.Config.CheckRegionZones {
+        message := fmt.Sprintf("Region %s is UP", region)
+        slog.Info(message)
+        return &validator.Result{
+            Status:  validator.StatusSuccess,
+            Reason:  validator.ReasonRegionUp,
+            Message: message,
+            Details: map[string]interface{}{
+                "region":     region,
+                "zones":      zones,
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    var downZones []string
+    subResults := make([]*validator.Result, 0, len(zones))
+    for _, zone := range zones {
+        reqCtx, reqCancel := context.WithTimeout(ctx, regionRequestTimeout)
+        z, err := computeSvc.GetZone(reqCtx, vctx.Config.ProjectID, zone)
+        reqCancel()
+        if err != nil {
+            return regionLookupFailure(vctx, "zone", zone, err)
+        }
+
+        if z.Status == computeStatusUp {
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: zone,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonZoneUp,
+                Message:       fmt.Sprintf("Zone %s is UP", zone),
+            })
+            continue
+        }
+        downZones = append(downZones, zone)
+        slog.Warn("Zone is not UP", "zone", zone, "status", z.Status)
+        subResults = append(subResults, &validator.Result{
+            ValidatorName: zone,
+            Status:        validator.StatusFailure,
+            Reason:        validator.ReasonZoneDown,
+            Message:       fmt.Sprintf("Zone %s is %s", zone, z.Status),
+        })
+    }
+
+    if len(downZones) > 0 {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonZoneDown,
+            Message: fmt.Sprintf("%d zone(s) in region %s are not UP", len(downZones), region),
+            Details: map[string]interface{}{
+                "region":     region,
+                "down_zones": downZones,
+                "zones":      zones,
+                "project_id": vctx.Config.ProjectID,
+            },
+            SubResults: subResults,
+        }
+    }
+
+    message := fmt.Sprintf("Region %s and its %d zone(s) are UP", region, len(zones))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonRegionUp,
+        Message: message,
+        Details: map[string]interface{}{
+            "region":     region,
+            "zones":      zones,
+            "project_id": vctx.Config.ProjectID,
+        },
+        SubResults: subResults,
+    }
+}
+
+// regionLookupFailure builds the failure result for a failed region or zone lookup
+func regionLookupFailure(vctx *validator.Context, kind, name string, err error) *validator.Result {
+    slog.Error("Failed to get "+kind,
+        kind, name,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonRegionCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s %s: %v", kind, name, err),
+        Details: map[string]interface{}{
+            kind:         name,
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,136 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("RegionCheckValidator", func() {
+    var (
+        v          *validators.RegionCheckValidator
+        vctx       *validator.Context
+        computeAPI *fakeCompute
+    )
+
+    BeforeEach(func() {
+        v = &validators.RegionCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeAPI = &fakeCompute{
+            regions: map[string]*compute.Region{
+                "us-central1": {
+                    Name:   "us-central1",
+                    Status: "UP",
+                    Zones: []string{
+                        "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-a",
+                        "https://www.googleapis.com/compute/v1/projects/test-project/zones/us-central1-b",
+                    },
+                },
+            },
+        }
+        vctx.SetComputeAPI(computeAPI)
+    })
+
+    Describe("Metadata", func() {
+        It("should return correct metadata", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("region-check"))
+            Expect(meta.RunAfter).To(ConsistOf("api-enabled"))
+        })
+    })
+
+    Describe("Enabled", func() {
+        It("should follow whether GCP_REGION is configured", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+            vctx.Config.GCPRegion = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should skip when GCP_REGION is unset", func() {
+            vctx.Config.GCPRegion = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+            Expect(result.Reason).To(Equal("RegionNotConfigured"))
+        })
+
+        It("should succeed when the region is UP", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("RegionUp"))
+            Expect(result.Details["zones"]).To(ConsistOf("us-central1-a", "us-central1-b"))
+            Expect(result.SubResults).To(BeEmpty())
+        })
+
+        It("should fail with InvalidRegion when the region does not exist", func() {
+            vctx.Config.GCPRegion = "mars-north1"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("InvalidRegion"))
+        })
+
+        It("should fail with RegionDown when the region is not UP", func() {
+            computeAPI.regions["us-central1"].Status = "DOWN"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("RegionDown"))
+        })
+
+        It("should surface other lookup errors", func() {
+            computeAPI.regionErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+
+        Context("with zone checks enabled", func() {
+            BeforeEach(func() {
+                vctx.Config.CheckRegionZones = true
+                computeAPI.zones = map[string]*compute.Zone{
+                    "us-central1-a": {Name: "us-central1-a", Status: "UP"},
+                    "us-central1-b": {Name: "us-central1-b", Status: "UP"},
+                }
+            })
+
+            It("should succeed when every zone is UP", func() {
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.SubResults).To(HaveLen(2))
+            })
+
+            It("should fail with ZoneDown when a zone is not UP", func() {
+                computeAPI.zones["us-central1-b"].Status = "DOWN"
+
+                result := v.Validate(context.Background(), vctx)
+                Expect(result.Status).To(Equal(validator.StatusFailure))
+                Expect(result.Reason).To(Equal("ZoneDown"))
+                Expect(result.Details["down_zones"]).To(ConsistOf("us-central1-b"))
+            })
+        })
+    })
+})
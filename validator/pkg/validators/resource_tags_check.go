@@ -0,0 +1,168 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "strings"
+    "time"
+
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+    "validator/pkg/validator"
+)
+
+// Timeout for the tag binding listing
+const resourceTagsCheckTimeout = 30 * time.Second
+
+// ResourceTagsCheckValidator checks that the REQUIRED_TAG_BINDINGS GCP Tags are bound to the project
+// Tags are distinct from labels: they live in the Resource Manager v3 API and are what organization
+// policies condition on, so they are listed through their own client
+type ResourceTagsCheckValidator struct{}
+
+// init registers the ResourceTagsCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ResourceTagsCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ResourceTagsCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "resource-tags-check",
+        Description: "Verify the required GCP Tags are bound to the project",
+        RunAfter:    []string{"api-enabled"}, // Requires cloudresourcemanager.googleapis.com
+        Tags:        []string{"post-mvp", "resource-manager"},
+    }
+}
+
+// RequiredAPIs declares the APIs resource-tags-check calls so api-enabled checks them
+func (v *ResourceTagsCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless REQUIRED_TAG_BINDINGS is set
+func (v *ResourceTagsCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("REQUIRED_TAG_BINDINGS")
+}
+
+// Validate lists the tag bindings of the project and reports the required ones that are missing
+// Only tags bound to the project itself count; tags inherited from a folder or the organization do not
+func (v *ResourceTagsCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    required := vctx.Config.RequiredTagBindings
+    slog.Info("Checking project tag bindings", "required", required)
+
+    ctx, cancel := context.WithTimeout(ctx, resourceTagsCheckTimeout)
+    defer cancel()
+
+    // Tag bindings are keyed by the project number, not its ID
+    projectNumber, err := vctx.GetProjectNumber(ctx)
+    if err != nil {
+        slog.Error("Failed to resolve project number",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonProjectNumberLookupFailed),
+            Message: fmt.Sprintf("Failed to resolve project number for the tag bindings lookup: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    tagsSvc, err := vctx.GetTagsAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Tags client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonTagsClientError),
+            Message: fmt.Sprintf("Failed to get Tags client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    parent := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%d", projectNumber)
+    bindings, err := tagsSvc.ListTagBindings(ctx, parent)
+    if err != nil {
+        slog.Error("Failed to list tag bindings",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonTagBindingCheckFailed),
+            Message: fmt.Sprintf("Failed to list tag bindings of project %s: %v", vctx.Config.ProjectID, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant resourcemanager.tagValueBindings.list on the project to the validator's service account",
+            },
+        }
+    }
+
+    bound := make([]string, 0, len(bindings))
+    for _, b := range bindings {
+        bound = append(bound, tagBindingName(b))
+    }
+    var missing []string
+    for _, want := range required {
+        if !tagBindingPresent(bindings, want) {
+            missing = append(missing, want)
+        }
+    }
+
+    details := map[string]interface{}{
+        "bound_tags": bound,
+        "required":   required,
+        "project_id": vctx.Config.ProjectID,
+    }
+
+    if len(missing) > 0 {
+        slog.Warn("Required tags are not bound to the project", "missing", missing)
+        details["missing_tags"] = missing
+        details["hint"] = fmt.Sprintf("Bind them with: gcloud resource-manager tags bindings create --tag-value=<value> --parent=%s", parent)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonMissingTagBinding,
+            Message: fmt.Sprintf("%d of %d required tag(s) are not bound to project %s: %s", len(missing), len(required), vctx.Config.ProjectID, strings.Join(missing, ", ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("All %d required tag(s) are bound to project %s", len(required), vctx.Config.ProjectID)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonTagBindingsPresent,
+        Message: message,
+        Details: details,
+    }
+}
+
+// tagBindingPresent reports whether a binding satisfies a REQUIRED_TAG_BINDINGS entry: the same
+// tagValues/<id>, the same namespaced value, or for "<parent>/<key>" any value of that key
+func tagBindingPresent(bindings []*crmv3.TagBinding, want string) bool {
+    for _, b := range bindings {
+        if b.TagValue == want || b.TagValueNamespacedName == want {
+            return true
+        }
+        if strings.Count(want, "/") == 1 && !strings.HasPrefix(want, "tagValues/") &&
+            strings.HasPrefix(b.TagValueNamespacedName, want+"/") {
+            return true
+        }
+    }
+    return false
+}
+
+// tagBindingName names a binding for the result details, preferring the readable namespaced name
+func tagBindingName(b *crmv3.TagBinding) string {
+    if b.TagValueNamespacedName != "" {
+        return b.TagValueNamespacedName
+    }
+    return b.TagValue
+}
@@ -0,0 +1,101 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    crmv3 "google.golang.org/api/cloudresourcemanager/v3"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ResourceTagsCheckValidator", func() {
+    var (
+        v        *validators.ResourceTagsCheckValidator
+        vctx     *validator.Context
+        tagsFake *fakeTags
+    )
+
+    const parent = "//cloudresourcemanager.googleapis.com/projects/123"
+
+    BeforeEach(func() {
+        v = &validators.ResourceTagsCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("REQUIRED_TAG_BINDINGS", "456/env/prod,tagValues/789")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        tagsFake = &fakeTags{bindings: map[string][]*crmv3.TagBinding{
+            parent: {
+                {Parent: parent, TagValue: "tagValues/111", TagValueNamespacedName: "456/env/prod"},
+                {Parent: parent, TagValue: "tagValues/789", TagValueNamespacedName: "456/cost-center/cc-42"},
+            },
+        }}
+        vctx.SetTagsAPI(tagsFake)
+        vctx.SetResourceManagerAPI(&fakeResourceManager{
+            project: &cloudresourcemanager.Project{ProjectId: "test-project", ProjectNumber: 123},
+        })
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without REQUIRED_TAG_BINDINGS", func() {
+            vctx.Config.RequiredTagBindings = nil
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when every required tag is bound", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("TagBindingsPresent"))
+            Expect(result.Details).To(HaveKeyWithValue("bound_tags", []string{"456/env/prod", "456/cost-center/cc-42"}))
+        })
+
+        It("should accept any value of a required key", func() {
+            vctx.Config.RequiredTagBindings = []string{"456/cost-center"}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+        })
+
+        It("should report missing tags", func() {
+            vctx.Config.RequiredTagBindings = []string{"456/env/prod", "456/env/staging", "456/owner"}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("MissingTagBinding"))
+            Expect(result.Details).To(HaveKeyWithValue("missing_tags", []string{"456/env/staging", "456/owner"}))
+        })
+
+        It("should fail when the project has no bindings", func() {
+            tagsFake.bindings = nil
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("MissingTagBinding"))
+            Expect(result.Details["missing_tags"]).To(HaveLen(2))
+        })
+
+        It("should fail when the listing errors", func() {
+            tagsFake.err = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
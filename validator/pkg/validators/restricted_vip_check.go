@@ -0,0 +1,156 @@
+package validators
+
+import (
+    "cmp"
+    "context"
+    "fmt"
+    "log/slog"
+    "net/netip"
+    "path"
+    "strings"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for listing the project's routes
+    restrictedVIPCheckTimeout = 30 * time.Second
+
+    // Range of restricted.googleapis.com, the VIP for Private Google Access under VPC Service Controls
+    restrictedVIPRange = "199.36.153.4/30"
+
+    // Network checked when VPC_NAME is not set
+    defaultNetworkName = "default"
+)
+
+// vpcNetwork returns the VPC network validatorName checks: VALIDATOR_<NAME>_VPC_NAME, then VPC_NAME, then fallback
+// Validators sharing VPC_NAME read it through here so one can be pointed at another network
+func vpcNetwork(vctx *validator.Context, validatorName, fallback string) string {
+    return vctx.Config.ValidatorString(validatorName, "VPC_NAME", cmp.Or(vctx.Config.VPCName, fallback))
+}
+
+// RestrictedVIPCheckValidator checks that the VPC network routes the restricted Google APIs
+// range to the default internet gateway, which Private Google Access needs to reach it
+type RestrictedVIPCheckValidator struct{}
+
+// init registers the RestrictedVIPCheckValidator with the global validator registry
+func init() {
+    validator.Register(&RestrictedVIPCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *RestrictedVIPCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "restricted-vip-check",
+        Description: "Verify the VPC network has a route to restricted.googleapis.com (" + restrictedVIPRange + ")",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure compute API is available
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs restricted-vip-check calls so api-enabled checks them
+func (v *RestrictedVIPCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless CHECK_RESTRICTED_VIP is set
+func (v *RestrictedVIPCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("CHECK_RESTRICTED_VIP")
+}
+
+// Validate lists the project's routes and looks for one on the VPC network whose destination
+// covers the restricted VIP range and whose next hop is the default internet gateway
+func (v *RestrictedVIPCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    network := vpcNetwork(vctx, v.Metadata().Name, defaultNetworkName)
+    slog.Info("Checking route to the restricted Google APIs VIP", "network", network, "range", restrictedVIPRange)
+
+    ctx, cancel := context.WithTimeout(ctx, restrictedVIPCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    routes, err := computeSvc.ListRoutes(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to list routes",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonRestrictedVIPCheckFailed),
+            Message: fmt.Sprintf("Failed to list routes: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    route := findRestrictedVIPRoute(routes, network)
+    details := map[string]interface{}{
+        "network":    network,
+        "dest_range": restrictedVIPRange,
+        "project_id": vctx.Config.ProjectID,
+    }
+
+    if route == nil {
+        slog.Warn("No route to the restricted Google APIs VIP", "network", network)
+        details["hint"] = "Create one with: gcloud compute routes create <name> --network=<network> " +
+            "--destination-range=" + restrictedVIPRange + " --next-hop-gateway=default-internet-gateway"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonMissingRestrictedVIPRoute,
+            Message: fmt.Sprintf("VPC network %s has no route to %s via the default internet gateway", network, restrictedVIPRange),
+            Details: details,
+        }
+    }
+
+    details["route"] = route.Name
+    details["route_dest_range"] = route.DestRange
+    message := fmt.Sprintf("VPC network %s routes %s through route %s (%s)", network, restrictedVIPRange, route.Name, route.DestRange)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonRestrictedVIPRouteFound,
+        Message: message,
+        Details: details,
+    }
+}
+
+// findRestrictedVIPRoute returns the most specific route on network that covers the restricted
+// VIP range with the default internet gateway as next hop; a 0.0.0.0/0 default route qualifies
+func findRestrictedVIPRoute(routes []*compute.Route, network string) *compute.Route {
+    vip := netip.MustParsePrefix(restrictedVIPRange)
+
+    var best *compute.Route
+    bestBits := -1
+    for _, r := range routes {
+        if path.Base(r.Network) != network || !strings.HasSuffix(r.NextHopGateway, "/default-internet-gateway") {
+            continue
+        }
+        dest, err := netip.ParsePrefix(r.DestRange)
+        if err != nil || dest.Bits() > vip.Bits() || !dest.Contains(vip.Addr()) {
+            continue
+        }
+        if dest.Bits() > bestBits {
+            best, bestBits = r, dest.Bits()
+        }
+    }
+    return best
+}
@@ -0,0 +1,110 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("RestrictedVIPCheckValidator", func() {
+    var (
+        v           *validators.RestrictedVIPCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    const (
+        networkURL = "https://www.googleapis.com/compute/v1/projects/test-project/global/networks/"
+        gatewayURL = "https://www.googleapis.com/compute/v1/projects/test-project/global/gateways/default-internet-gateway"
+    )
+
+    BeforeEach(func() {
+        v = &validators.RestrictedVIPCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("VPC_NAME", "cluster-vpc")
+        GinkgoT().Setenv("CHECK_RESTRICTED_VIP", "true")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{routes: []*compute.Route{
+            {Name: "default-route", Network: networkURL + "cluster-vpc", DestRange: "0.0.0.0/0", NextHopGateway: gatewayURL},
+            {Name: "restricted-apis", Network: networkURL + "cluster-vpc", DestRange: "199.36.153.4/30", NextHopGateway: gatewayURL},
+            {Name: "other-vpc-route", Network: networkURL + "other-vpc", DestRange: "199.36.153.4/30", NextHopGateway: gatewayURL},
+        }}
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without CHECK_RESTRICTED_VIP", func() {
+            vctx.Config.CheckRestrictedVIP = false
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should report the most specific matching route", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("route", "restricted-apis"))
+        })
+
+        It("should accept a default route to the internet gateway", func() {
+            computeFake.routes = computeFake.routes[:1]
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("route", "default-route"))
+        })
+
+        It("should fail when only another network has the route", func() {
+            computeFake.routes = computeFake.routes[2:]
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("MissingRestrictedVIPRoute"))
+        })
+
+        It("should ignore routes that do not use the internet gateway", func() {
+            computeFake.routes = []*compute.Route{
+                {Name: "to-appliance", Network: networkURL + "cluster-vpc", DestRange: "199.36.153.4/30", NextHopIp: "10.0.0.5"},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("MissingRestrictedVIPRoute"))
+        })
+
+        It("should check the default network when VPC_NAME is not set", func() {
+            vctx.Config.VPCName = ""
+            GinkgoT().Setenv("VPC_NAME", "")
+            computeFake.routes[2].Network = networkURL + "default"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("route", "other-vpc-route"))
+        })
+
+        It("should fail when listing routes errors", func() {
+            computeFake.listErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
@@ -0,0 +1,210 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "sort"
+    "strings"
+    "time"
+
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the router and router status lookups
+    routerBGPCheckTimeout = 30 * time.Second
+
+    // Status of a BGP session that is exchanging routes
+    bgpPeerStatusUp = "UP"
+)
+
+// RouterBGPCheckValidator checks that the Cloud Router ROUTER_NAME in GCP_REGION uses the BGP ASN
+// EXPECTED_ROUTER_ASN the on-premises peers are configured for, and that all its BGP sessions are UP
+type RouterBGPCheckValidator struct{}
+
+// init registers the RouterBGPCheckValidator with the global validator registry
+func init() {
+    validator.Register(&RouterBGPCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *RouterBGPCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "router-bgp-check",
+        Description: "Verify the Cloud Router's BGP ASN matches the on-premises peer and its BGP sessions are up",
+        RunAfter:    []string{"api-enabled"}, // Requires compute.googleapis.com
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs router-bgp-check calls so api-enabled checks them
+func (v *RouterBGPCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless both ROUTER_NAME and EXPECTED_ROUTER_ASN are set
+func (v *RouterBGPCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("ROUTER_NAME") && vctx.HasConfig("EXPECTED_ROUTER_ASN")
+}
+
+// Validate fetches the router, compares its ASN and then checks the status of every BGP session
+func (v *RouterBGPCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := vctx.Config.RouterName
+    region := vctx.Config.GCPRegion
+    expected := vctx.Config.ExpectedRouterASN
+    if region == "" {
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRegionNotConfigured,
+            Message: "GCP_REGION is required to check Cloud Router " + name,
+            Details: map[string]interface{}{
+                "router":     name,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set GCP_REGION to the region of the Cloud Router",
+            },
+        }
+    }
+
+    slog.Info("Checking Cloud Router BGP configuration", "router", name, "region", region, "expected_asn", expected)
+
+    ctx, cancel := context.WithTimeout(ctx, routerBGPCheckTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    router, err := computeSvc.GetRouter(ctx, vctx.Config.ProjectID, region, name)
+    if err != nil {
+        if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonRouterNotFound,
+                Message: fmt.Sprintf("Cloud Router %s does not exist in region %s", name, region),
+                Details: map[string]interface{}{
+                    "router":     name,
+                    "region":     region,
+                    "project_id": vctx.Config.ProjectID,
+                    "hint":       "Check ROUTER_NAME and GCP_REGION, or list routers with: gcloud compute routers list",
+                },
+            }
+        }
+        return routerLookupFailure(vctx, "Cloud Router "+name, err)
+    }
+
+    var asn int64
+    if router.Bgp != nil {
+        asn = router.Bgp.Asn
+    }
+    details := map[string]interface{}{
+        "router":       name,
+        "region":       region,
+        "asn":          asn,
+        "expected_asn": expected,
+        "project_id":   vctx.Config.ProjectID,
+    }
+
+    if asn != expected {
+        slog.Warn("Cloud Router ASN does not match", "router", name, "asn", asn, "expected_asn", expected)
+        details["hint"] = fmt.Sprintf("Use ASN %d on the router, or update EXPECTED_ROUTER_ASN and the on-premises peers to %d", expected, asn)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonRouterASNMismatch,
+            Message: fmt.Sprintf("Cloud Router %s uses BGP ASN %d, expected %d", name, asn, expected),
+            Details: details,
+        }
+    }
+
+    status, err := computeSvc.GetRouterStatus(ctx, vctx.Config.ProjectID, region, name)
+    if err != nil {
+        return routerLookupFailure(vctx, "status of Cloud Router "+name, err)
+    }
+
+    // The peer's ASN is only part of the router's configuration, not of the session status
+    peerASNs := make(map[string]int64, len(router.BgpPeers))
+    for _, p := range router.BgpPeers {
+        peerASNs[p.Name] = p.PeerAsn
+    }
+
+    peers := map[string]interface{}{}
+    var down []string
+    if status.Result != nil {
+        for _, p := range status.Result.BgpPeerStatus {
+            peers[p.Name] = map[string]interface{}{
+                "status":   p.Status,
+                "state":    p.State,
+                "peer_ip":  p.PeerIpAddress,
+                "peer_asn": peerASNs[p.Name],
+                "uptime":   p.Uptime,
+            }
+            if p.Status != bgpPeerStatusUp {
+                down = append(down, p.Name)
+            }
+        }
+    }
+    sort.Strings(down)
+    details["peers"] = peers
+
+    if len(peers) == 0 {
+        slog.Warn("Cloud Router has no BGP sessions", "router", name)
+        details["hint"] = "Add a BGP peer for the VPN tunnel or Interconnect attachment with: gcloud compute routers add-bgp-peer"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonBGPSessionDown,
+            Message: fmt.Sprintf("Cloud Router %s has no BGP sessions", name),
+            Details: details,
+        }
+    }
+
+    if len(down) > 0 {
+        slog.Warn("BGP sessions are not established", "router", name, "down", down)
+        details["down_peers"] = down
+        details["hint"] = "Check that the on-premises peers use the router's ASN and interface IPs, and that the VPN tunnel or attachment is up"
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonBGPSessionDown,
+            Message: fmt.Sprintf("%d of %d BGP session(s) on Cloud Router %s are not up: %s", len(down), len(peers), name, strings.Join(down, ", ")),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Cloud Router %s uses ASN %d and all %d BGP session(s) are up", name, asn, len(peers))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonBGPSessionsEstablished,
+        Message: message,
+        Details: details,
+    }
+}
+
+// routerLookupFailure builds the failure result for a failed router or router status lookup
+func routerLookupFailure(vctx *validator.Context, what string, err error) *validator.Result {
+    slog.Error("Failed to get "+what,
+        "error", err.Error(),
+        "project_id", vctx.Config.ProjectID)
+    return &validator.Result{
+        Status:  validator.StatusFailure,
+        Reason:  extractErrorReason(err, validator.ReasonRouterBGPCheckFailed),
+        Message: fmt.Sprintf("Failed to get %s: %v", what, err),
+        Details: map[string]interface{}{
+            "error_type": fmt.Sprintf("%T", err),
+            "project_id": vctx.Config.ProjectID,
+        },
+    }
+}
@@ -0,0 +1,128 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("RouterBGPCheckValidator", func() {
+    var (
+        v           *validators.RouterBGPCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+    )
+
+    BeforeEach(func() {
+        v = &validators.RouterBGPCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("GCP_REGION", "us-central1")
+        GinkgoT().Setenv("ROUTER_NAME", "hybrid-router")
+        GinkgoT().Setenv("EXPECTED_ROUTER_ASN", "64512")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeFake = &fakeCompute{
+            routers: map[string]*compute.Router{
+                "hybrid-router": {
+                    Name:     "hybrid-router",
+                    Bgp:      &compute.RouterBgp{Asn: 64512},
+                    BgpPeers: []*compute.RouterBgpPeer{{Name: "onprem-a", PeerAsn: 65001}, {Name: "onprem-b", PeerAsn: 65001}},
+                },
+            },
+            routerStatus: map[string]*compute.RouterStatus{
+                "hybrid-router": {BgpPeerStatus: []*compute.RouterStatusBgpPeerStatus{
+                    {Name: "onprem-a", Status: "UP", State: "Established", PeerIpAddress: "169.254.0.2"},
+                    {Name: "onprem-b", Status: "UP", State: "Established", PeerIpAddress: "169.254.1.2"},
+                }},
+            },
+        }
+        vctx.SetComputeAPI(computeFake)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without an expected ASN", func() {
+            vctx.Config.ExpectedRouterASN = 0
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+
+        It("should not be enabled without a router", func() {
+            vctx.Config.RouterName = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the ASN matches and every session is up", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("BGPSessionsEstablished"))
+            Expect(result.Details["peers"]).To(HaveKeyWithValue("onprem-a", HaveKeyWithValue("peer_asn", int64(65001))))
+        })
+
+        It("should report an ASN mismatch", func() {
+            vctx.Config.ExpectedRouterASN = 65000
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("RouterASNMismatch"))
+            Expect(result.Details).To(HaveKeyWithValue("asn", int64(64512)))
+        })
+
+        It("should report sessions that are down", func() {
+            computeFake.routerStatus["hybrid-router"].BgpPeerStatus[1].Status = "DOWN"
+            computeFake.routerStatus["hybrid-router"].BgpPeerStatus[1].State = "Active"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("BGPSessionDown"))
+            Expect(result.Details).To(HaveKeyWithValue("down_peers", []string{"onprem-b"}))
+            Expect(result.Details["peers"]).To(HaveKeyWithValue("onprem-b", HaveKeyWithValue("state", "Active")))
+        })
+
+        It("should fail when the router has no BGP sessions", func() {
+            computeFake.routerStatus = nil
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("BGPSessionDown"))
+        })
+
+        It("should report a missing router", func() {
+            vctx.Config.RouterName = "other-router"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal("RouterNotFound"))
+        })
+
+        It("should require GCP_REGION", func() {
+            vctx.Config.GCPRegion = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Reason).To(Equal(validator.ReasonRegionNotConfigured))
+        })
+
+        It("should fail when the lookup errors", func() {
+            computeFake.routerErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
@@ -0,0 +1,212 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "sort"
+    "strings"
+    "time"
+
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the project number and IAM policy lookups
+    serviceAgentRequestTimeout = 30 * time.Second
+)
+
+// serviceAgentDomains maps services whose agent does not follow the gcp-sa-<service> convention
+var serviceAgentDomains = map[string]string{
+    "compute.googleapis.com":   "compute-system.iam.gserviceaccount.com",
+    "container.googleapis.com": "container-engine-robot.iam.gserviceaccount.com",
+}
+
+// ServiceAgentValidator checks that Google-managed service agents hold the roles their APIs need
+// An API can be enabled while its agent lacks its role (e.g., after a custom policy removed it),
+// which api-enabled cannot detect
+type ServiceAgentValidator struct{}
+
+// init registers the ServiceAgentValidator with the global validator registry
+func init() {
+    validator.Register(&ServiceAgentValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ServiceAgentValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "service-agent-check",
+        Description: "Verify Google-managed service agents exist and hold their expected project roles",
+        RunAfter:    []string{"api-enabled"}, // Service agents are created when their API is enabled
+        Tags:        []string{"post-mvp", "iam"},
+    }
+}
+
+// RequiredAPIs declares the APIs service-agent-check calls so api-enabled checks them
+func (v *ServiceAgentValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless SERVICE_AGENT_ROLES is set
+func (v *ServiceAgentValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("SERVICE_AGENT_ROLES")
+}
+
+// Validate reads the project IAM policy and checks each configured agent's role bindings
+// An agent absent from the policy is reported as missing all of its roles
+func (v *ServiceAgentValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    slog.Info("Checking service agent roles", "services", len(vctx.Config.ServiceAgentRoles))
+
+    ctx, cancel := context.WithTimeout(ctx, serviceAgentRequestTimeout)
+    defer cancel()
+
+    projectNumber, err := vctx.GetProjectNumber(ctx)
+    if err != nil {
+        slog.Error("Failed to resolve project number",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonProjectNumberLookupFailed),
+            Message: fmt.Sprintf("Failed to resolve project number for service agents: %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    policy, err := crm.GetIamPolicy(ctx, vctx.Config.ProjectID)
+    if err != nil {
+        slog.Error("Failed to get project IAM policy",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonIAMPolicyLookupFailed),
+            Message: fmt.Sprintf("Failed to get IAM policy of project %s: %v", vctx.Config.ProjectID, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant resourcemanager.projects.getIamPolicy to the validator's service account",
+            },
+        }
+    }
+
+    // Index granted roles by member so each agent is a map lookup
+    granted := map[string]map[string]bool{}
+    for _, b := range policy.Bindings {
+        for _, member := range b.Members {
+            if granted[member] == nil {
+                granted[member] = map[string]bool{}
+            }
+            granted[member][b.Role] = true
+        }
+    }
+
+    services := make([]string, 0, len(vctx.Config.ServiceAgentRoles))
+    for service := range vctx.Config.ServiceAgentRoles {
+        services = append(services, service)
+    }
+    sort.Strings(services)
+
+    agents := map[string]string{}
+    missingRoles := map[string][]string{}
+    var missingAgents []string
+    subResults := make([]*validator.Result, 0, len(services))
+    for _, service := range services {
+        agent := serviceAgentEmail(service, projectNumber)
+        agents[service] = agent
+
+        roles := granted["serviceAccount:"+agent]
+        if roles == nil {
+            missingAgents = append(missingAgents, agent)
+        }
+        var missing []string
+        for _, role := range vctx.Config.ServiceAgentRoles[service] {
+            if !roles[role] {
+                missing = append(missing, role)
+            }
+        }
+
+        if len(missing) == 0 {
+            subResults = append(subResults, &validator.Result{
+                ValidatorName: service,
+                Status:        validator.StatusSuccess,
+                Reason:        validator.ReasonServiceAgentRolesGranted,
+                Message:       fmt.Sprintf("Service agent %s holds its expected roles", agent),
+            })
+            continue
+        }
+        missingRoles[agent] = missing
+        slog.Warn("Service agent is missing roles", "service", service, "agent", agent, "missing_roles", missing)
+        subResults = append(subResults, &validator.Result{
+            ValidatorName: service,
+            Status:        validator.StatusFailure,
+            Reason:        validator.ReasonServiceAgentMissingRole,
+            Message:       fmt.Sprintf("Service agent %s is missing %s", agent, strings.Join(missing, ", ")),
+        })
+    }
+
+    if len(missingRoles) > 0 {
+        details := map[string]interface{}{
+            "missing_roles":  missingRoles,
+            "service_agents": agents,
+            "project_id":     vctx.Config.ProjectID,
+            "hint":           "Re-enable the API to recreate its service agent, or grant the role with: gcloud projects add-iam-policy-binding",
+        }
+        if len(missingAgents) > 0 {
+            details["missing_agents"] = missingAgents
+        }
+        return &validator.Result{
+            Status:     validator.StatusFailure,
+            Reason:     validator.ReasonServiceAgentMissingRole,
+            Message:    fmt.Sprintf("%d service agent(s) are missing expected roles", len(missingRoles)),
+            Details:    details,
+            SubResults: subResults,
+        }
+    }
+
+    message := fmt.Sprintf("All %d service agent(s) hold their expected roles", len(services))
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonServiceAgentsConfigured,
+        Message: message,
+        Details: map[string]interface{}{
+            "service_agents": agents,
+            "project_id":     vctx.Config.ProjectID,
+        },
+        SubResults: subResults,
+    }
+}
+
+// serviceAgentEmail returns the service agent of a service, e.g. compute.googleapis.com ->
+// service-<number>@compute-system.iam.gserviceaccount.com; keys containing "@" are used as-is
+func serviceAgentEmail(service string, projectNumber int64) string {
+    if strings.Contains(service, "@") {
+        return service
+    }
+    domain, ok := serviceAgentDomains[service]
+    if !ok {
+        domain = "gcp-sa-" + strings.TrimSuffix(service, ".googleapis.com") + ".iam.gserviceaccount.com"
+    }
+    return fmt.Sprintf("service-%d@%s", projectNumber, domain)
+}
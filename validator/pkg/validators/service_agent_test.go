@@ -0,0 +1,97 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ServiceAgentValidator", func() {
+    var (
+        v    *validators.ServiceAgentValidator
+        vctx *validator.Context
+        crm  *fakeResourceManager
+    )
+
+    const computeAgent = "serviceAccount:service-123@compute-system.iam.gserviceaccount.com"
+
+    BeforeEach(func() {
+        v = &validators.ServiceAgentValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("SERVICE_AGENT_ROLES", "compute.googleapis.com=roles/compute.serviceAgent")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        crm = &fakeResourceManager{
+            project: &cloudresourcemanager.Project{ProjectId: "test-project", ProjectNumber: 123},
+            policy: &cloudresourcemanager.Policy{Bindings: []*cloudresourcemanager.Binding{
+                {Role: "roles/compute.serviceAgent", Members: []string{computeAgent}},
+            }},
+        }
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    Describe("Enabled", func() {
+        It("should not be enabled without SERVICE_AGENT_ROLES", func() {
+            vctx.Config.ServiceAgentRoles = nil
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should succeed when the agent holds its roles", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("ServiceAgentsConfigured"))
+            Expect(result.SubResults).To(HaveLen(1))
+        })
+
+        It("should fail with the missing roles", func() {
+            vctx.Config.ServiceAgentRoles["compute.googleapis.com"] = []string{
+                "roles/compute.serviceAgent",
+                "roles/compute.networkUser",
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("ServiceAgentMissingRole"))
+            Expect(result.Details).To(HaveKeyWithValue("missing_roles", map[string][]string{
+                "service-123@compute-system.iam.gserviceaccount.com": {"roles/compute.networkUser"},
+            }))
+            Expect(result.Details).NotTo(HaveKey("missing_agents"))
+        })
+
+        It("should report agents absent from the policy using the gcp-sa convention", func() {
+            vctx.Config.ServiceAgentRoles["pubsub.googleapis.com"] = []string{"roles/pubsub.serviceAgent"}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Details).To(HaveKeyWithValue("missing_agents",
+                []string{"service-123@gcp-sa-pubsub.iam.gserviceaccount.com"}))
+        })
+
+        It("should fail when the IAM policy cannot be read", func() {
+            crm.policyErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
@@ -0,0 +1,203 @@
+package validators
+
+import (
+    "context"
+    "fmt"
+    "log/slog"
+    "slices"
+    "time"
+
+    "google.golang.org/api/compute/v1"
+    "validator/pkg/gcp"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the org policy and image lookups
+    shieldedVMCheckTimeout = 30 * time.Second
+
+    // Boolean org policy constraint that only allows Shielded VM instances
+    requireShieldedVMConstraint = "constraints/compute.requireShieldedVm"
+
+    // Guest OS feature marking images that boot with UEFI, which Shielded VM requires
+    guestOSFeatureUEFICompatible = "UEFI_COMPATIBLE"
+)
+
+// ShieldedVMCheckValidator checks that SOURCE_IMAGE can boot as a Shielded VM when the
+// compute.requireShieldedVm org policy is enforced on the project
+// Instance creation from an incompatible image is otherwise only rejected at provisioning time
+type ShieldedVMCheckValidator struct{}
+
+// init registers the ShieldedVMCheckValidator with the global validator registry
+func init() {
+    validator.Register(&ShieldedVMCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *ShieldedVMCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "shielded-vm-check",
+        Description: "Verify the source image supports Shielded VM when the requireShieldedVm org policy is enforced",
+        RunAfter:    []string{"api-enabled", "image-check"}, // image-check reports a missing or deprecated image first
+        Tags:        []string{"post-mvp", "compute"},
+    }
+}
+
+// RequiredAPIs declares the APIs shielded-vm-check calls so api-enabled checks them
+func (v *ShieldedVMCheckValidator) RequiredAPIs() []string {
+    return []string{"cloudresourcemanager.googleapis.com", "compute.googleapis.com"}
+}
+
+// Enabled drops the validator from the plan unless SOURCE_IMAGE is set
+func (v *ShieldedVMCheckValidator) Enabled(vctx *validator.Context) bool {
+    return vctx.HasConfig("SOURCE_IMAGE")
+}
+
+// Describe names the policy and image that will be checked
+func (v *ShieldedVMCheckValidator) Describe(vctx *validator.Context) string {
+    return fmt.Sprintf("will read %s on project %s and, when enforced, verify source image %s supports Shielded VM",
+        requireShieldedVMConstraint, vctx.Config.ProjectID, vctx.Config.SourceImage)
+}
+
+// Validate reads the effective requireShieldedVm policy and, when it is enforced, checks the image's guest OS features
+func (v *ShieldedVMCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    source := vctx.Config.SourceImage
+    slog.Info("Checking Shielded VM org policy", "constraint", requireShieldedVMConstraint, "image", source)
+
+    ctx, cancel := context.WithTimeout(ctx, shieldedVMCheckTimeout)
+    defer cancel()
+
+    crm, err := vctx.GetResourceManagerAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Cloud Resource Manager client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonResourceManagerClientError),
+            Message: fmt.Sprintf("Failed to get Cloud Resource Manager client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    policy, err := crm.GetEffectiveOrgPolicy(ctx, vctx.Config.ProjectID, requireShieldedVMConstraint)
+    if err != nil {
+        slog.Error("Failed to get effective org policy",
+            "error", err.Error(),
+            "constraint", requireShieldedVMConstraint)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonShieldedVMCheckFailed),
+            Message: fmt.Sprintf("Failed to get effective org policy %s: %v", requireShieldedVMConstraint, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "constraint": requireShieldedVMConstraint,
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant orgpolicy.policy.get on the project to the validator's service account",
+            },
+        }
+    }
+
+    if policy.BooleanPolicy == nil || !policy.BooleanPolicy.Enforced {
+        slog.Info("Shielded VM org policy not enforced, skipping image check")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonShieldedVMNotRequired,
+            Message: fmt.Sprintf("Org policy %s is not enforced, Shielded VM compatibility not checked", requireShieldedVMConstraint),
+            Details: map[string]interface{}{
+                "constraint": requireShieldedVMConstraint,
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    project, name, family := parseSourceImage(source, vctx.Config.ProjectID)
+    image, err := getSourceImage(ctx, computeSvc, project, name, family)
+    if err != nil {
+        if gcp.ClassifyError(err) == gcp.ErrorClassNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonImageNotFound,
+                Message: fmt.Sprintf("Source image %s does not exist", source),
+                Details: map[string]interface{}{
+                    "image":         source,
+                    "image_project": project,
+                    "project_id":    vctx.Config.ProjectID,
+                    "hint":          "Check SOURCE_IMAGE; image-check reports the details",
+                },
+            }
+        }
+        slog.Error("Failed to get source image",
+            "error", err.Error(),
+            "image", source)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonShieldedVMCheckFailed),
+            Message: fmt.Sprintf("Failed to get source image %s: %v", source, err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Grant compute.images.get on the image project to the validator's service account",
+            },
+        }
+    }
+
+    features := guestOSFeatures(image)
+    details := map[string]interface{}{
+        "constraint":        requireShieldedVMConstraint,
+        "image":             source,
+        "self_link":         image.SelfLink,
+        "guest_os_features": features,
+        "project_id":        vctx.Config.ProjectID,
+    }
+
+    // A custom Secure Boot initial state is only accepted on images built for Shielded VM
+    if !slices.Contains(features, guestOSFeatureUEFICompatible) && image.ShieldedInstanceInitialState == nil {
+        details["hint"] = "Use an image with the UEFI_COMPATIBLE guest OS feature, or exempt the project from " + requireShieldedVMConstraint
+        slog.Warn("Source image does not support Shielded VM", "image", source, "guest_os_features", features)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  validator.ReasonShieldedVMIncompatible,
+            Message: fmt.Sprintf("Org policy %s is enforced but source image %s (%s) is not UEFI_COMPATIBLE", requireShieldedVMConstraint, source, image.SelfLink),
+            Details: details,
+        }
+    }
+
+    message := fmt.Sprintf("Source image %s supports Shielded VM as required by %s", source, requireShieldedVMConstraint)
+    slog.Info(message)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonShieldedVMCompatible,
+        Message: message,
+        Details: details,
+    }
+}
+
+// guestOSFeatures returns the guest OS feature types of an image
+func guestOSFeatures(image *compute.Image) []string {
+    features := make([]string, 0, len(image.GuestOsFeatures))
+    for _, feature := range image.GuestOsFeatures {
+        features = append(features, feature.Type)
+    }
+    return features
+}
@@ -0,0 +1,110 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/cloudresourcemanager/v1"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("ShieldedVMCheckValidator", func() {
+    var (
+        v           *validators.ShieldedVMCheckValidator
+        vctx        *validator.Context
+        computeFake *fakeCompute
+        crm         *fakeResourceManager
+        image       *compute.Image
+    )
+
+    const constraint = "constraints/compute.requireShieldedVm"
+
+    BeforeEach(func() {
+        v = &validators.ShieldedVMCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("SOURCE_IMAGE", "rhcos-cloud/rhcos-414")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        image = &compute.Image{
+            Name:            "rhcos-414-x86-64",
+            SelfLink:        "projects/rhcos-cloud/global/images/rhcos-414-x86-64",
+            GuestOsFeatures: []*compute.GuestOsFeature{{Type: "VIRTIO_SCSI_MULTIQUEUE"}, {Type: "UEFI_COMPATIBLE"}},
+        }
+        computeFake = &fakeCompute{images: map[string]*compute.Image{"rhcos-cloud/family/rhcos-414": image}}
+        vctx.SetComputeAPI(computeFake)
+
+        crm = &fakeResourceManager{orgPolicies: map[string]*cloudresourcemanager.OrgPolicy{
+            constraint: {Constraint: constraint, BooleanPolicy: &cloudresourcemanager.BooleanPolicy{Enforced: true}},
+        }}
+        vctx.SetResourceManagerAPI(crm)
+    })
+
+    It("should not be enabled without SOURCE_IMAGE", func() {
+        vctx.Config.SourceImage = ""
+        Expect(v.Enabled(vctx)).To(BeFalse())
+    })
+
+    It("should skip when the policy is not enforced", func() {
+        crm.orgPolicies = nil
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSkipped))
+        Expect(result.Reason).To(Equal("ShieldedVMNotRequired"))
+    })
+
+    It("should pass for a UEFI_COMPATIBLE image", func() {
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+        Expect(result.Reason).To(Equal("ShieldedVMCompatible"))
+        Expect(result.Details).To(HaveKeyWithValue("guest_os_features", []string{"VIRTIO_SCSI_MULTIQUEUE", "UEFI_COMPATIBLE"}))
+    })
+
+    It("should fail for an image without Shielded VM support", func() {
+        image.GuestOsFeatures = []*compute.GuestOsFeature{{Type: "VIRTIO_SCSI_MULTIQUEUE"}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("ShieldedVMIncompatible"))
+        Expect(result.Details).To(HaveKeyWithValue("self_link", image.SelfLink))
+    })
+
+    It("should accept an image with a Shielded VM initial state", func() {
+        image.GuestOsFeatures = nil
+        image.ShieldedInstanceInitialState = &compute.InitialStateConfig{}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusSuccess))
+    })
+
+    It("should report a missing image", func() {
+        vctx.Config.SourceImage = "rhcos-cloud/rhcos-399"
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("ImageNotFound"))
+    })
+
+    It("should fail when the org policy cannot be read", func() {
+        crm.orgPolicyErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+        result := v.Validate(context.Background(), vctx)
+        Expect(result.Status).To(Equal(validator.StatusFailure))
+        Expect(result.Reason).To(Equal("forbidden"))
+    })
+})
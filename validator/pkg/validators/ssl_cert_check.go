@@ -0,0 +1,159 @@
+package validators
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "log/slog"
+    "net/http"
+    "time"
+
+    "google.golang.org/api/googleapi"
+    "validator/pkg/validator"
+)
+
+const (
+    // Timeout for the SSL certificate lookup
+    sslCertRequestTimeout = 30 * time.Second
+)
+
+// SSLCertCheckValidator checks that the SSL certificate required for ingress exists and has not expired
+type SSLCertCheckValidator struct{}
+
+// init registers the SSLCertCheckValidator with the global validator registry
+func init() {
+    validator.Register(&SSLCertCheckValidator{})
+}
+
+// Metadata returns the validator configuration including name, description, and dependencies
+func (v *SSLCertCheckValidator) Metadata() validator.ValidatorMetadata {
+    return validator.ValidatorMetadata{
+        Name:        "ssl-cert-check",
+        Description: "Verify the pre-provisioned SSL certificate for ingress exists and is not expired",
+        RunAfter:    []string{"api-enabled"}, // Depends on api-enabled to ensure GCP access works
+        Tags:        []string{"post-mvp", "network"},
+    }
+}
+
+// RequiredAPIs declares the APIs ssl-cert-check calls so api-enabled checks them
+func (v *SSLCertCheckValidator) RequiredAPIs() []string {
+    return []string{"compute.googleapis.com"}
+}
+
+// certName returns the configured certificate name, preferring the validator-scoped setting
+func (v *SSLCertCheckValidator) certName(vctx *validator.Context) string {
+    return vctx.Config.ValidatorString(v.Metadata().Name, "SSL_CERT_NAME", vctx.Config.SSLCertName)
+}
+
+// Enabled drops the validator from the plan unless a certificate name is configured
+func (v *SSLCertCheckValidator) Enabled(vctx *validator.Context) bool {
+    return v.certName(vctx) != ""
+}
+
+// Validate looks up the global SSL certificate and checks its expiry when one is reported
+func (v *SSLCertCheckValidator) Validate(ctx context.Context, vctx *validator.Context) *validator.Result {
+    name := v.certName(vctx)
+    if name == "" {
+        slog.Info("SSL_CERT_NAME not set, skipping SSL certificate check")
+        return &validator.Result{
+            Status:  validator.StatusSkipped,
+            Reason:  validator.ReasonSSLCertNotConfigured,
+            Message: "No SSL certificate configured",
+            Details: map[string]interface{}{
+                "project_id": vctx.Config.ProjectID,
+                "hint":       "Set SSL_CERT_NAME to the certificate used by the ingress",
+            },
+        }
+    }
+
+    slog.Info("Checking SSL certificate", "certificate", name)
+
+    ctx, cancel := context.WithTimeout(ctx, sslCertRequestTimeout)
+    defer cancel()
+
+    computeSvc, err := vctx.GetComputeAPI(ctx)
+    if err != nil {
+        slog.Error("Failed to get Compute client",
+            "error", err.Error(),
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonComputeClientError),
+            Message: fmt.Sprintf("Failed to get Compute client (check WIF configuration): %v", err),
+            Details: map[string]interface{}{
+                "error_type": fmt.Sprintf("%T", err),
+                "project_id": vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    cert, err := computeSvc.GetSslCertificate(ctx, vctx.Config.ProjectID, name)
+    if err != nil {
+        var apiErr *googleapi.Error
+        if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonSSLCertNotFound,
+                Message: fmt.Sprintf("SSL certificate %s does not exist", name),
+                Details: map[string]interface{}{
+                    "certificate": name,
+                    "project_id":  vctx.Config.ProjectID,
+                    "hint":        "Create it with: gcloud compute ssl-certificates create <name>",
+                },
+            }
+        }
+
+        slog.Error("Failed to get SSL certificate",
+            "error", err.Error(),
+            "certificate", name,
+            "project_id", vctx.Config.ProjectID)
+        return &validator.Result{
+            Status:  validator.StatusFailure,
+            Reason:  extractErrorReason(err, validator.ReasonSSLCertCheckFailed),
+            Message: fmt.Sprintf("Failed to get SSL certificate %s: %v", name, err),
+            Details: map[string]interface{}{
+                "certificate": name,
+                "error_type":  fmt.Sprintf("%T", err),
+                "project_id":  vctx.Config.ProjectID,
+            },
+        }
+    }
+
+    details := map[string]interface{}{
+        "certificate": name,
+        "cert_type":   cert.Type,
+        "project_id":  vctx.Config.ProjectID,
+    }
+    if cert.Managed != nil {
+        details["managed_status"] = cert.Managed.Status
+    }
+
+    // Managed certificates only report an expiry once provisioned
+    if cert.ExpireTime != "" {
+        details["expire_time"] = cert.ExpireTime
+        expiry, err := time.Parse(time.RFC3339, cert.ExpireTime)
+        if err != nil {
+            slog.Warn("Unparseable SSL certificate expiry, skipping expiry check",
+                "certificate", name, "expire_time", cert.ExpireTime)
+        } else if !expiry.After(time.Now()) {
+            slog.Warn("SSL certificate is expired", "certificate", name, "expire_time", cert.ExpireTime)
+            details["hint"] = "Renew or replace the certificate before configuring ingress"
+            return &validator.Result{
+                Status:  validator.StatusFailure,
+                Reason:  validator.ReasonSSLCertExpired,
+                Message: fmt.Sprintf("SSL certificate %s expired at %s", name, cert.ExpireTime),
+                Details: details,
+            }
+        }
+    }
+
+    message := fmt.Sprintf("SSL certificate %s exists", name)
+    slog.Info(message, "cert_type", cert.Type)
+
+    return &validator.Result{
+        Status:  validator.StatusSuccess,
+        Reason:  validator.ReasonSSLCertValid,
+        Message: message,
+        Details: details,
+    }
+}
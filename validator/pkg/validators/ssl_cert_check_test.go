@@ -0,0 +1,124 @@
+package validators_test
+
+import (
+    "context"
+    "log/slog"
+    "os"
+
+    . "github.com/onsi/ginkgo/v2"
+    . "github.com/onsi/gomega"
+    "google.golang.org/api/compute/v1"
+    "google.golang.org/api/googleapi"
+
+    "validator/pkg/config"
+    "validator/pkg/validator"
+    "validator/pkg/validators"
+)
+
+var _ = Describe("SSLCertCheckValidator", func() {
+    var (
+        v          *validators.SSLCertCheckValidator
+        vctx       *validator.Context
+        computeAPI *fakeCompute
+    )
+
+    BeforeEach(func() {
+        v = &validators.SSLCertCheckValidator{}
+
+        GinkgoT().Setenv("PROJECT_ID", "test-project")
+        GinkgoT().Setenv("SSL_CERT_NAME", "ingress-cert")
+
+        cfg, err := config.LoadFromEnv()
+        Expect(err).NotTo(HaveOccurred())
+
+        logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+            Level: slog.LevelWarn,
+        }))
+        vctx = validator.NewContext(cfg, logger)
+
+        computeAPI = &fakeCompute{
+            sslCerts: map[string]*compute.SslCertificate{
+                "ingress-cert": {
+                    Name:       "ingress-cert",
+                    Type:       "SELF_MANAGED",
+                    ExpireTime: "2999-01-01T00:00:00.000-08:00",
+                },
+            },
+        }
+        vctx.SetComputeAPI(computeAPI)
+    })
+
+    Describe("Metadata", func() {
+        It("should return correct metadata", func() {
+            meta := v.Metadata()
+            Expect(meta.Name).To(Equal("ssl-cert-check"))
+            Expect(meta.RunAfter).To(ConsistOf("api-enabled"))
+        })
+    })
+
+    Describe("Enabled", func() {
+        It("should follow whether SSL_CERT_NAME is configured", func() {
+            Expect(v.Enabled(vctx)).To(BeTrue())
+
+            GinkgoT().Setenv("SSL_CERT_NAME", "")
+            vctx.Config.SSLCertName = ""
+            Expect(v.Enabled(vctx)).To(BeFalse())
+        })
+    })
+
+    Describe("Validate", func() {
+        It("should skip when SSL_CERT_NAME is unset", func() {
+            GinkgoT().Setenv("SSL_CERT_NAME", "")
+            vctx.Config.SSLCertName = ""
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSkipped))
+        })
+
+        It("should succeed for an unexpired certificate", func() {
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Reason).To(Equal("SSLCertValid"))
+            Expect(result.Details).To(HaveKeyWithValue("cert_type", "SELF_MANAGED"))
+            Expect(result.Details).To(HaveKeyWithValue("expire_time", "2999-01-01T00:00:00.000-08:00"))
+        })
+
+        It("should succeed for a managed certificate that has no expiry yet", func() {
+            computeAPI.sslCerts["ingress-cert"] = &compute.SslCertificate{
+                Name:    "ingress-cert",
+                Type:    "MANAGED",
+                Managed: &compute.SslCertificateManagedSslCertificate{Status: "PROVISIONING"},
+            }
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusSuccess))
+            Expect(result.Details).To(HaveKeyWithValue("managed_status", "PROVISIONING"))
+            Expect(result.Details).NotTo(HaveKey("expire_time"))
+        })
+
+        It("should fail with SSLCertExpired for an expired certificate", func() {
+            computeAPI.sslCerts["ingress-cert"].ExpireTime = "2000-01-01T00:00:00Z"
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("SSLCertExpired"))
+        })
+
+        It("should fail with SSLCertNotFound when the certificate does not exist", func() {
+            vctx.Config.SSLCertName = "missing-cert"
+            GinkgoT().Setenv("SSL_CERT_NAME", "missing-cert")
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("SSLCertNotFound"))
+        })
+
+        It("should surface other lookup errors", func() {
+            computeAPI.sslCertErr = &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}
+
+            result := v.Validate(context.Background(), vctx)
+            Expect(result.Status).To(Equal(validator.StatusFailure))
+            Expect(result.Reason).To(Equal("forbidden"))
+        })
+    })
+})
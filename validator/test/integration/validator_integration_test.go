@@ -106,14 +106,16 @@ var _ = Describe("Validator Integration Tests", func() {
                 Expect(result.Status).To(BeElementOf(
                     validator.StatusSuccess,
                     validator.StatusFailure,
-                ), "Status should be success or failure")
+                    validator.StatusWarning,
+                    validator.StatusSkipped,
+                ), "Status should be a known status")
                 Expect(result.Reason).NotTo(BeEmpty(), "Reason should not be empty")
                 Expect(result.Message).NotTo(BeEmpty(), "Message should not be empty")
             })
         })
 
         Context("quota-check validator", func() {
-            It("should run quota-check validator (stub)", func() {
+            It("should run quota-check validator", func() {
                 v, exists := validator.Get("quota-check")
                 Expect(exists).To(BeTrue(), "quota-check validator should be registered")
 
@@ -134,8 +136,13 @@ var _ = Describe("Validator Integration Tests", func() {
                     "reason", result.Reason,
                     "message", result.Message)
 
-                // Currently a stub, so should succeed
-                Expect(result.Status).To(Equal(validator.StatusSuccess))
+                Expect(result.Status).To(BeElementOf(
+                    validator.StatusSuccess,
+                    validator.StatusFailure,
+                    validator.StatusWarning,
+                    validator.StatusSkipped,
+                ), "Status should be a known status")
+                Expect(result.Reason).NotTo(BeEmpty(), "Reason should not be empty")
             })
         })
     })
@@ -167,17 +174,18 @@ var _ = Describe("Validator Integration Tests", func() {
             Expect(ok).To(BeTrue(), "checks_passed should be an int")
 
             successCount := 0
-            failureCount := 0
+            notPassedCount := 0
             for _, r := range results {
-                if r.Status == validator.StatusSuccess {
+                switch r.Status {
+                case validator.StatusSuccess, validator.StatusWarning:
                     successCount++
-                } else {
-                    failureCount++
+                default:
+                    notPassedCount++
                 }
             }
 
             Expect(checksPassed).To(Equal(successCount))
-            Expect(checksRun - checksPassed).To(Equal(failureCount))
+            Expect(checksRun - checksPassed).To(Equal(notPassedCount))
 
             logger.Info("Aggregated results",
                 "status", aggregated.Status,
@@ -203,6 +211,9 @@ var _ = Describe("Validator Integration Tests", func() {
                 Expect(vctx.Results[result.ValidatorName]).To(Equal(result))
             }
 
+            // ExecuteAll already returns level-then-name order; OrderedResults must match it
+            Expect(vctx.OrderedResults()).To(Equal(results))
+
             logger.Info("Verified shared state",
                 "validators_in_context", len(vctx.Results))
         })